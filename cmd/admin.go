@@ -0,0 +1,117 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/service"
+	"zadanie-6105/internal/util"
+)
+
+// runUser Подкоманда user.
+//
+//	user create -username user [-first-name name] [-last-name name]
+func runUser(args []string) {
+	if len(args) == 0 || args[0] != "create" {
+		badUsage("user: доступно только create")
+	}
+
+	fs := flag.NewFlagSet("user create", flag.ExitOnError)
+	username := fs.String("username", "", "имя пользователя")
+	firstName := fs.String("first-name", "", "имя")
+	lastName := fs.String("last-name", "", "фамилия")
+	_ = fs.Parse(args[1:])
+
+	c := newCLI()
+	employee, err := service.NewAdminService(c.storage).CreateUser(c.request(), &models.Employee{
+		Username:  *username,
+		FirstName: *firstName,
+		LastName:  *lastName,
+	})
+	if err != nil {
+		c.fail(err)
+	}
+
+	c.printJSON(employee)
+}
+
+// runOrg Подкоманда org.
+//
+//	org create -name name -type IE|LLC|JSC [-description text]
+//	org add-responsible -org organizationId -username user
+func runOrg(args []string) {
+	if len(args) == 0 {
+		badUsage("org: нужно указать create или add-responsible")
+	}
+
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("org create", flag.ExitOnError)
+		name := fs.String("name", "", "название организации")
+		orgType := fs.String("type", string(models.LLC), "тип организации: IE, LLC или JSC")
+		description := fs.String("description", "", "описание")
+		_ = fs.Parse(args[1:])
+
+		c := newCLI()
+		organization, err := service.NewAdminService(c.storage).CreateOrganization(c.request(), &models.Organization{
+			Name: *name,
+			Desc: *description,
+			Type: models.OrganizationType(*orgType),
+		})
+		if err != nil {
+			c.fail(err)
+		}
+
+		c.printJSON(organization)
+	case "add-responsible":
+		fs := flag.NewFlagSet("org add-responsible", flag.ExitOnError)
+		orgID := fs.String("org", "", "идентификатор организации")
+		username := fs.String("username", "", "имя пользователя")
+		_ = fs.Parse(args[1:])
+
+		c := newCLI()
+		responsible, err := service.NewAdminService(c.storage).AddResponsible(c.request(), *orgID, *username)
+		if err != nil {
+			c.fail(err)
+		}
+
+		c.printJSON(responsible)
+	default:
+		badUsage("org: неизвестное действие %q", args[0])
+	}
+}
+
+// runTender Подкоманда tender.
+//
+//	tender close-expired [-older-than 720h]
+func runTender(args []string) {
+	if len(args) == 0 || args[0] != "close-expired" {
+		badUsage("tender: доступно только close-expired")
+	}
+
+	fs := flag.NewFlagSet("tender close-expired", flag.ExitOnError)
+	olderThan := fs.Duration("older-than", 30*24*time.Hour, "закрыть опубликованные тендеры старше этого срока")
+	_ = fs.Parse(args[1:])
+	if *olderThan <= 0 {
+		badUsage("tender close-expired: -older-than должен быть положительным")
+	}
+
+	c := newCLI()
+	batch, tenders, err := service.NewTenderService(c.storage, util.NewBatchConfig()).
+		CloseExpiredTenders(c.request(), time.Now().Add(-*olderThan))
+	if err != nil {
+		c.fail(err)
+	}
+
+	closed := 0
+	for i, result := range batch.Results {
+		if result.Success {
+			closed++
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "tender %s: %d %s\n", tenders[i].ID, result.Status, result.Error)
+	}
+	fmt.Printf("closed %d of %d expired tenders\n", closed, len(tenders))
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"net/http"
+	"os"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/storage/postgres"
+	"zadanie-6105/internal/util"
+)
+
+const usage = `Использование: main [команда] [параметры]
+
+Команды:
+  serve                      запустить HTTP-сервер (по умолчанию)
+  migrate up|down|status     применить, откатить последнюю или показать встроенные миграции
+  seed [-file fixtures.json] завести пользователей и организации из фикстур
+  user create                завести пользователя
+  org create                 завести организацию
+  org add-responsible        назначить пользователя Ответственным организации
+  tender close-expired       закрыть давно опубликованные тендеры
+  export tenders|bids|reviews выгрузить список в CSV или XLSX
+  import                     загрузить тендеры из CSV или NDJSON
+  sealing-key [-out file]    создать ключ запечатанных предложений
+
+Параметры команды: main <команда> -h`
+
+// cli Окружение команд администрирования. Команды работают через те же сервисы, что и API.
+type cli struct {
+	ctx       context.Context
+	zapLogger *zap.SugaredLogger
+	storage   storage.Storage
+}
+
+func newCLI() *cli {
+	ctx := context.Background()
+	// Информационные сообщения пишутся в стандартный вывод и смешались бы с выводом команды.
+	zapLogger := util.NewZapLogger().Desugar().WithOptions(zap.IncreaseLevel(zap.WarnLevel)).Sugar()
+
+	return &cli{
+		ctx:       ctx,
+		zapLogger: zapLogger,
+		storage:   postgres.NewPostgresRepository(ctx, util.NewDBConfig(), zapLogger),
+	}
+}
+
+// request Сервисы принимают запрос, из которого берут только контекст.
+func (c *cli) request() *http.Request {
+	r, err := http.NewRequestWithContext(c.ctx, http.MethodPost, "/", nil)
+	if err != nil {
+		c.fail(err)
+	}
+
+	return r
+}
+
+// fail Печатает ошибку так же, как её увидел бы клиент API, и завершает команду.
+func (c *cli) fail(err error) {
+	var customErr util.MyResponseError
+	if errors.As(err, &customErr) {
+		fmt.Fprintf(os.Stderr, "%d %s\n", customErr.Status, customErr.Msg)
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	os.Exit(1)
+}
+
+func (c *cli) printJSON(v any) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		c.fail(err)
+	}
+}
+
+// badUsage Ошибка в аргументах команды.
+func badUsage(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(2)
+}
+
+// isConflict Сущность уже заведена. seed в этом случае пропускает запись.
+func isConflict(err error) bool {
+	var customErr util.MyResponseError
+	return errors.As(err, &customErr) && customErr.Status == http.StatusConflict
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/sealing"
+	"zadanie-6105/internal/service"
+	"zadanie-6105/internal/util"
+)
+
+// runExport Подкоманда export: те же выгрузки, что и в API, с теми же проверками прав.
+//
+//	export tenders -username user [-format csv|xlsx] [-out file]
+//	export bids -tender tenderId -username user [-format csv|xlsx] [-out file]
+//	export reviews -tender tenderId -author user -requester user [-format csv|xlsx] [-out file]
+//	export reviews -id bidId -username user [-format csv|xlsx] [-out file]
+func runExport(args []string) {
+	if len(args) == 0 {
+		badUsage("export: нужно указать tenders, bids или reviews")
+	}
+
+	fs := flag.NewFlagSet("export "+args[0], flag.ExitOnError)
+	username := fs.String("username", "", "пользователь, от имени которого делается выгрузка")
+	tenderID := fs.String("tender", "", "идентификатор тендера")
+	bidID := fs.String("id", "", "идентификатор предложения, для отзывов на него")
+	author := fs.String("author", "", "автор предложений, для отзывов на автора")
+	requester := fs.String("requester", "", "Ответственный тендера, для отзывов на автора")
+	format := fs.String("format", string(models.ExportCSV), "формат: csv или xlsx")
+	out := fs.String("out", "", "файл выгрузки, по умолчанию стандартный вывод")
+	_ = fs.Parse(args[1:])
+
+	exportFormat := models.ExportFormat(*format)
+	if _, ok := models.ExportContentTypes[exportFormat]; !ok {
+		badUsage("export: неизвестный формат %q", *format)
+	}
+
+	c := newCLI()
+	// Файл создаётся только после проверок прав, как и ответ API.
+	var dst *os.File
+	open := func() (util.TableWriter, error) {
+		var w io.Writer = os.Stdout
+		if *out != "" {
+			var err error
+			if dst, err = os.Create(*out); err != nil {
+				return nil, err
+			}
+			w = dst
+		}
+
+		return util.NewTableWriter(w, exportFormat)
+	}
+
+	var err error
+	switch args[0] {
+	case "tenders":
+		err = service.NewTenderService(c.storage, util.NewBatchConfig()).ExportUserTenders(c.request(), *username, open)
+	case "bids", "reviews":
+		bidService := newBidService(c)
+		switch {
+		case args[0] == "bids":
+			err = bidService.ExportBidsForTender(c.request(), *tenderID, *username, open)
+		case *bidID != "":
+			err = bidService.ExportReviewsForBid(c.request(), *bidID, *username, open)
+		default:
+			err = bidService.ExportBidReviews(c.request(), *tenderID, *author, *requester, open)
+		}
+	default:
+		badUsage("export: неизвестный список %q", args[0])
+	}
+	if dst != nil {
+		if closeErr := dst.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		c.fail(err)
+	}
+}
+
+func newBidService(c *cli) *service.BidService {
+	sealer, err := sealing.NewSealer(util.NewSealingConfig())
+	if err != nil {
+		c.fail(err)
+	}
+
+	return service.NewBidService(c.storage, sealer, util.NewNegotiationConfig(), util.NewBatchConfig())
+}
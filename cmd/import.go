@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/service"
+	"zadanie-6105/internal/util"
+)
+
+// runImport Подкоманда import: загружает тендеры из файла CSV или NDJSON теми же проверками, что и
+// POST /tenders/import, и печатает отчёт. Без файла читает стандартный ввод.
+//
+//	import -username user [-format csv|ndjson] [-dry-run] [-report json|csv|xlsx] [-out report.csv] [file]
+func runImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	username := fs.String("username", "", "пользователь, от имени которого создаются тендеры")
+	format := fs.String("format", string(models.ImportCSV), "формат файла: csv или ndjson")
+	dryRun := fs.Bool("dry-run", false, "только проверить строки, ничего не создавая")
+	reportFormat := fs.String("report", "json", "формат отчёта: json, csv или xlsx")
+	out := fs.String("out", "", "файл отчёта, по умолчанию стандартный вывод")
+	_ = fs.Parse(args)
+
+	if *username == "" {
+		badUsage("import: -username обязателен")
+	}
+	if _, ok := models.ExportContentTypes[models.ExportFormat(*reportFormat)]; !ok && *reportFormat != "json" {
+		badUsage("import: неизвестный формат отчёта %q", *reportFormat)
+	}
+
+	var src io.Reader = os.Stdin
+	if fs.NArg() > 0 {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			badUsage("import: %v", err)
+		}
+		defer f.Close()
+		src = f
+	}
+
+	c := newCLI()
+	report, err := service.NewTenderService(c.storage, util.NewBatchConfig()).
+		ImportTenders(c.request(), src, models.ImportFormat(*format), *username, *dryRun)
+	if err != nil {
+		c.fail(err)
+	}
+
+	if *reportFormat == "json" && *out == "" {
+		c.printJSON(report)
+	} else if err = writeReport(&report, *reportFormat, *out); err != nil {
+		c.fail(err)
+	}
+
+	fmt.Fprintf(os.Stderr, "import: total %d, succeeded %d, failed %d, dry run %t\n",
+		report.Total, report.Succeeded, report.Failed, report.DryRun)
+}
+
+func writeReport(report *models.ImportReport, reportFormat, out string) error {
+	var dst io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		dst = f
+	}
+
+	if reportFormat == "json" {
+		return json.NewEncoder(dst).Encode(report)
+	}
+
+	return util.WriteImportReport(dst, report, models.ExportFormat(reportFormat))
+}
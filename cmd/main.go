@@ -2,9 +2,16 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"zadanie-6105/internal/api"
+	"zadanie-6105/internal/auction"
 	"zadanie-6105/internal/controller"
+	"zadanie-6105/internal/debarment"
+	"zadanie-6105/internal/outbox"
+	"zadanie-6105/internal/sealing"
 	"zadanie-6105/internal/service"
+	"zadanie-6105/internal/storage/local"
 	"zadanie-6105/internal/storage/postgres"
 	"zadanie-6105/internal/util"
 )
@@ -13,12 +20,86 @@ import (
 // Необходимо клонировать репозиторий к себе, выполнить задание и
 // запушить его обратно.
 func main() {
+	if len(os.Args) < 2 {
+		serve()
+		return
+	}
+
+	args := os.Args[2:]
+	switch os.Args[1] {
+	case "serve":
+		serve()
+	case "migrate":
+		runMigrate(args)
+	case "seed":
+		runSeed(args)
+	case "user":
+		runUser(args)
+	case "org":
+		runOrg(args)
+	case "tender":
+		runTender(args)
+	case "export":
+		runExport(args)
+	case "import":
+		runImport(args)
+	case "sealing-key":
+		runSealingKey(args)
+	case "help", "-h", "--help":
+		fmt.Println(usage)
+	default:
+		badUsage("%s", usage)
+	}
+}
+
+func serve() {
 	ctx := context.Background()
 	zapLogger := util.NewZapLogger()
 	storage := postgres.NewPostgresRepository(ctx, util.NewDBConfig(), zapLogger)
-	tenderService := service.NewTenderService(storage)
-	bidService := service.NewBidService(storage)
-	ctrl := controller.NewController(zapLogger, tenderService, bidService)
+	defer storage.Close()
+
+	// Реплики применяют миграции под advisory lock: первая применяет, остальные ждут и ничего не находят.
+	applied, err := storage.MigrateUp(ctx)
+	if err != nil {
+		zapLogger.Fatalf("migrations: %v", err)
+	}
+	for _, m := range applied {
+		zapLogger.Infof("migration applied: %d %s", m.Version, m.Name)
+	}
+
+	attachmentConfig := util.NewAttachmentConfig()
+	blobStore, err := local.NewLocalBlobStore(attachmentConfig)
+	if err != nil {
+		zapLogger.Fatalf("blob store: %v", err)
+	}
+
+	sealer, err := sealing.NewSealer(util.NewSealingConfig())
+	if err != nil {
+		zapLogger.Fatalf("sealer: %v", err)
+	}
+
+	batchConfig := util.NewBatchConfig()
+	tenderService := service.NewTenderService(storage, batchConfig)
+	bidService := service.NewBidService(storage, sealer, util.NewNegotiationConfig(), batchConfig)
+	auditService := service.NewAuditService(storage)
+	attachmentService := service.NewAttachmentService(storage, blobStore, attachmentConfig)
+	auctionConfig := util.NewAuctionConfig()
+	auctionService := service.NewAuctionService(storage, auctionConfig)
+	platformConfig := util.NewPlatformConfig()
+	organizationService := service.NewOrganizationService(storage, platformConfig)
+	reviewService := service.NewReviewService(storage, platformConfig, util.NewReviewConfig())
+	contractService := service.NewContractService(storage)
+	ctrl := controller.NewController(zapLogger, tenderService, bidService, auditService, attachmentService, auctionService,
+		organizationService, reviewService, contractService, service.NewHealthService(storage))
+
+	relay := outbox.NewRelay(storage, outbox.NewLogPublisher(zapLogger), zapLogger, util.NewOutboxConfig())
+	go relay.Run(ctx)
+
+	closer := auction.NewCloser(storage, zapLogger, auctionConfig)
+	go closer.Run(ctx)
+
+	expirer := debarment.NewExpirer(storage, auditService, zapLogger, util.NewDebarmentConfig())
+	go expirer.Run(ctx)
 
 	app := api.NewAPI(ctrl, zapLogger, util.NewServerConfig())
 
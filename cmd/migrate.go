@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"time"
+	"zadanie-6105/internal/service"
+)
+
+// runMigrate Подкоманда migrate: встроенные в бинарник миграции из migrations/.
+//
+//	migrate up | down | status
+func runMigrate(args []string) {
+	if len(args) != 1 {
+		badUsage("migrate: нужно указать up, down или status")
+	}
+
+	c := newCLI()
+	adminService := service.NewAdminService(c.storage)
+
+	switch args[0] {
+	case "up":
+		applied, err := adminService.MigrateUp(c.request())
+		if err != nil {
+			c.fail(err)
+		}
+		for _, m := range applied {
+			fmt.Printf("up    %d %s\n", m.Version, m.Name)
+		}
+		fmt.Printf("applied %d migrations\n", len(applied))
+	case "down":
+		m, err := adminService.MigrateDown(c.request())
+		if err != nil {
+			c.fail(err)
+		}
+		fmt.Printf("down  %d %s\n", m.Version, m.Name)
+	case "status":
+		list, err := adminService.GetMigrations(c.request())
+		if err != nil {
+			c.fail(err)
+		}
+		for _, m := range list {
+			appliedAt := "pending"
+			if m.AppliedAt != nil {
+				appliedAt = m.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%-25s %d %s\n", appliedAt, m.Version, m.Name)
+		}
+	default:
+		badUsage("migrate: неизвестное действие %q", args[0])
+	}
+}
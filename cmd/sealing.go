@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"zadanie-6105/internal/sealing"
+)
+
+// runSealingKey Подкоманда sealing-key: создаёт ключ запечатанных предложений. Без -out печатает ключ,
+// например, чтобы положить его в Secret.
+//
+//	sealing-key [-out file]
+func runSealingKey(args []string) {
+	fs := flag.NewFlagSet("sealing-key", flag.ExitOnError)
+	out := fs.String("out", "", "файл ключа; существующий файл не перезаписывается")
+	_ = fs.Parse(args)
+
+	key, err := sealing.GenerateKey()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *out == "" {
+		fmt.Println(key)
+		return
+	}
+
+	if err = sealing.WriteKeyFile(*out, key); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
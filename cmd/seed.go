@@ -0,0 +1,79 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/service"
+)
+
+//go:embed fixtures/seed.json
+var defaultFixtures []byte
+
+// fixtures Пользователи и организации для тестового стенда. У организаций заданы ID, чтобы на них можно
+// было ссылаться в запросах.
+type fixtures struct {
+	Users         []models.Employee `json:"users"`
+	Organizations []struct {
+		models.Organization
+		Responsibles []string `json:"responsibles"`
+	} `json:"organizations"`
+}
+
+// runSeed Подкоманда seed: заводит пользователей, организации и Ответственных из фикстур.
+// Уже заведённые записи пропускаются, поэтому команду можно запускать повторно.
+//
+//	seed [-file fixtures.json]
+func runSeed(args []string) {
+	fs := flag.NewFlagSet("seed", flag.ExitOnError)
+	file := fs.String("file", "", "файл фикстур, по умолчанию встроенный")
+	_ = fs.Parse(args)
+
+	data := defaultFixtures
+	if *file != "" {
+		var err error
+		if data, err = os.ReadFile(*file); err != nil {
+			badUsage("seed: %v", err)
+		}
+	}
+
+	var f fixtures
+	if err := json.Unmarshal(data, &f); err != nil {
+		badUsage("seed: %v", err)
+	}
+
+	c := newCLI()
+	adminService := service.NewAdminService(c.storage)
+
+	created, skipped := 0, 0
+	count := func(err error) {
+		switch {
+		case err == nil:
+			created++
+		case isConflict(err):
+			skipped++
+		default:
+			c.fail(err)
+		}
+	}
+
+	for i := range f.Users {
+		_, err := adminService.CreateUser(c.request(), &f.Users[i])
+		count(err)
+	}
+	for i := range f.Organizations {
+		organization := &f.Organizations[i]
+		_, err := adminService.CreateOrganization(c.request(), &organization.Organization)
+		count(err)
+
+		for _, username := range organization.Responsibles {
+			_, err = adminService.AddResponsible(c.request(), organization.ID.String(), username)
+			count(err)
+		}
+	}
+
+	fmt.Printf("created %d, skipped %d\n", created, skipped)
+}
@@ -24,6 +24,7 @@ type API struct {
 	controller      *controller.Controller
 	zapLogger       *zap.SugaredLogger
 	gracefulTimeout time.Duration
+	drainTimeout    time.Duration
 }
 
 func NewAPI(c *controller.Controller, l *zap.SugaredLogger, sc *config.ServerConfig) *API {
@@ -39,6 +40,7 @@ func NewAPI(c *controller.Controller, l *zap.SugaredLogger, sc *config.ServerCon
 		controller:      c,
 		zapLogger:       l,
 		gracefulTimeout: sc.GracefulTimeout,
+		drainTimeout:    sc.DrainTimeout,
 	}
 }
 
@@ -53,6 +55,10 @@ func (a *API) Run(ctxBackground context.Context) {
 	swagger.Servers = nil
 
 	a.server.Use(echomiddleware.RequestLoggerWithConfig(echomiddleware.RequestLoggerConfig{
+		// Пробы kube приходят каждые несколько секунд и засоряли бы журнал.
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == "/healthz" || c.Path() == "/readyz"
+		},
 		LogMethod: true,
 		LogURI:    true,
 		LogStatus: true,
@@ -76,6 +82,8 @@ func (a *API) Run(ctxBackground context.Context) {
 			return nil
 		},
 	}))
+	a.server.Use(echomiddleware.RequestID())
+	a.server.Use(a.controller.AuditMiddleware)
 	g := a.server.Group("/api")
 	g.Use(middleware.OapiRequestValidator(swagger))
 	// the generated code sets up the routing to match the OpenAPI spec and
@@ -83,6 +91,8 @@ func (a *API) Run(ctxBackground context.Context) {
 	// The generated ServerInterfaceWrapper wraps tender.go methods and
 	// calls tender.go methods when the corresponding route is accessed.
 	controller.RegisterHandlersWithBaseURL(a.server, a.controller, "/api")
+	a.server.GET("/healthz", a.controller.Liveness)
+	a.server.GET("/readyz", a.controller.Readiness)
 
 	a.ListenGracefulShutdown(ctx)
 }
@@ -96,6 +106,12 @@ func (a *API) ListenGracefulShutdown(ctx context.Context) {
 	a.zapLogger.Infof("Listening on: %v\n", a.server.Server.Addr)
 
 	<-ctx.Done()
+
+	// Пока сервер ещё принимает запросы, /readyz отвечает 503, и балансировщик выводит под из ротации.
+	a.controller.StartDraining()
+	a.zapLogger.Infof("Draining for %v\n", a.drainTimeout)
+	time.Sleep(a.drainTimeout)
+
 	a.zapLogger.Info("Shutting down server...\n")
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
@@ -0,0 +1,79 @@
+package auction
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/storage"
+)
+
+const (
+	batchSize   = 100
+	closerActor = "system"
+)
+
+type Store interface {
+	storage.Auction
+	storage.Outbox
+	storage.Transactor
+}
+
+// Closer Периодически закрывает истёкшие аукционы и фиксирует победителя.
+// Несколько реплик могут работать одновременно: строки аукционов блокируются с SKIP LOCKED.
+// Победитель аукциона сразу может заключить контракт, а решение по предложению и закрытие тендера
+// остаются за Ответственным: закрыватель не действует от имени сотрудника.
+type Closer struct {
+	storage   Store
+	zapLogger *zap.SugaredLogger
+	interval  time.Duration
+}
+
+func NewCloser(s Store, l *zap.SugaredLogger, cfg *config.AuctionConfig) *Closer {
+	return &Closer{
+		storage:   s,
+		zapLogger: l,
+		interval:  cfg.CloseInterval,
+	}
+}
+
+func (c *Closer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.closeDue(ctx); err != nil {
+				c.zapLogger.Errorf("auction closer: %v", err)
+			}
+		}
+	}
+}
+
+func (c *Closer) closeDue(ctx context.Context) error {
+	return c.storage.WithTx(ctx, func(ctx context.Context) error {
+		closed, err := c.storage.CloseDueAuctions(ctx, time.Now().UTC(), batchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range closed {
+			closed[i].Status = models.AuctionStatusClosed
+
+			var event models.Event
+			event, err = models.NewEvent(models.AuctionClosed, models.TenderAggregate, closed[i].TenderID, closerActor, "", closed[i])
+			if err != nil {
+				return err
+			}
+			if err = c.storage.AppendEvent(ctx, &event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
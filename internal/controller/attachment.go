@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// UploadTenderAttachment (POST /tenders/{tenderId}/attachments).
+func (c *Controller) UploadTenderAttachment(ctx echo.Context, tenderID TenderId, params UploadTenderAttachmentParams) error {
+	return c.uploadAttachment(ctx, models.TenderAttachment, tenderID, params.Username)
+}
+
+// GetTenderAttachments (GET /tenders/{tenderId}/attachments).
+func (c *Controller) GetTenderAttachments(ctx echo.Context, tenderID TenderId, params GetTenderAttachmentsParams) error {
+	return c.getAttachments(ctx, models.TenderAttachment, tenderID, params.Username, params.Version)
+}
+
+// DownloadTenderAttachment (GET /tenders/{tenderId}/attachments/{attachmentId}).
+func (c *Controller) DownloadTenderAttachment(ctx echo.Context, tenderID TenderId, attachmentID AttachmentId, params DownloadTenderAttachmentParams) error {
+	return c.downloadAttachment(ctx, models.TenderAttachment, tenderID, attachmentID, params.Username)
+}
+
+// DeleteTenderAttachment (DELETE /tenders/{tenderId}/attachments/{attachmentId}).
+func (c *Controller) DeleteTenderAttachment(ctx echo.Context, tenderID TenderId, attachmentID AttachmentId, params DeleteTenderAttachmentParams) error {
+	return c.deleteAttachment(ctx, models.TenderAttachment, tenderID, attachmentID, params.Username)
+}
+
+// UploadBidAttachment (POST /bids/{bidId}/attachments).
+func (c *Controller) UploadBidAttachment(ctx echo.Context, bidID BidId, params UploadBidAttachmentParams) error {
+	return c.uploadAttachment(ctx, models.BidAttachment, bidID, params.Username)
+}
+
+// GetBidAttachments (GET /bids/{bidId}/attachments).
+func (c *Controller) GetBidAttachments(ctx echo.Context, bidID BidId, params GetBidAttachmentsParams) error {
+	return c.getAttachments(ctx, models.BidAttachment, bidID, params.Username, params.Version)
+}
+
+// DownloadBidAttachment (GET /bids/{bidId}/attachments/{attachmentId}).
+func (c *Controller) DownloadBidAttachment(ctx echo.Context, bidID BidId, attachmentID AttachmentId, params DownloadBidAttachmentParams) error {
+	return c.downloadAttachment(ctx, models.BidAttachment, bidID, attachmentID, params.Username)
+}
+
+// DeleteBidAttachment (DELETE /bids/{bidId}/attachments/{attachmentId}).
+func (c *Controller) DeleteBidAttachment(ctx echo.Context, bidID BidId, attachmentID AttachmentId, params DeleteBidAttachmentParams) error {
+	return c.deleteAttachment(ctx, models.BidAttachment, bidID, attachmentID, params.Username)
+}
+
+func (c *Controller) uploadAttachment(ctx echo.Context, entityType models.AttachmentEntity, entityID, username string) error {
+	file, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Reason: err.Error()})
+		return err
+	}
+
+	attachment, err := c.attachmentService.Upload(ctx.Request(), entityType, entityID, username, file)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, attachment)
+	return nil
+}
+
+func (c *Controller) getAttachments(ctx echo.Context, entityType models.AttachmentEntity, entityID, username string, version *int32) error {
+	var v int32
+	if version != nil {
+		v = *version
+	}
+
+	attachments, err := c.attachmentService.GetAttachments(ctx.Request(), entityType, entityID, username, v)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, attachments)
+	return nil
+}
+
+func (c *Controller) downloadAttachment(ctx echo.Context, entityType models.AttachmentEntity, entityID, attachmentID, username string) error {
+	attachment, content, err := c.attachmentService.Download(ctx.Request(), entityType, entityID, attachmentID, username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+	defer content.Close()
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", attachment.FileName))
+	ctx.Response().Header().Set("Digest", "sha-256="+attachment.Checksum)
+	return ctx.Stream(http.StatusOK, attachment.ContentType, content)
+}
+
+func (c *Controller) deleteAttachment(ctx echo.Context, entityType models.AttachmentEntity, entityID, attachmentID, username string) error {
+	attachment, err := c.attachmentService.Delete(ctx.Request(), entityType, entityID, attachmentID, username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, attachment)
+	return nil
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+)
+
+// ScheduleAuction (PUT /tenders/{tenderId}/auction).
+func (c *Controller) ScheduleAuction(ctx echo.Context, tenderID TenderId, params ScheduleAuctionParams) error {
+	var body ScheduleAuctionJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	parsedTenderID, err := uuid.Parse(tenderID)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	auction := models.Auction{
+		TenderID:        parsedTenderID,
+		StartsAt:        body.StartsAt,
+		DurationSeconds: body.DurationSeconds,
+		MinStep:         body.MinStep,
+		StartPrice:      body.StartPrice,
+	}
+	if body.ExtensionSeconds != nil {
+		auction.ExtensionSeconds = *body.ExtensionSeconds
+	}
+
+	newAuction, err := c.auctionService.ScheduleAuction(ctx.Request(), &auction, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newAuction)
+	return nil
+}
+
+// GetAuction (GET /tenders/{tenderId}/auction).
+func (c *Controller) GetAuction(ctx echo.Context, tenderID TenderId, params GetAuctionParams) error {
+	state, err := c.auctionService.GetAuction(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, state)
+	return nil
+}
+
+// StreamAuction (GET /tenders/{tenderId}/auction/stream).
+func (c *Controller) StreamAuction(ctx echo.Context, tenderID TenderId, params StreamAuctionParams) error {
+	started := false
+	err := c.auctionService.WatchAuction(ctx.Request(), tenderID, params.Username, func(state models.AuctionState) error {
+		if !started {
+			// Поток живёт дольше WriteTimeout сервера.
+			if err := http.NewResponseController(ctx.Response()).SetWriteDeadline(time.Time{}); err != nil {
+				return err
+			}
+			ctx.Response().Header().Set(echo.HeaderContentType, "text/event-stream")
+			ctx.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+			ctx.Response().WriteHeader(http.StatusOK)
+			started = true
+		}
+
+		data, err := json.Marshal(state)
+		if err != nil {
+			return err
+		}
+		if _, err = fmt.Fprintf(ctx.Response(), "event: auction\ndata: %s\n\n", data); err != nil {
+			return err
+		}
+		ctx.Response().Flush()
+		return nil
+	})
+	if err != nil && !started {
+		return InternalError(ctx, err)
+	}
+
+	return err
+}
+
+// SubmitAuctionPrice (PUT /bids/{bidId}/auction/price).
+func (c *Controller) SubmitAuctionPrice(ctx echo.Context, bidID BidId, params SubmitAuctionPriceParams) error {
+	var body SubmitAuctionPriceJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	price, err := c.auctionService.SubmitPrice(ctx.Request(), bidID, body.Price, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, price)
+	return nil
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"io"
+	"net/http"
+	"strings"
+	"zadanie-6105/internal/models"
+)
+
+// AuditMiddleware Записывает в журнал аудита все изменяющие запросы и все отказы в доступе.
+func (c *Controller) AuditMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		actor := auditActor(ctx.Request())
+
+		err := next(ctx)
+
+		status := ctx.Response().Status
+		if err != nil && !ctx.Response().Committed {
+			status = http.StatusInternalServerError
+			var he *echo.HTTPError
+			if errors.As(err, &he) {
+				status = he.Code
+			}
+		}
+
+		outcome := models.AuditOutcomeFromStatus(status)
+		if ctx.Request().Method == http.MethodGet && outcome != models.AuditDenied {
+			return err
+		}
+
+		entry := models.AuditEntry{
+			Actor:     actor,
+			RequestID: ctx.Response().Header().Get(echo.HeaderXRequestID),
+			ClientIP:  ctx.RealIP(),
+			Action:    ctx.Request().Method + " " + ctx.Path(),
+			Target:    auditTarget(ctx),
+			Outcome:   outcome,
+			Status:    status,
+		}
+		if recErr := c.auditService.Record(ctx.Request().Context(), &entry); recErr != nil {
+			c.zapLogger.Errorf("audit: %v", recErr)
+		}
+
+		return err
+	}
+}
+
+// auditActor Достаёт пользователя из query или из JSON-тела, не вычитывая тело для обработчика.
+func auditActor(r *http.Request) string {
+	for _, param := range []string{"username", "requesterUsername"} {
+		if v := r.URL.Query().Get(param); v != "" {
+			return v
+		}
+	}
+
+	if r.Body == nil || !strings.HasPrefix(r.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return ""
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var author struct {
+		CreatorUsername string `json:"creatorUsername"`
+		AuthorID        string `json:"authorId"`
+	}
+	if err = json.Unmarshal(body, &author); err != nil {
+		return ""
+	}
+	if author.CreatorUsername != "" {
+		return author.CreatorUsername
+	}
+
+	return author.AuthorID
+}
+
+// auditTarget Первый path-параметр маршрута - идентификатор тендера или предложения.
+func auditTarget(ctx echo.Context) string {
+	names := ctx.ParamNames()
+	if len(names) == 0 {
+		return ""
+	}
+
+	return ctx.Param(names[0])
+}
+
+// GetAuditLog (GET /audit).
+func (c *Controller) GetAuditLog(ctx echo.Context, params GetAuditLogParams) error {
+	var offset, limit int32 = 0, 5
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	entries, err := c.auditService.GetAuditLog(ctx.Request(), params.Username, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+	return nil
+}
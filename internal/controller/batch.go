@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// checkBatchPath echo читает ":batch" в "/tenders:batch" как параметр пути с именем batch,
+// поэтому маршрут совпадает и с "/tendersfoo". Отвечаем 404 на всё, кроме ":batch".
+func checkBatchPath(ctx echo.Context) error {
+	if ctx.Param("batch") != ":batch" {
+		return echo.ErrNotFound
+	}
+
+	return nil
+}
+
+// batchTender Данные создаваемого тендера. Операция с некорректной организацией остаётся без тендера
+// и завершается ошибкой InvalidBatchOp, не прерывая разбор остальных.
+func batchTender(body BatchTendersJSONBody, i int) *models.Tender {
+	item := body.Operations[i].Tender
+	if item == nil {
+		return nil
+	}
+
+	organizationID, err := uuid.Parse(item.OrganizationId)
+	if err != nil {
+		return nil
+	}
+
+	tender := models.Tender{
+		Name:           item.Name,
+		Description:    item.Description,
+		ServiceType:    models.ServiceType(item.ServiceType),
+		Status:         models.TenderStatus(item.Status),
+		OrganizationID: organizationID,
+		OpeningAt:      item.OpeningAt,
+	}
+	if item.Sealed != nil {
+		tender.Sealed = *item.Sealed
+	}
+	if item.TwoEnvelope != nil {
+		tender.TwoEnvelope = *item.TwoEnvelope
+	}
+	if item.Private != nil {
+		tender.Private = *item.Private
+	}
+
+	return &tender
+}
+
+// BatchTenders (POST /tenders:batch).
+func (c *Controller) BatchTenders(ctx echo.Context, params BatchTendersParams) error {
+	if err := checkBatchPath(ctx); err != nil {
+		return err
+	}
+
+	var body BatchTendersJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	ops := make([]models.TenderOperation, len(body.Operations))
+	for i, item := range body.Operations {
+		ops[i] = models.TenderOperation{Op: models.BatchOp(item.Op), Tender: batchTender(body, i)}
+		if item.TenderId != nil {
+			ops[i].TenderID = *item.TenderId
+		}
+	}
+
+	atomic := body.Atomic != nil && *body.Atomic
+	result, err := c.tenderService.ExecuteTenderBatch(ctx.Request(), ops, atomic, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, result)
+	return nil
+}
+
+// BatchBids (POST /bids:batch).
+func (c *Controller) BatchBids(ctx echo.Context, params BatchBidsParams) error {
+	if err := checkBatchPath(ctx); err != nil {
+		return err
+	}
+
+	var body BatchBidsJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	ops := make([]models.BidOperation, len(body.Operations))
+	for i, item := range body.Operations {
+		ops[i] = models.BidOperation{Op: models.BatchOp(item.Op), BidID: item.BidId}
+		if item.Decision != nil {
+			ops[i].Decision = models.BidDecision(*item.Decision)
+		}
+	}
+
+	atomic := body.Atomic != nil && *body.Atomic
+	result, err := c.bidService.ExecuteBidBatch(ctx.Request(), ops, atomic, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, result)
+	return nil
+}
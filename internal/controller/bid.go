@@ -2,6 +2,7 @@ package controller
 
 import (
 	"errors"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"net/http"
 	"zadanie-6105/internal/models"
@@ -128,7 +129,13 @@ func (c *Controller) SubmitBidDecision(ctx echo.Context, bidID BidId, params Sub
 
 // SubmitBidFeedback (PUT /bids/{bidId}/feedback).
 func (c *Controller) SubmitBidFeedback(ctx echo.Context, bidID BidId, params SubmitBidFeedbackParams) error {
-	status, err := c.bidService.SubmitBidFeedback(ctx.Request(), bidID, params.BidFeedback, params.Username)
+	var rating *int
+	if params.Rating != nil {
+		value := int(*params.Rating)
+		rating = &value
+	}
+
+	status, err := c.bidService.SubmitBidFeedback(ctx.Request(), bidID, params.BidFeedback, params.Username, rating, params.Completed)
 	if err != nil {
 		return InternalError(ctx, err)
 	}
@@ -148,8 +155,8 @@ func (c *Controller) RollbackBid(ctx echo.Context, bidID BidId, version int32, p
 	return nil
 }
 
-// GetBidReviews (GET /bids/{tenderId}/reviews).
-func (c *Controller) GetBidReviews(ctx echo.Context, tenderID TenderId, params GetBidReviewsParams) error {
+// GetBidReviews (GET /bids/{id}/reviews).
+func (c *Controller) GetBidReviews(ctx echo.Context, id string, params GetBidReviewsParams) error {
 	var offset, limit int32 = 0, 5
 	if params.Offset != nil {
 		offset = *params.Offset
@@ -158,11 +165,66 @@ func (c *Controller) GetBidReviews(ctx echo.Context, tenderID TenderId, params G
 		limit = *params.Limit
 	}
 
-	reviews, err := c.bidService.GetBidReviews(ctx.Request(), tenderID, params.AuthorUsername, params.RequesterUsername, offset, limit)
+	var reviews []models.Review
+	var err error
+	if params.AuthorUsername != nil {
+		reviews, err = c.bidService.GetBidReviews(ctx.Request(), id, *params.AuthorUsername, params.RequesterUsername, offset, limit)
+	} else {
+		reviews, err = c.bidService.GetReviewsForBid(ctx.Request(), id, params.RequesterUsername, offset, limit)
+	}
 	if err != nil {
 		return InternalError(ctx, err)
 	}
 
 	ctx.JSON(http.StatusOK, reviews)
 	return nil
-}
\ No newline at end of file
+}
+
+// OpenBids (PUT /bids/{tenderId}/open).
+func (c *Controller) OpenBids(ctx echo.Context, tenderID TenderId, params OpenBidsParams) error {
+	opening, err := c.bidService.OpenBids(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, opening)
+	return nil
+}
+
+// GetBidOpening (GET /bids/{tenderId}/opening).
+func (c *Controller) GetBidOpening(ctx echo.Context, tenderID TenderId, params GetBidOpeningParams) error {
+	opening, err := c.bidService.GetBidOpening(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, opening)
+	return nil
+}
+
+// QualifyBid (PUT /bids/{bidId}/qualification).
+func (c *Controller) QualifyBid(ctx echo.Context, bidID BidId, params QualifyBidParams) error {
+	var body QualifyBidJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	parsedBidID, err := uuid.Parse(bidID)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	record := models.QualificationRecord{
+		BidID:         parsedBidID,
+		Qualification: models.BidQualification(body.Qualification),
+		Reason:        body.Reason,
+	}
+
+	newRecord, err := c.bidService.QualifyBid(ctx.Request(), bidID, &record, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newRecord)
+	return nil
+}
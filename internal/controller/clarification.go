@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+// AskClarification (POST /tenders/{tenderId}/clarifications).
+func (c *Controller) AskClarification(ctx echo.Context, tenderID TenderId, params AskClarificationParams) error {
+	var body AskClarificationJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	clarification, err := c.tenderService.AskClarification(ctx.Request(), tenderID, body.Question, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, clarification)
+	return nil
+}
+
+// GetClarifications (GET /tenders/{tenderId}/clarifications).
+func (c *Controller) GetClarifications(ctx echo.Context, tenderID TenderId, params GetClarificationsParams) error {
+	var offset, limit int32 = 0, 5
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	clarifications, err := c.tenderService.GetClarifications(ctx.Request(), tenderID, params.Username, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, clarifications)
+	return nil
+}
+
+// AnswerClarification (PUT /tenders/{tenderId}/clarifications/{clarificationId}/answer).
+func (c *Controller) AnswerClarification(ctx echo.Context, tenderID TenderId, clarificationID ClarificationId, params AnswerClarificationParams) error {
+	var body AnswerClarificationJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	publish := body.Publish != nil && *body.Publish
+	bumpVersion := body.BumpVersion != nil && *body.BumpVersion
+
+	clarification, err := c.tenderService.AnswerClarification(ctx.Request(), tenderID, clarificationID, body.Answer, params.Username, publish, bumpVersion)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, clarification)
+	return nil
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// CreateContract (POST /contracts/new).
+func (c *Controller) CreateContract(ctx echo.Context, params CreateContractParams) error {
+	var body CreateContractJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	contract, err := c.contractService.CreateContract(ctx.Request(), body.BidId, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, contract)
+	return nil
+}
+
+// GetUserContracts (GET /contracts/my).
+func (c *Controller) GetUserContracts(ctx echo.Context, params GetUserContractsParams) error {
+	var offset, limit int32 = 0, 5
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	contracts, err := c.contractService.GetUserContracts(ctx.Request(), params.Username, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, contracts)
+	return nil
+}
+
+// GetContract (GET /contracts/{contractId}).
+func (c *Controller) GetContract(ctx echo.Context, contractID ContractId, params GetContractParams) error {
+	contract, err := c.contractService.GetContract(ctx.Request(), contractID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, contract)
+	return nil
+}
+
+// CloseContract (PUT /contracts/{contractId}/close).
+func (c *Controller) CloseContract(ctx echo.Context, contractID ContractId, params CloseContractParams) error {
+	var body CloseContractJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	var rating *int
+	if body.Rating != nil {
+		value := int(*body.Rating)
+		rating = &value
+	}
+
+	contract, err := c.contractService.CloseContract(ctx.Request(), contractID, models.ContractStatus(body.Status), body.Feedback, rating, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, contract)
+	return nil
+}
+
+// AddMilestone (POST /contracts/{contractId}/milestones).
+func (c *Controller) AddMilestone(ctx echo.Context, contractID ContractId, params AddMilestoneParams) error {
+	var body AddMilestoneJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	parsedContractID, err := uuid.Parse(contractID)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	milestone := models.Milestone{
+		ContractID:  parsedContractID,
+		Deliverable: body.Deliverable,
+		Description: body.Description,
+		DueDate:     body.DueDate.Time,
+		Amount:      body.Amount,
+	}
+
+	newMilestone, err := c.contractService.AddMilestone(ctx.Request(), &milestone, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newMilestone)
+	return nil
+}
+
+// GetMilestones (GET /contracts/{contractId}/milestones).
+func (c *Controller) GetMilestones(ctx echo.Context, contractID ContractId, params GetMilestonesParams) error {
+	milestones, err := c.contractService.GetMilestones(ctx.Request(), contractID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, milestones)
+	return nil
+}
+
+// UpdateMilestoneStatus (PUT /contracts/{contractId}/milestones/{milestoneId}/status).
+func (c *Controller) UpdateMilestoneStatus(ctx echo.Context, contractID ContractId, milestoneID MilestoneId, params UpdateMilestoneStatusParams) error {
+	milestone, err := c.contractService.UpdateMilestoneStatus(ctx.Request(), contractID, milestoneID,
+		models.MilestoneStatus(params.Status), params.Comment, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, milestone)
+	return nil
+}
+
+// DecideMilestone (PUT /contracts/{contractId}/milestones/{milestoneId}/decision).
+func (c *Controller) DecideMilestone(ctx echo.Context, contractID ContractId, milestoneID MilestoneId, params DecideMilestoneParams) error {
+	milestone, err := c.contractService.DecideMilestone(ctx.Request(), contractID, milestoneID,
+		models.MilestoneStatus(params.Decision), params.Comment, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, milestone)
+	return nil
+}
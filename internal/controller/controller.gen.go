@@ -1,6 +1,6 @@
 // Package controller provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package controller
 
 import (
@@ -12,16 +12,45 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AttachmentEntityType.
+const (
+	AttachmentEntityTypeBid    AttachmentEntityType = "Bid"
+	AttachmentEntityTypeTender AttachmentEntityType = "Tender"
+)
+
+// Defines values for AuctionStatus.
+const (
+	AuctionStatusClosed    AuctionStatus = "Closed"
+	AuctionStatusRunning   AuctionStatus = "Running"
+	AuctionStatusScheduled AuctionStatus = "Scheduled"
+)
+
+// Defines values for AuctionStateStatus.
+const (
+	AuctionStateStatusClosed    AuctionStateStatus = "Closed"
+	AuctionStateStatusRunning   AuctionStateStatus = "Running"
+	AuctionStateStatusScheduled AuctionStateStatus = "Scheduled"
+)
+
+// Defines values for AuditEntryOutcome.
+const (
+	Denied  AuditEntryOutcome = "Denied"
+	Failed  AuditEntryOutcome = "Failed"
+	Success AuditEntryOutcome = "Success"
+)
+
 // Defines values for BidAuthorType.
 const (
 	Organization BidAuthorType = "Organization"
@@ -34,6 +63,12 @@ const (
 	BidDecisionRejected BidDecision = "Rejected"
 )
 
+// Defines values for BidQualification.
+const (
+	Disqualified BidQualification = "Disqualified"
+	Qualified    BidQualification = "Qualified"
+)
+
 // Defines values for BidStatus.
 const (
 	BidStatusApproved  BidStatus = "Approved"
@@ -43,6 +78,82 @@ const (
 	BidStatusRejected  BidStatus = "Rejected"
 )
 
+// Defines values for ClarificationVisibility.
+const (
+	Private ClarificationVisibility = "Private"
+	Public  ClarificationVisibility = "Public"
+)
+
+// Defines values for ContractStatus.
+const (
+	ContractStatusActive     ContractStatus = "Active"
+	ContractStatusCompleted  ContractStatus = "Completed"
+	ContractStatusTerminated ContractStatus = "Terminated"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv  ExportFormat = "csv"
+	ExportFormatXlsx ExportFormat = "xlsx"
+)
+
+// Defines values for ImportFormat.
+const (
+	ImportFormatCsv    ImportFormat = "csv"
+	ImportFormatNdjson ImportFormat = "ndjson"
+)
+
+// Defines values for ImportReportFormat.
+const (
+	Csv  ImportReportFormat = "csv"
+	Json ImportReportFormat = "json"
+	Xlsx ImportReportFormat = "xlsx"
+)
+
+// Defines values for InvitationStatus.
+const (
+	InvitationStatusAccepted InvitationStatus = "Accepted"
+	InvitationStatusDeclined InvitationStatus = "Declined"
+	InvitationStatusPending  InvitationStatus = "Pending"
+)
+
+// Defines values for LotStatus.
+const (
+	LotStatusAwarded   LotStatus = "Awarded"
+	LotStatusCancelled LotStatus = "Cancelled"
+	LotStatusOpen      LotStatus = "Open"
+)
+
+// Defines values for MilestoneStatus.
+const (
+	MilestoneStatusAccepted   MilestoneStatus = "Accepted"
+	MilestoneStatusInProgress MilestoneStatus = "InProgress"
+	MilestoneStatusPending    MilestoneStatus = "Pending"
+	MilestoneStatusRejected   MilestoneStatus = "Rejected"
+	MilestoneStatusSubmitted  MilestoneStatus = "Submitted"
+)
+
+// Defines values for NegotiationRoundParty.
+const (
+	Customer NegotiationRoundParty = "Customer"
+	Supplier NegotiationRoundParty = "Supplier"
+)
+
+// Defines values for NegotiationRoundStatus.
+const (
+	NegotiationRoundStatusAccepted  NegotiationRoundStatus = "Accepted"
+	NegotiationRoundStatusCountered NegotiationRoundStatus = "Countered"
+	NegotiationRoundStatusOpen      NegotiationRoundStatus = "Open"
+	NegotiationRoundStatusRejected  NegotiationRoundStatus = "Rejected"
+)
+
+// Defines values for OrganizationType.
+const (
+	IE  OrganizationType = "IE"
+	JSC OrganizationType = "JSC"
+	LLC OrganizationType = "LLC"
+)
+
 // Defines values for TenderServiceType.
 const (
 	Construction TenderServiceType = "Construction"
@@ -52,35 +163,239 @@ const (
 
 // Defines values for TenderStatus.
 const (
-	Closed    TenderStatus = "Closed"
-	Created   TenderStatus = "Created"
-	Published TenderStatus = "Published"
+	Closed               TenderStatus = "Closed"
+	CommercialEvaluation TenderStatus = "CommercialEvaluation"
+	Created              TenderStatus = "Created"
+	Published            TenderStatus = "Published"
+	TechnicalEvaluation  TenderStatus = "TechnicalEvaluation"
 )
 
+// Defines values for BatchBidsJSONBodyOperationsOp.
+const (
+	Decide BatchBidsJSONBodyOperationsOp = "decide"
+)
+
+// Defines values for CloseContractJSONBodyStatus.
+const (
+	CloseContractJSONBodyStatusCompleted  CloseContractJSONBodyStatus = "Completed"
+	CloseContractJSONBodyStatusTerminated CloseContractJSONBodyStatus = "Terminated"
+)
+
+// Defines values for DecideMilestoneParamsDecision.
+const (
+	Accepted DecideMilestoneParamsDecision = "Accepted"
+	Rejected DecideMilestoneParamsDecision = "Rejected"
+)
+
+// Defines values for UpdateMilestoneStatusParamsStatus.
+const (
+	InProgress UpdateMilestoneStatusParamsStatus = "InProgress"
+	Submitted  UpdateMilestoneStatusParamsStatus = "Submitted"
+)
+
+// Defines values for BatchTendersJSONBodyOperationsOp.
+const (
+	Close   BatchTendersJSONBodyOperationsOp = "close"
+	Create  BatchTendersJSONBodyOperationsOp = "create"
+	Publish BatchTendersJSONBodyOperationsOp = "publish"
+)
+
+// Attachment Вложение тендера или предложения
+type Attachment struct {
+	// Checksum SHA-256 содержимого в hex.
+	Checksum    string               `json:"checksum"`
+	ContentType string               `json:"contentType"`
+	CreatedAt   string               `json:"createdAt"`
+	EntityId    string               `json:"entityId"`
+	EntityType  AttachmentEntityType `json:"entityType"`
+	FileName    string               `json:"fileName"`
+
+	// Id Уникальный идентификатор вложения, присвоенный сервером.
+	Id   AttachmentId `json:"id"`
+	Size int64        `json:"size"`
+
+	// UploadedBy Уникальный slug пользователя.
+	UploadedBy *Username `json:"uploadedBy,omitempty"`
+
+	// VersionFrom Версия сущности, начиная с которой вложение видно.
+	VersionFrom int `json:"versionFrom"`
+
+	// VersionTo Версия сущности, начиная с которой вложение скрыто.
+	VersionTo *int `json:"versionTo,omitempty"`
+}
+
+// AttachmentEntityType defines model for Attachment.EntityType.
+type AttachmentEntityType string
+
+// AttachmentId Уникальный идентификатор вложения, присвоенный сервером.
+type AttachmentId = string
+
+// Auction Редукцион по тендеру
+type Auction struct {
+	BestPrice       *float32 `json:"bestPrice,omitempty"`
+	ClosedAt        *string  `json:"closedAt,omitempty"`
+	CreatedAt       string   `json:"createdAt"`
+	DurationSeconds int      `json:"durationSeconds"`
+
+	// EndsAt Текущее время окончания с учётом продлений
+	EndsAt           string        `json:"endsAt"`
+	ExtensionSeconds int           `json:"extensionSeconds"`
+	MinStep          float32       `json:"minStep"`
+	StartPrice       *float32      `json:"startPrice,omitempty"`
+	StartsAt         string        `json:"startsAt"`
+	Status           AuctionStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// WinnerBidId Уникальный идентификатор предложения, присвоенный сервером.
+	WinnerBidId *BidId `json:"winnerBidId,omitempty"`
+}
+
+// AuctionStatus defines model for Auction.Status.
+type AuctionStatus string
+
+// AuctionPrice Цена, поданная в аукционе
+type AuctionPrice struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId     BidId   `json:"bidId"`
+	CreatedAt string  `json:"createdAt"`
+	Id        string  `json:"id"`
+	Price     float32 `json:"price"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// AuctionState defines model for auctionState.
+type AuctionState struct {
+	BestPrice       *float32 `json:"bestPrice,omitempty"`
+	ClosedAt        *string  `json:"closedAt,omitempty"`
+	CreatedAt       string   `json:"createdAt"`
+	DurationSeconds int      `json:"durationSeconds"`
+
+	// EndsAt Текущее время окончания с учётом продлений
+	EndsAt           string  `json:"endsAt"`
+	ExtensionSeconds int     `json:"extensionSeconds"`
+	MinStep          float32 `json:"minStep"`
+	Ranking          []struct {
+		// BidId Уникальный идентификатор предложения, присвоенный сервером.
+		BidId *BidId  `json:"bidId,omitempty"`
+		Own   *bool   `json:"own,omitempty"`
+		Price float32 `json:"price"`
+		Rank  int     `json:"rank"`
+	} `json:"ranking"`
+	StartPrice *float32           `json:"startPrice,omitempty"`
+	StartsAt   string             `json:"startsAt"`
+	Status     AuctionStateStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// WinnerBidId Уникальный идентификатор предложения, присвоенный сервером.
+	WinnerBidId *BidId `json:"winnerBidId,omitempty"`
+}
+
+// AuctionStateStatus defines model for AuctionState.Status.
+type AuctionStateStatus string
+
+// AuditEntry Запись журнала аудита
+type AuditEntry struct {
+	// Action Метод и маршрут запроса.
+	Action string `json:"action"`
+
+	// Actor Уникальный slug пользователя.
+	Actor     Username `json:"actor"`
+	ClientIp  string   `json:"clientIp"`
+	CreatedAt string   `json:"createdAt"`
+	Hash      string   `json:"hash"`
+	Id        string   `json:"id"`
+	Linked    bool     `json:"linked"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId *OrganizationId   `json:"organizationId,omitempty"`
+	Outcome        AuditEntryOutcome `json:"outcome"`
+	PrevHash       string            `json:"prevHash"`
+	RequestId      string            `json:"requestId"`
+	Status         int               `json:"status"`
+
+	// Target Идентификатор тендера или предложения.
+	Target   *string `json:"target,omitempty"`
+	Verified bool    `json:"verified"`
+}
+
+// AuditEntryOutcome defines model for AuditEntry.Outcome.
+type AuditEntryOutcome string
+
+// BatchResult Итоги пакета операций
+type BatchResult struct {
+	Atomic bool `json:"atomic"`
+
+	// Committed Сохранены ли успешные операции. В атомарном режиме false, если хотя бы одна операция не выполнена.
+	Committed bool `json:"committed"`
+	Results   []struct {
+		Error *string `json:"error,omitempty"`
+
+		// Index Номер операции в пакете, начиная с 0
+		Index int `json:"index"`
+
+		// Result Тендер или предложение после успешной операции
+		Result *map[string]interface{} `json:"result,omitempty"`
+
+		// Status HTTP-статус, с которым завершился бы одиночный запрос
+		Status  int  `json:"status"`
+		Success bool `json:"success"`
+	} `json:"results"`
+}
+
 // Bid Информация о предложении
 type Bid struct {
 	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
 	AuthorId BidAuthorId `json:"authorId"`
 
+	// AuthorReputation Репутация автора предложений или организации. Рассчитывается по отзывам и решениям по предложениям.
+	// Доли отсутствуют, пока не на что опереться.
+	AuthorReputation *Reputation `json:"authorReputation,omitempty"`
+
 	// AuthorType Тип автора
 	AuthorType BidAuthorType `json:"authorType"`
 
+	// CommercialProposal Коммерческая часть предложения. В двухконвертном тендере видна Ответственным вместе с ценой
+	// только на этапе CommercialEvaluation и только у допущенных предложений.
+	CommercialProposal *BidCommercialProposal `json:"commercialProposal,omitempty"`
+
 	// CreatedAt Серверная дата и время в момент, когда пользователь отправил предложение на создание.
 	// Передается в формате RFC3339.
 	CreatedAt string `json:"createdAt"`
 
+	// Debarred Автор предложения или его организация сейчас отстранены. Заполняется в списке предложений тендера.
+	Debarred *bool `json:"debarred,omitempty"`
+
 	// Description Описание предложения
 	Description BidDescription `json:"description"`
 
 	// Id Уникальный идентификатор предложения, присвоенный сервером.
-	Id BidId `json:"id"`
+	Id     BidId    `json:"id"`
+	LotIds *[]LotId `json:"lotIds,omitempty"`
 
 	// Name Полное название предложения
 	Name BidName `json:"name"`
 
+	// Price Цена предложения
+	Price *BidPrice `json:"price,omitempty"`
+
+	// Qualification Решение по технической части предложения
+	Qualification *BidQualification `json:"qualification,omitempty"`
+
+	// Sealed Предложение запечатано, название, описание и цена скрыты до вскрытия.
+	Sealed *bool `json:"sealed,omitempty"`
+
 	// Status Статус предложения
 	Status BidStatus `json:"status"`
 
+	// TechnicalProposal Техническая часть предложения
+	TechnicalProposal *BidTechnicalProposal `json:"technicalProposal,omitempty"`
+
 	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
 	TenderId TenderId `json:"tenderId"`
 
@@ -94,23 +409,159 @@ type BidAuthorId = string
 // BidAuthorType Тип автора
 type BidAuthorType string
 
+// BidCommercialProposal Коммерческая часть предложения. В двухконвертном тендере видна Ответственным вместе с ценой
+// только на этапе CommercialEvaluation и только у допущенных предложений.
+type BidCommercialProposal = string
+
 // BidDecision Решение по предложению
 type BidDecision string
 
 // BidDescription Описание предложения
 type BidDescription = string
 
+// BidDraft Черновик правки предложения. Незаданные поля при публикации не меняются.
+// Если baseVersion отличается от currentVersion, черновик устарел.
+type BidDraft struct {
+	// BaseVersion Версия предложения при последнем сохранении черновика
+	BaseVersion int `json:"baseVersion"`
+
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// CommercialProposal Коммерческая часть предложения. В двухконвертном тендере видна Ответственным вместе с ценой
+	// только на этапе CommercialEvaluation и только у допущенных предложений.
+	CommercialProposal *BidCommercialProposal `json:"commercialProposal,omitempty"`
+
+	// CurrentVersion Текущая версия предложения
+	CurrentVersion int `json:"currentVersion"`
+
+	// Description Описание предложения
+	Description *BidDescription `json:"description,omitempty"`
+
+	// Name Полное название предложения
+	Name *BidName `json:"name,omitempty"`
+
+	// Price Цена предложения
+	Price   *BidPrice `json:"price,omitempty"`
+	SavedAt time.Time `json:"savedAt"`
+
+	// SavedBy Уникальный slug пользователя.
+	SavedBy *Username `json:"savedBy,omitempty"`
+
+	// TechnicalProposal Техническая часть предложения
+	TechnicalProposal *BidTechnicalProposal `json:"technicalProposal,omitempty"`
+}
+
 // BidFeedback Отзыв на предложение
 type BidFeedback = string
 
+// BidHistoryVersion defines model for bidHistoryVersion.
+type BidHistoryVersion struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// AuthorReputation Репутация автора предложений или организации. Рассчитывается по отзывам и решениям по предложениям.
+	// Доли отсутствуют, пока не на что опереться.
+	AuthorReputation *Reputation `json:"authorReputation,omitempty"`
+
+	// AuthorType Тип автора
+	AuthorType BidAuthorType `json:"authorType"`
+
+	// CommercialProposal Коммерческая часть предложения. В двухконвертном тендере видна Ответственным вместе с ценой
+	// только на этапе CommercialEvaluation и только у допущенных предложений.
+	CommercialProposal *BidCommercialProposal `json:"commercialProposal,omitempty"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил предложение на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Debarred Автор предложения или его организация сейчас отстранены. Заполняется в списке предложений тендера.
+	Debarred *bool `json:"debarred,omitempty"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// Id Уникальный идентификатор предложения, присвоенный сервером.
+	Id     BidId    `json:"id"`
+	LotIds *[]LotId `json:"lotIds,omitempty"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// NegotiationRound Номер раунда переговоров, принятием которого создана версия
+	NegotiationRound *int `json:"negotiationRound,omitempty"`
+
+	// Price Цена предложения
+	Price *BidPrice `json:"price,omitempty"`
+
+	// Qualification Решение по технической части предложения
+	Qualification *BidQualification `json:"qualification,omitempty"`
+
+	// Sealed Предложение запечатано, название, описание и цена скрыты до вскрытия.
+	Sealed *bool `json:"sealed,omitempty"`
+
+	// Status Статус предложения
+	Status BidStatus `json:"status"`
+
+	// TechnicalProposal Техническая часть предложения
+	TechnicalProposal *BidTechnicalProposal `json:"technicalProposal,omitempty"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// Version Номер версии посел правок
+	Version BidVersion `json:"version"`
+}
+
 // BidId Уникальный идентификатор предложения, присвоенный сервером.
 type BidId = string
 
 // BidName Полное название предложения
 type BidName = string
 
+// BidOpening Вскрытие запечатанных предложений тендера
+type BidOpening struct {
+	// BidsCount Количество вскрытых предложений
+	BidsCount int    `json:"bidsCount"`
+	OpenedAt  string `json:"openedAt"`
+
+	// OpenedBy Уникальный slug пользователя.
+	OpenedBy Username `json:"openedBy"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// BidPrice Цена предложения
+type BidPrice = float64
+
+// BidQualification Решение по технической части предложения
+type BidQualification string
+
+// BidRanking Место предложения в рейтинге тендера
+type BidRanking struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// BidName Полное название предложения
+	BidName BidName `json:"bidName"`
+
+	// Decision Решение по предложению
+	Decision *BidDecision `json:"decision,omitempty"`
+	Rank     int          `json:"rank"`
+
+	// Scorers Количество ответственных, выставивших оценки
+	Scorers int `json:"scorers"`
+
+	// WeightedTotal Взвешенная оценка от 0 до 100
+	WeightedTotal float32 `json:"weightedTotal"`
+}
+
 // BidReview Отзыв о предложении
 type BidReview struct {
+	// Completed Контракт по предложению исполнен
+	Completed *bool `json:"completed,omitempty"`
+
 	// CreatedAt Серверная дата и время в момент, когда пользователь отправил отзыв на предложение.
 	// Передается в формате RFC3339.
 	CreatedAt string `json:"createdAt"`
@@ -118,8 +569,32 @@ type BidReview struct {
 	// Description Описание предложения
 	Description BidReviewDescription `json:"description"`
 
+	// HiddenAt Время скрытия отзыва модератором в формате RFC3339
+	HiddenAt *time.Time `json:"hiddenAt,omitempty"`
+
+	// HiddenBy Уникальный slug пользователя.
+	HiddenBy *Username `json:"hiddenBy,omitempty"`
+
+	// HiddenReason Причина скрытия отзыва модератором
+	HiddenReason *string `json:"hiddenReason,omitempty"`
+
 	// Id Уникальный идентификатор отзыва, присвоенный сервером.
 	Id BidReviewId `json:"id"`
+
+	// Rating Оценка автора предложения
+	Rating *BidReviewRating `json:"rating,omitempty"`
+
+	// RepliedAt Время ответа автора предложения в формате RFC3339
+	RepliedAt *time.Time `json:"repliedAt,omitempty"`
+
+	// Reply Публичный ответ автора предложения на отзыв
+	Reply *BidReviewReply `json:"reply,omitempty"`
+
+	// ReplyAuthorUsername Уникальный slug пользователя.
+	ReplyAuthorUsername *Username `json:"replyAuthorUsername,omitempty"`
+
+	// UpdatedAt Время последней правки отзыва в формате RFC3339
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
 // BidReviewDescription Описание предложения
@@ -128,398 +603,5001 @@ type BidReviewDescription = string
 // BidReviewId Уникальный идентификатор отзыва, присвоенный сервером.
 type BidReviewId = string
 
+// BidReviewRating Оценка автора предложения
+type BidReviewRating = int32
+
+// BidReviewReply Публичный ответ автора предложения на отзыв
+type BidReviewReply = string
+
+// BidScore Оценка предложения одним ответственным по одному критерию
+type BidScore struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId     BidId   `json:"bidId"`
+	Comment   *string `json:"comment,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+
+	// CriterionId Уникальный идентификатор критерия оценки, присвоенный сервером.
+	CriterionId CriterionId `json:"criterionId"`
+	Id          string      `json:"id"`
+	Score       int         `json:"score"`
+
+	// ScorerUsername Уникальный slug пользователя.
+	ScorerUsername Username `json:"scorerUsername"`
+	UpdatedAt      *string  `json:"updatedAt,omitempty"`
+}
+
 // BidStatus Статус предложения
 type BidStatus string
 
+// BidTechnicalProposal Техническая часть предложения
+type BidTechnicalProposal = string
+
 // BidVersion Номер версии посел правок
 type BidVersion = int32
 
-// ErrorResponse Используется для возвращения ошибки пользователю
-type ErrorResponse struct {
-	// Reason Описание ошибки в свободной форме
-	Reason string `json:"reason"`
-}
+// Certificate Сертификат организации
+type Certificate struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
-type OrganizationId = string
+	// CreatedBy Уникальный slug пользователя.
+	CreatedBy *Username `json:"createdBy,omitempty"`
 
-// Tender Информация о тендере
-type Tender struct {
-	// CreatedAt Серверная дата и время в момент, когда пользователь отправил тендер на создание.
-	// Передается в формате RFC3339.
-	CreatedAt string `json:"createdAt"`
+	// Id Уникальный идентификатор сертификата, присвоенный сервером.
+	Id   CertificateId `json:"id"`
+	Name string        `json:"name"`
 
-	// Description Описание тендера
-	Description TenderDescription `json:"description"`
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId      `json:"organizationId"`
+	ValidUntil     *openapi_types.Date `json:"validUntil,omitempty"`
+	VerifiedAt     *time.Time          `json:"verifiedAt,omitempty"`
 
-	// Id Уникальный идентификатор тендера, присвоенный сервером.
-	Id TenderId `json:"id"`
+	// VerifiedBy Уникальный slug пользователя.
+	VerifiedBy *Username `json:"verifiedBy,omitempty"`
+}
 
-	// Name Полное название тендера
-	Name TenderName `json:"name"`
+// CertificateId Уникальный идентификатор сертификата, присвоенный сервером.
+type CertificateId = string
 
-	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
-	OrganizationId OrganizationId `json:"organizationId"`
+// Clarification Вопрос по тендеру и ответ на него
+type Clarification struct {
+	// Answer Текст вопроса или ответа
+	Answer     *ClarificationText `json:"answer,omitempty"`
+	AnsweredAt *string            `json:"answeredAt,omitempty"`
 
-	// ServiceType Вид услуги, к которой относиться тендер
-	ServiceType TenderServiceType `json:"serviceType"`
+	// AnsweredBy Уникальный slug пользователя.
+	AnsweredBy *Username `json:"answeredBy,omitempty"`
 
-	// Status Статус тендер
-	Status TenderStatus `json:"status"`
+	// AuthorUsername Уникальный slug пользователя.
+	AuthorUsername Username `json:"authorUsername"`
+	CreatedAt      string   `json:"createdAt"`
 
-	// Version Номер версии посел правок
-	Version TenderVersion `json:"version"`
+	// Id Уникальный идентификатор вопроса, присвоенный сервером.
+	Id ClarificationId `json:"id"`
+
+	// Question Текст вопроса или ответа
+	Question ClarificationText `json:"question"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// TenderVersion Версия тендера, созданная публикацией ответа.
+	TenderVersion *int                    `json:"tenderVersion,omitempty"`
+	Visibility    ClarificationVisibility `json:"visibility"`
 }
 
-// TenderDescription Описание тендера
-type TenderDescription = string
+// ClarificationVisibility defines model for Clarification.Visibility.
+type ClarificationVisibility string
 
-// TenderId Уникальный идентификатор тендера, присвоенный сервером.
-type TenderId = string
+// ClarificationId Уникальный идентификатор вопроса, присвоенный сервером.
+type ClarificationId = string
 
-// TenderName Полное название тендера
-type TenderName = string
+// ClarificationText Текст вопроса или ответа
+type ClarificationText = string
 
-// TenderServiceType Вид услуги, к которой относиться тендер
-type TenderServiceType string
+// Contract Контракт по выигравшему предложению
+type Contract struct {
+	// Amount Сумма контракта, берётся из цены предложения
+	Amount *float64 `json:"amount,omitempty"`
 
-// TenderStatus Статус тендер
-type TenderStatus string
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId    BidId      `json:"bidId"`
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
 
-// TenderVersion Номер версии посел правок
-type TenderVersion = int32
+	// ClosedBy Уникальный slug пользователя.
+	ClosedBy  *Username `json:"closedBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 
-// Username Уникальный slug пользователя.
-type Username = string
+	// CreatedBy Уникальный slug пользователя.
+	CreatedBy *Username `json:"createdBy,omitempty"`
 
-// PaginationLimit defines model for paginationLimit.
-type PaginationLimit = int32
+	// Id Уникальный идентификатор контракта
+	Id ContractId `json:"id"`
 
-// PaginationOffset defines model for paginationOffset.
-type PaginationOffset = int32
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
 
-// GetUserBidsParams defines parameters for GetUserBids.
-type GetUserBidsParams struct {
-	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
-	//
-	// Сервер должен возвращать максимальное допустимое число объектов.
-	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+	// ReviewId Уникальный идентификатор отзыва, присвоенный сервером.
+	ReviewId *BidReviewId `json:"reviewId,omitempty"`
 
-	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
-	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
-	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+	// Status Статус контракта:
+	// * Active - контракт исполняется
+	// * Completed - все этапы приняты, контракт исполнен
+	// * Terminated - контракт расторгнут
+	Status ContractStatus `json:"status"`
+
+	// SupplierUsername Уникальный slug пользователя.
+	SupplierUsername Username `json:"supplierUsername"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId  TenderId   `json:"tenderId"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
-// CreateBidJSONBody defines parameters for CreateBid.
-type CreateBidJSONBody struct {
-	// CreatorUsername Уникальный slug пользователя.
-	CreatorUsername Username `json:"creatorUsername"`
+// ContractId Уникальный идентификатор контракта
+type ContractId = string
 
-	// Description Описание предложения
-	Description BidDescription `json:"description"`
+// ContractStatus Статус контракта:
+// * Active - контракт исполняется
+// * Completed - все этапы приняты, контракт исполнен
+// * Terminated - контракт расторгнут
+type ContractStatus string
 
-	// Name Полное название предложения
-	Name BidName `json:"name"`
+// Criterion Критерий оценки предложений
+type Criterion struct {
+	CreatedAt string `json:"createdAt"`
 
-	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
-	OrganizationId OrganizationId `json:"organizationId"`
+	// Id Уникальный идентификатор критерия оценки, присвоенный сервером.
+	Id CriterionId `json:"id"`
 
-	// Status Статус предложения
-	Status BidStatus `json:"status"`
+	// MaxScore Максимальная оценка по критерию
+	MaxScore CriterionMaxScore `json:"maxScore"`
+
+	// Name Название критерия оценки
+	Name CriterionName `json:"name"`
 
 	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
 	TenderId TenderId `json:"tenderId"`
+
+	// Weight Вес критерия. Доля критерия в итоговой оценке равна его весу, делённому на сумму весов.
+	Weight CriterionWeight `json:"weight"`
 }
 
-// EditBidJSONBody defines parameters for EditBid.
-type EditBidJSONBody struct {
-	// Description Описание предложения
-	Description *BidDescription `json:"description,omitempty"`
+// CriterionId Уникальный идентификатор критерия оценки, присвоенный сервером.
+type CriterionId = string
 
-	// Name Полное название предложения
-	Name *BidName `json:"name,omitempty"`
+// CriterionMaxScore Максимальная оценка по критерию
+type CriterionMaxScore = int
+
+// CriterionName Название критерия оценки
+type CriterionName = string
+
+// CriterionWeight Вес критерия. Доля критерия в итоговой оценке равна его весу, делённому на сумму весов.
+type CriterionWeight = float32
+
+// Debarment Отстранение поставщика от участия в тендерах. Без organizationId действует на всей площадке,
+// иначе только в тендерах этой организации. Перестаёт действовать в endsAt или при досрочном снятии.
+type Debarment struct {
+	// ApprovedBy Уникальный slug пользователя.
+	ApprovedBy *Username  `json:"approvedBy,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	EndsAt     time.Time  `json:"endsAt"`
+	ExpiredAt  *time.Time `json:"expiredAt,omitempty"`
+
+	// Id Уникальный идентификатор отстранения, присвоенный сервером.
+	Id       DebarmentId `json:"id"`
+	LiftedAt *time.Time  `json:"liftedAt,omitempty"`
+
+	// LiftedBy Уникальный slug пользователя.
+	LiftedBy *Username `json:"liftedBy,omitempty"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId *OrganizationId `json:"organizationId,omitempty"`
+	Reason         string          `json:"reason"`
+	StartsAt       time.Time       `json:"startsAt"`
+
+	// SupplierOrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	SupplierOrganizationId *OrganizationId `json:"supplierOrganizationId,omitempty"`
+
+	// SupplierUsername Уникальный slug пользователя.
+	SupplierUsername *Username `json:"supplierUsername,omitempty"`
 }
 
-// EditBidParams defines parameters for EditBid.
-type EditBidParams struct {
-	Username Username `form:"username" json:"username"`
+// DebarmentId Уникальный идентификатор отстранения, присвоенный сервером.
+type DebarmentId = string
+
+// DecisionRecord Решение по предложению с оценками на момент решения
+type DecisionRecord struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId     BidId  `json:"bidId"`
+	CreatedAt string `json:"createdAt"`
+
+	// Decision Решение по предложению
+	Decision BidDecision `json:"decision"`
+	Id       string      `json:"id"`
+	Scores   []struct {
+		Comment *string `json:"comment,omitempty"`
+
+		// Criterion Название критерия оценки
+		Criterion *CriterionName `json:"criterion,omitempty"`
+
+		// CriterionId Уникальный идентификатор критерия оценки, присвоенный сервером.
+		CriterionId *CriterionId `json:"criterionId,omitempty"`
+		MaxScore    *int         `json:"maxScore,omitempty"`
+		Score       *int         `json:"score,omitempty"`
+
+		// ScorerUsername Уникальный slug пользователя.
+		ScorerUsername *Username `json:"scorerUsername,omitempty"`
+		Weight         *float32  `json:"weight,omitempty"`
+	} `json:"scores"`
+
+	// Username Уникальный slug пользователя.
+	Username      Username `json:"username"`
+	WeightedTotal *float32 `json:"weightedTotal,omitempty"`
 }
 
-// SubmitBidFeedbackParams defines parameters for SubmitBidFeedback.
-type SubmitBidFeedbackParams struct {
-	BidFeedback BidFeedback `form:"bidFeedback" json:"bidFeedback"`
-	Username    Username    `form:"username" json:"username"`
+// EligibilityResult Результат проверки соответствия требованиям тендера
+type EligibilityResult struct {
+	Eligible bool `json:"eligible"`
+
+	// Reasons Невыполненные требования
+	Reasons []string `json:"reasons"`
 }
 
-// RollbackBidParams defines parameters for RollbackBid.
-type RollbackBidParams struct {
-	Username Username `form:"username" json:"username"`
+// EligibilityRules Требования тендера к организации, подающей предложение. Пустой список типов,
+// нулевое число контрактов и отсутствие сертификата означают, что требование не задано.
+type EligibilityRules struct {
+	// Certificate Название сертификата, который должен быть подтверждён и действовать
+	Certificate *string `json:"certificate,omitempty"`
+
+	// MinCompletedContracts Минимальное число исполненных контрактов
+	MinCompletedContracts int `json:"minCompletedContracts"`
+
+	// OrganizationTypes Допустимые организационно-правовые формы
+	OrganizationTypes []OrganizationType `json:"organizationTypes"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId  TenderId   `json:"tenderId"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// UpdatedBy Уникальный slug пользователя.
+	UpdatedBy *Username `json:"updatedBy,omitempty"`
 }
 
-// GetBidStatusParams defines parameters for GetBidStatus.
-type GetBidStatusParams struct {
-	Username Username `form:"username" json:"username"`
+// ErrorResponse Используется для возвращения ошибки пользователю
+type ErrorResponse struct {
+	// Reason Описание ошибки в свободной форме
+	Reason string `json:"reason"`
 }
 
-// UpdateBidStatusParams defines parameters for UpdateBidStatus.
-type UpdateBidStatusParams struct {
-	Status   BidStatus `form:"status" json:"status"`
-	Username Username  `form:"username" json:"username"`
+// ExportFormat Формат выгрузки
+type ExportFormat string
+
+// HealthStatus Состояние сервера.
+type HealthStatus struct {
+	// Schema Версия схемы базы.
+	Schema SchemaVersion `json:"schema"`
+	Status string        `json:"status"`
 }
 
-// SubmitBidDecisionParams defines parameters for SubmitBidDecision.
-type SubmitBidDecisionParams struct {
-	Decision BidDecision `form:"decision" json:"decision"`
-	Username Username    `form:"username" json:"username"`
+// ImportFormat Формат файла импорта
+type ImportFormat string
+
+// ImportReport Отчёт об импорте.
+type ImportReport struct {
+	DryRun    bool              `json:"dryRun"`
+	Failed    int               `json:"failed"`
+	Rows      []ImportRowResult `json:"rows"`
+	Succeeded int               `json:"succeeded"`
+	Total     int               `json:"total"`
 }
 
-// GetBidsForTenderParams defines parameters for GetBidsForTender.
-type GetBidsForTenderParams struct {
-	Username Username `form:"username" json:"username"`
+// ImportReportFormat Формат отчёта об импорте
+type ImportReportFormat string
 
-	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
-	//
-	// Сервер должен возвращать максимальное допустимое число объектов.
-	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+// ImportRowResult Итог строки импорта.
+type ImportRowResult struct {
+	Error *string `json:"error,omitempty"`
 
-	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
-	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	// Line Номер строки файла, для CSV с учётом заголовка.
+	Line int `json:"line"`
+
+	// Status Статус ответа, который получил бы одиночный запрос.
+	Status  int  `json:"status"`
+	Success bool `json:"success"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId *TenderId `json:"tenderId,omitempty"`
 }
 
-// GetBidReviewsParams defines parameters for GetBidReviews.
-type GetBidReviewsParams struct {
-	// AuthorUsername Имя пользователя автора предложений, отзывы на которые нужно просмотреть.
-	AuthorUsername Username `form:"authorUsername" json:"authorUsername"`
+// Invitation Приглашение организации к участию в закрытом тендере
+type Invitation struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-	// RequesterUsername Имя пользователя, который запрашивает отзывы.
-	RequesterUsername Username `form:"requesterUsername" json:"requesterUsername"`
+	// InvitedBy Уникальный slug пользователя.
+	InvitedBy *Username `json:"invitedBy,omitempty"`
 
-	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
-	//
-	// Сервер должен возвращать максимальное допустимое число объектов.
-	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+	RespondedAt    *time.Time     `json:"respondedAt,omitempty"`
 
-	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
-	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	// RespondedBy Уникальный slug пользователя.
+	RespondedBy *Username `json:"respondedBy,omitempty"`
+
+	// Status Статус приглашения
+	Status InvitationStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
 }
 
-// GetTendersParams defines parameters for GetTenders.
-type GetTendersParams struct {
-	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
-	//
-	// Сервер должен возвращать максимальное допустимое число объектов.
-	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+// InvitationStatus Статус приглашения
+type InvitationStatus string
 
-	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
-	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+// InvitedTender defines model for invitedTender.
+type InvitedTender struct {
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил тендер на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
 
-	// ServiceType Возвращенные тендеры должны соответствовать указанным видам услуг.
-	//
-	// Если список пустой, фильтры не применяются.
-	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Id Уникальный идентификатор тендера, присвоенный сервером.
+	Id TenderId `json:"id"`
+
+	// InvitationStatus Статус приглашения
+	InvitationStatus InvitationStatus `json:"invitationStatus"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OpeningAt Время вскрытия запечатанных предложений в формате RFC3339. Обязательно для запечатанного тендера.
+	OpeningAt *TenderOpeningAt `json:"openingAt,omitempty"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Private Закрытый тендер. Виден и доступен для подачи предложений только организациям,
+	// которые Ответственные пригласили и которые не отказались от участия.
+	Private *TenderPrivate `json:"private,omitempty"`
+
+	// Sealed Режим запечатанных предложений. Название, описание и цена предложений хранятся
+	// в зашифрованном виде и никому не видны до вскрытия.
+	Sealed *TenderSealed `json:"sealed,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// SourceTenderId Идентификатор тендера, копией которого создан этот тендер.
+	SourceTenderId *string `json:"sourceTenderId,omitempty"`
+
+	// Status Статус тендер
+	Status TenderStatus `json:"status"`
+
+	// TwoEnvelope Двухконвертный режим. Сначала Ответственные оценивают технические части предложений
+	// и допускают или отклоняют их, затем видят цены и коммерческие части только допущенных предложений.
+	// Несовместим с режимом запечатанных предложений.
+	TwoEnvelope *TenderTwoEnvelope `json:"twoEnvelope,omitempty"`
+
+	// Version Номер версии посел правок
+	Version TenderVersion `json:"version"`
 }
 
-// GetUserTendersParams defines parameters for GetUserTenders.
-type GetUserTendersParams struct {
-	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
-	//
-	// Сервер должен возвращать максимальное допустимое число объектов.
-	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+// Lot Лот тендера
+type Lot struct {
+	// Budget Бюджет лота
+	Budget    LotBudget `json:"budget"`
+	CreatedAt string    `json:"createdAt"`
 
-	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
-	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
-	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+	// Id Уникальный идентификатор лота, присвоенный сервером.
+	Id LotId `json:"id"`
+
+	// Name Название лота
+	Name LotName `json:"name"`
+
+	// Quantity Объём лота
+	Quantity LotQuantity `json:"quantity"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+	Status      LotStatus         `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId  TenderId `json:"tenderId"`
+	UpdatedAt *string  `json:"updatedAt,omitempty"`
+
+	// WinningBidId Уникальный идентификатор предложения, присвоенный сервером.
+	WinningBidId *BidId `json:"winningBidId,omitempty"`
 }
 
-// CreateTenderJSONBody defines parameters for CreateTender.
-type CreateTenderJSONBody struct {
-	// CreatorUsername Уникальный slug пользователя.
-	CreatorUsername Username `json:"creatorUsername"`
+// LotStatus defines model for Lot.Status.
+type LotStatus string
+
+// LotBudget Бюджет лота
+type LotBudget = float32
+
+// LotId Уникальный идентификатор лота, присвоенный сервером.
+type LotId = string
+
+// LotName Название лота
+type LotName = string
+
+// LotQuantity Объём лота
+type LotQuantity = int
+
+// Milestone Этап контракта
+type Milestone struct {
+	Amount float64 `json:"amount"`
+
+	// ContractId Уникальный идентификатор контракта
+	ContractId ContractId `json:"contractId"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	DecidedAt  *time.Time `json:"decidedAt,omitempty"`
+
+	// DecidedBy Уникальный slug пользователя.
+	DecidedBy       *Username `json:"decidedBy,omitempty"`
+	DecisionComment *string   `json:"decisionComment,omitempty"`
+
+	// Deliverable Результат этапа
+	Deliverable string  `json:"deliverable"`
+	Description *string `json:"description,omitempty"`
+
+	// DueDate Срок сдачи этапа
+	DueDate time.Time `json:"dueDate"`
+
+	// Id Уникальный идентификатор этапа контракта
+	Id MilestoneId `json:"id"`
+
+	// Status Статус этапа:
+	// * Pending - работы не начаты
+	// * InProgress - поставщик ведёт работы
+	// * Submitted - поставщик сдал этап
+	// * Accepted - заказчик принял этап
+	// * Rejected - заказчик отклонил этап, его можно сдать повторно
+	Status          MilestoneStatus `json:"status"`
+	SupplierComment *string         `json:"supplierComment,omitempty"`
+	UpdatedAt       *time.Time      `json:"updatedAt,omitempty"`
+}
+
+// MilestoneId Уникальный идентификатор этапа контракта
+type MilestoneId = string
+
+// MilestoneStatus Статус этапа:
+// * Pending - работы не начаты
+// * InProgress - поставщик ведёт работы
+// * Submitted - поставщик сдал этап
+// * Accepted - заказчик принял этап
+// * Rejected - заказчик отклонил этап, его можно сдать повторно
+type MilestoneStatus string
+
+// NegotiationRound Раунд переговоров по предложению
+type NegotiationRound struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// BidVersion Номер версии посел правок
+	BidVersion BidVersion         `json:"bidVersion"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Party Сторона, предложившая условия
+	Party NegotiationRoundParty `json:"party"`
+
+	// Price Цена предложения
+	Price *BidPrice `json:"price,omitempty"`
+
+	// ProposedBy Уникальный slug пользователя.
+	ProposedBy  *Username  `json:"proposedBy,omitempty"`
+	RespondedAt *time.Time `json:"respondedAt,omitempty"`
+
+	// RespondedBy Уникальный slug пользователя.
+	RespondedBy *Username `json:"respondedBy,omitempty"`
+
+	// ResultingVersion Версия предложения, созданная принятием раунда
+	ResultingVersion *int `json:"resultingVersion,omitempty"`
+
+	// Round Номер раунда, начиная с 1
+	Round int `json:"round"`
+
+	// Status Статус раунда:
+	// * Open - ждёт ответа другой стороны
+	// * Accepted - условия приняты, создана новая версия предложения
+	// * Countered - выдвинуты встречные условия следующим раундом
+	// * Rejected - условия отклонены
+	Status NegotiationRoundStatus `json:"status"`
+
+	// Terms Предлагаемые условия в свободной форме
+	Terms string `json:"terms"`
+}
+
+// NegotiationRoundParty Сторона, предложившая условия
+type NegotiationRoundParty string
+
+// NegotiationRoundStatus Статус раунда:
+// * Open - ждёт ответа другой стороны
+// * Accepted - условия приняты, создана новая версия предложения
+// * Countered - выдвинуты встречные условия следующим раундом
+// * Rejected - условия отклонены
+type NegotiationRoundStatus string
+
+// NegotiationTerms Условия раунда переговоров
+type NegotiationTerms struct {
+	// Price Цена предложения
+	Price *BidPrice `json:"price,omitempty"`
+
+	// Terms Предлагаемые условия в свободной форме
+	Terms string `json:"terms"`
+}
+
+// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+type OrganizationId = string
+
+// OrganizationType Организационно-правовая форма
+type OrganizationType string
+
+// QualificationRecord Решение о допуске предложения к коммерческой оценке
+type QualificationRecord struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// Qualification Решение по технической части предложения
+	Qualification BidQualification `json:"qualification"`
+	Reason        *string          `json:"reason,omitempty"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+
+	// Username Уникальный slug пользователя.
+	Username Username `json:"username"`
+}
+
+// Reputation Репутация автора предложений или организации. Рассчитывается по отзывам и решениям по предложениям.
+// Доли отсутствуют, пока не на что опереться.
+type Reputation struct {
+	// AverageRating Средняя оценка по отзывам с оценкой
+	AverageRating *float64 `json:"averageRating,omitempty"`
+
+	// CompletedCount Количество отзывов, подтверждающих исполнение контракта
+	CompletedCount int `json:"completedCount"`
+
+	// CompletionRate Доля исполненных среди отзывов, где указан итог исполнения
+	CompletionRate *float64 `json:"completionRate,omitempty"`
+
+	// DecidedBids Количество предложений, по которым принято решение
+	DecidedBids int `json:"decidedBids"`
+
+	// ReviewCount Количество отзывов
+	ReviewCount int `json:"reviewCount"`
+
+	// WinRate Доля одобренных среди предложений с решением
+	WinRate *float64 `json:"winRate,omitempty"`
+
+	// WonBids Количество одобренных предложений
+	WonBids int `json:"wonBids"`
+}
+
+// ReviewRevision Редакция отзыва до очередной правки
+type ReviewRevision struct {
+	Completed *bool `json:"completed,omitempty"`
+
+	// CreatedAt Время правки в формате RFC3339
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description Описание предложения
+	Description BidReviewDescription `json:"description"`
+
+	// EditedBy Уникальный slug пользователя.
+	EditedBy *Username          `json:"editedBy,omitempty"`
+	Id       openapi_types.UUID `json:"id"`
+
+	// Rating Оценка автора предложения
+	Rating *BidReviewRating `json:"rating,omitempty"`
+
+	// ReviewId Уникальный идентификатор отзыва, присвоенный сервером.
+	ReviewId BidReviewId `json:"reviewId"`
+}
+
+// SchemaVersion Версия схемы базы.
+type SchemaVersion struct {
+	// Current Последняя применённая миграция.
+	Current int64 `json:"current"`
+
+	// Latest Последняя миграция, встроенная в приложение.
+	Latest int64 `json:"latest"`
+}
+
+// TemplateCriterion Критерий оценки в шаблоне тендера
+type TemplateCriterion struct {
+	// MaxScore Максимальная оценка по критерию
+	MaxScore *CriterionMaxScore `json:"maxScore,omitempty"`
+
+	// Name Название критерия оценки
+	Name CriterionName `json:"name"`
+
+	// Weight Вес критерия. Доля критерия в итоговой оценке равна его весу, делённому на сумму весов.
+	Weight CriterionWeight `json:"weight"`
+}
+
+// TemplateId Уникальный идентификатор шаблона тендера, присвоенный сервером.
+type TemplateId = string
+
+// Tender Информация о тендере
+type Tender struct {
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил тендер на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
 
 	// Description Описание тендера
 	Description TenderDescription `json:"description"`
 
+	// Id Уникальный идентификатор тендера, присвоенный сервером.
+	Id TenderId `json:"id"`
+
 	// Name Полное название тендера
 	Name TenderName `json:"name"`
 
+	// OpeningAt Время вскрытия запечатанных предложений в формате RFC3339. Обязательно для запечатанного тендера.
+	OpeningAt *TenderOpeningAt `json:"openingAt,omitempty"`
+
 	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
 	OrganizationId OrganizationId `json:"organizationId"`
 
-	// ServiceType Вид услуги, к которой относиться тендер
-	ServiceType TenderServiceType `json:"serviceType"`
+	// Private Закрытый тендер. Виден и доступен для подачи предложений только организациям,
+	// которые Ответственные пригласили и которые не отказались от участия.
+	Private *TenderPrivate `json:"private,omitempty"`
+
+	// Sealed Режим запечатанных предложений. Название, описание и цена предложений хранятся
+	// в зашифрованном виде и никому не видны до вскрытия.
+	Sealed *TenderSealed `json:"sealed,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// SourceTenderId Идентификатор тендера, копией которого создан этот тендер.
+	SourceTenderId *string `json:"sourceTenderId,omitempty"`
+
+	// Status Статус тендер
+	Status TenderStatus `json:"status"`
+
+	// TwoEnvelope Двухконвертный режим. Сначала Ответственные оценивают технические части предложений
+	// и допускают или отклоняют их, затем видят цены и коммерческие части только допущенных предложений.
+	// Несовместим с режимом запечатанных предложений.
+	TwoEnvelope *TenderTwoEnvelope `json:"twoEnvelope,omitempty"`
+
+	// Version Номер версии посел правок
+	Version TenderVersion `json:"version"`
+}
+
+// TenderDescription Описание тендера
+type TenderDescription = string
+
+// TenderDraft Черновик правки тендера. Незаданные поля при публикации не меняются.
+// Если baseVersion отличается от currentVersion, черновик устарел.
+type TenderDraft struct {
+	// BaseVersion Версия тендера при последнем сохранении черновика
+	BaseVersion int `json:"baseVersion"`
+
+	// CurrentVersion Текущая версия тендера
+	CurrentVersion int `json:"currentVersion"`
+
+	// Description Описание тендера
+	Description *TenderDescription `json:"description,omitempty"`
+
+	// Name Полное название тендера
+	Name    *TenderName `json:"name,omitempty"`
+	SavedAt time.Time   `json:"savedAt"`
+
+	// SavedBy Уникальный slug пользователя.
+	SavedBy *Username `json:"savedBy,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+type TenderId = string
+
+// TenderName Полное название тендера
+type TenderName = string
+
+// TenderOpeningAt Время вскрытия запечатанных предложений в формате RFC3339. Обязательно для запечатанного тендера.
+type TenderOpeningAt = time.Time
+
+// TenderPrivate Закрытый тендер. Виден и доступен для подачи предложений только организациям,
+// которые Ответственные пригласили и которые не отказались от участия.
+type TenderPrivate = bool
+
+// TenderSealed Режим запечатанных предложений. Название, описание и цена предложений хранятся
+// в зашифрованном виде и никому не видны до вскрытия.
+type TenderSealed = bool
+
+// TenderServiceType Вид услуги, к которой относиться тендер
+type TenderServiceType string
+
+// TenderStatus Статус тендер
+type TenderStatus string
+
+// TenderTemplate Шаблон тендера организации. Каждая правка увеличивает версию и сохраняется в истории,
+// к которой можно откатиться.
+type TenderTemplate struct {
+	// Certificate Название сертификата, который должен быть подтверждён и действовать
+	Certificate *string   `json:"certificate,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+
+	// CreatorUsername Уникальный slug пользователя.
+	CreatorUsername *Username           `json:"creatorUsername,omitempty"`
+	Criteria        []TemplateCriterion `json:"criteria"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Id Уникальный идентификатор шаблона тендера, присвоенный сервером.
+	Id TemplateId `json:"id"`
+
+	// MinCompletedContracts Минимальное число исполненных контрактов
+	MinCompletedContracts int `json:"minCompletedContracts"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// OrganizationTypes Допустимые организационно-правовые формы поставщиков
+	OrganizationTypes []OrganizationType `json:"organizationTypes"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+	UpdatedAt   *time.Time        `json:"updatedAt,omitempty"`
+	Version     int               `json:"version"`
+}
+
+// TenderTwoEnvelope Двухконвертный режим. Сначала Ответственные оценивают технические части предложений
+// и допускают или отклоняют их, затем видят цены и коммерческие части только допущенных предложений.
+// Несовместим с режимом запечатанных предложений.
+type TenderTwoEnvelope = bool
+
+// TenderVersion Номер версии посел правок
+type TenderVersion = int32
+
+// Username Уникальный slug пользователя.
+type Username = string
+
+// PaginationLimit defines model for paginationLimit.
+type PaginationLimit = int32
+
+// PaginationOffset defines model for paginationOffset.
+type PaginationOffset = int32
+
+// GetAuditLogParams defines parameters for GetAuditLog.
+type GetAuditLogParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetUserBidsParams defines parameters for GetUserBids.
+type GetUserBidsParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// CreateBidJSONBody defines parameters for CreateBid.
+type CreateBidJSONBody struct {
+	// CommercialProposal Коммерческая часть предложения. В двухконвертном тендере видна Ответственным вместе с ценой
+	// только на этапе CommercialEvaluation и только у допущенных предложений.
+	CommercialProposal *BidCommercialProposal `json:"commercialProposal,omitempty"`
+
+	// CreatorUsername Уникальный slug пользователя.
+	CreatorUsername Username `json:"creatorUsername"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// LotIds Открытые лоты тендера, на которые подаётся предложение.
+	LotIds *[]LotId `json:"lotIds,omitempty"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Price Цена предложения
+	Price *BidPrice `json:"price,omitempty"`
+
+	// Status Статус предложения
+	Status BidStatus `json:"status"`
+
+	// TechnicalProposal Техническая часть предложения
+	TechnicalProposal *BidTechnicalProposal `json:"technicalProposal,omitempty"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// GetBidAttachmentsParams defines parameters for GetBidAttachments.
+type GetBidAttachmentsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Version Версия предложения. Если не указана, используется текущая.
+	Version *int32 `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// UploadBidAttachmentMultipartBody defines parameters for UploadBidAttachment.
+type UploadBidAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadBidAttachmentParams defines parameters for UploadBidAttachment.
+type UploadBidAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// DeleteBidAttachmentParams defines parameters for DeleteBidAttachment.
+type DeleteBidAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// DownloadBidAttachmentParams defines parameters for DownloadBidAttachment.
+type DownloadBidAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SubmitAuctionPriceJSONBody defines parameters for SubmitAuctionPrice.
+type SubmitAuctionPriceJSONBody struct {
+	// Price Цена предложения
+	Price BidPrice `json:"price"`
+}
+
+// SubmitAuctionPriceParams defines parameters for SubmitAuctionPrice.
+type SubmitAuctionPriceParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetDecisionRecordsParams defines parameters for GetDecisionRecords.
+type GetDecisionRecordsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// DiscardBidDraftParams defines parameters for DiscardBidDraft.
+type DiscardBidDraftParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidDraftParams defines parameters for GetBidDraft.
+type GetBidDraftParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SaveBidDraftJSONBody defines parameters for SaveBidDraft.
+type SaveBidDraftJSONBody struct {
+	// CommercialProposal Коммерческая часть предложения. В двухконвертном тендере видна Ответственным вместе с ценой
+	// только на этапе CommercialEvaluation и только у допущенных предложений.
+	CommercialProposal *BidCommercialProposal `json:"commercialProposal,omitempty"`
+
+	// Description Описание предложения
+	Description *BidDescription `json:"description,omitempty"`
+
+	// Name Полное название предложения
+	Name *BidName `json:"name,omitempty"`
+
+	// Price Цена предложения
+	Price *BidPrice `json:"price,omitempty"`
+
+	// TechnicalProposal Техническая часть предложения
+	TechnicalProposal *BidTechnicalProposal `json:"technicalProposal,omitempty"`
+}
+
+// SaveBidDraftParams defines parameters for SaveBidDraft.
+type SaveBidDraftParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// PublishBidDraftParams defines parameters for PublishBidDraft.
+type PublishBidDraftParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditBidJSONBody defines parameters for EditBid.
+type EditBidJSONBody struct {
+	// CommercialProposal Коммерческая часть предложения. В двухконвертном тендере видна Ответственным вместе с ценой
+	// только на этапе CommercialEvaluation и только у допущенных предложений.
+	CommercialProposal *BidCommercialProposal `json:"commercialProposal,omitempty"`
+
+	// Description Описание предложения
+	Description *BidDescription `json:"description,omitempty"`
+
+	// Name Полное название предложения
+	Name *BidName `json:"name,omitempty"`
+
+	// Price Цена предложения
+	Price *BidPrice `json:"price,omitempty"`
+
+	// TechnicalProposal Техническая часть предложения
+	TechnicalProposal *BidTechnicalProposal `json:"technicalProposal,omitempty"`
+}
+
+// EditBidParams defines parameters for EditBid.
+type EditBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SubmitBidFeedbackParams defines parameters for SubmitBidFeedback.
+type SubmitBidFeedbackParams struct {
+	BidFeedback BidFeedback `form:"bidFeedback" json:"bidFeedback"`
+
+	// Rating Оценка автора предложения от 1 до 5.
+	Rating *BidReviewRating `form:"rating,omitempty" json:"rating,omitempty"`
+
+	// Completed Контракт по предложению исполнен.
+	Completed *bool    `form:"completed,omitempty" json:"completed,omitempty"`
+	Username  Username `form:"username" json:"username"`
+}
+
+// GetBidHistoryParams defines parameters for GetBidHistory.
+type GetBidHistoryParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetNegotiationParams defines parameters for GetNegotiation.
+type GetNegotiationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// ProposeTermsParams defines parameters for ProposeTerms.
+type ProposeTermsParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// AcceptTermsParams defines parameters for AcceptTerms.
+type AcceptTermsParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CounterTermsParams defines parameters for CounterTerms.
+type CounterTermsParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// RejectTermsParams defines parameters for RejectTerms.
+type RejectTermsParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// QualifyBidJSONBody defines parameters for QualifyBid.
+type QualifyBidJSONBody struct {
+	// Qualification Решение по технической части предложения
+	Qualification BidQualification `json:"qualification"`
+
+	// Reason Обоснование решения
+	Reason *string `json:"reason,omitempty"`
+}
+
+// QualifyBidParams defines parameters for QualifyBid.
+type QualifyBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// RollbackBidParams defines parameters for RollbackBid.
+type RollbackBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidScoresParams defines parameters for GetBidScores.
+type GetBidScoresParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// ScoreBidJSONBody defines parameters for ScoreBid.
+type ScoreBidJSONBody = []struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// CriterionId Уникальный идентификатор критерия оценки, присвоенный сервером.
+	CriterionId CriterionId `json:"criterionId"`
+	Score       int         `json:"score"`
+}
+
+// ScoreBidParams defines parameters for ScoreBid.
+type ScoreBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidStatusParams defines parameters for GetBidStatus.
+type GetBidStatusParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// UpdateBidStatusParams defines parameters for UpdateBidStatus.
+type UpdateBidStatusParams struct {
+	Status   BidStatus `form:"status" json:"status"`
+	Username Username  `form:"username" json:"username"`
+}
+
+// SubmitBidDecisionParams defines parameters for SubmitBidDecision.
+type SubmitBidDecisionParams struct {
+	Decision BidDecision `form:"decision" json:"decision"`
+	Username Username    `form:"username" json:"username"`
+}
+
+// GetBidReviewsParams defines parameters for GetBidReviews.
+type GetBidReviewsParams struct {
+	// AuthorUsername Имя пользователя автора предложений, отзывы на которые нужно просмотреть.
+	AuthorUsername *Username `form:"authorUsername,omitempty" json:"authorUsername,omitempty"`
+
+	// RequesterUsername Имя пользователя, который запрашивает отзывы.
+	RequesterUsername Username `form:"requesterUsername" json:"requesterUsername"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ExportBidReviewsParams defines parameters for ExportBidReviews.
+type ExportBidReviewsParams struct {
+	AuthorUsername    *Username     `form:"authorUsername,omitempty" json:"authorUsername,omitempty"`
+	RequesterUsername Username      `form:"requesterUsername" json:"requesterUsername"`
+	Format            *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBidsForTenderParams defines parameters for GetBidsForTender.
+type GetBidsForTenderParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ExportBidsForTenderParams defines parameters for ExportBidsForTender.
+type ExportBidsForTenderParams struct {
+	Username Username      `form:"username" json:"username"`
+	Format   *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// OpenBidsParams defines parameters for OpenBids.
+type OpenBidsParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidOpeningParams defines parameters for GetBidOpening.
+type GetBidOpeningParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// BatchBidsJSONBody defines parameters for BatchBids.
+type BatchBidsJSONBody struct {
+	// Atomic Выполнить все операции или ни одной
+	Atomic     *bool `json:"atomic,omitempty"`
+	Operations []struct {
+		// BidId Уникальный идентификатор предложения, присвоенный сервером.
+		BidId BidId `json:"bidId"`
+
+		// Decision Решение по предложению
+		Decision *BidDecision                  `json:"decision,omitempty"`
+		Op       BatchBidsJSONBodyOperationsOp `json:"op"`
+	} `json:"operations"`
+}
+
+// BatchBidsParams defines parameters for BatchBids.
+type BatchBidsParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// BatchBidsJSONBodyOperationsOp defines parameters for BatchBids.
+type BatchBidsJSONBodyOperationsOp string
+
+// GetUserContractsParams defines parameters for GetUserContracts.
+type GetUserContractsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateContractJSONBody defines parameters for CreateContract.
+type CreateContractJSONBody struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+}
+
+// CreateContractParams defines parameters for CreateContract.
+type CreateContractParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetContractParams defines parameters for GetContract.
+type GetContractParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CloseContractJSONBody defines parameters for CloseContract.
+type CloseContractJSONBody struct {
+	// Feedback Отзыв на предложение
+	Feedback BidFeedback `json:"feedback"`
+
+	// Rating Оценка автора предложения
+	Rating *BidReviewRating            `json:"rating,omitempty"`
+	Status CloseContractJSONBodyStatus `json:"status"`
+}
+
+// CloseContractParams defines parameters for CloseContract.
+type CloseContractParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CloseContractJSONBodyStatus defines parameters for CloseContract.
+type CloseContractJSONBodyStatus string
+
+// GetMilestonesParams defines parameters for GetMilestones.
+type GetMilestonesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// AddMilestoneJSONBody defines parameters for AddMilestone.
+type AddMilestoneJSONBody struct {
+	Amount      float64            `json:"amount"`
+	Deliverable string             `json:"deliverable"`
+	Description *string            `json:"description,omitempty"`
+	DueDate     openapi_types.Date `json:"dueDate"`
+}
+
+// AddMilestoneParams defines parameters for AddMilestone.
+type AddMilestoneParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// DecideMilestoneParams defines parameters for DecideMilestone.
+type DecideMilestoneParams struct {
+	Decision DecideMilestoneParamsDecision `form:"decision" json:"decision"`
+	Comment  *string                       `form:"comment,omitempty" json:"comment,omitempty"`
+	Username Username                      `form:"username" json:"username"`
+}
+
+// DecideMilestoneParamsDecision defines parameters for DecideMilestone.
+type DecideMilestoneParamsDecision string
+
+// UpdateMilestoneStatusParams defines parameters for UpdateMilestoneStatus.
+type UpdateMilestoneStatusParams struct {
+	Status   UpdateMilestoneStatusParamsStatus `form:"status" json:"status"`
+	Comment  *string                           `form:"comment,omitempty" json:"comment,omitempty"`
+	Username Username                          `form:"username" json:"username"`
+}
+
+// UpdateMilestoneStatusParamsStatus defines parameters for UpdateMilestoneStatus.
+type UpdateMilestoneStatusParamsStatus string
+
+// GetDebarmentsParams defines parameters for GetDebarments.
+type GetDebarmentsParams struct {
+	Username Username `form:"username" json:"username"`
+	Active   *bool    `form:"active,omitempty" json:"active,omitempty"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// DebarSupplierJSONBody defines parameters for DebarSupplier.
+type DebarSupplierJSONBody struct {
+	// EndsAt Окончание периода в формате RFC3339
+	EndsAt time.Time `json:"endsAt"`
+	Reason string    `json:"reason"`
+
+	// StartsAt Начало периода в формате RFC3339. По умолчанию - текущее время.
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+
+	// SupplierOrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	SupplierOrganizationId *OrganizationId `json:"supplierOrganizationId,omitempty"`
+
+	// SupplierUsername Уникальный slug пользователя.
+	SupplierUsername *Username `json:"supplierUsername,omitempty"`
+}
+
+// DebarSupplierParams defines parameters for DebarSupplier.
+type DebarSupplierParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// LiftDebarmentParams defines parameters for LiftDebarment.
+type LiftDebarmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetCertificatesParams defines parameters for GetCertificates.
+type GetCertificatesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// AddCertificateJSONBody defines parameters for AddCertificate.
+type AddCertificateJSONBody struct {
+	Name       string              `json:"name"`
+	ValidUntil *openapi_types.Date `json:"validUntil,omitempty"`
+}
+
+// AddCertificateParams defines parameters for AddCertificate.
+type AddCertificateParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// VerifyCertificateParams defines parameters for VerifyCertificate.
+type VerifyCertificateParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetOrganizationDebarmentsParams defines parameters for GetOrganizationDebarments.
+type GetOrganizationDebarmentsParams struct {
+	Username Username `form:"username" json:"username"`
+	Active   *bool    `form:"active,omitempty" json:"active,omitempty"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// DebarOrganizationSupplierJSONBody defines parameters for DebarOrganizationSupplier.
+type DebarOrganizationSupplierJSONBody struct {
+	// EndsAt Окончание периода в формате RFC3339
+	EndsAt time.Time `json:"endsAt"`
+	Reason string    `json:"reason"`
+
+	// StartsAt Начало периода в формате RFC3339. По умолчанию - текущее время.
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+
+	// SupplierOrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	SupplierOrganizationId *OrganizationId `json:"supplierOrganizationId,omitempty"`
+
+	// SupplierUsername Уникальный slug пользователя.
+	SupplierUsername *Username `json:"supplierUsername,omitempty"`
+}
+
+// DebarOrganizationSupplierParams defines parameters for DebarOrganizationSupplier.
+type DebarOrganizationSupplierParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// LiftOrganizationDebarmentParams defines parameters for LiftOrganizationDebarment.
+type LiftOrganizationDebarmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTemplatesParams defines parameters for GetTemplates.
+type GetTemplatesParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateTemplateJSONBody defines parameters for CreateTemplate.
+type CreateTemplateJSONBody struct {
+	Certificate *string              `json:"certificate,omitempty"`
+	Criteria    *[]TemplateCriterion `json:"criteria,omitempty"`
+
+	// Description Описание тендера
+	Description           *TenderDescription `json:"description,omitempty"`
+	MinCompletedContracts *int               `json:"minCompletedContracts,omitempty"`
+
+	// Name Полное название тендера
+	Name              TenderName          `json:"name"`
+	OrganizationTypes *[]OrganizationType `json:"organizationTypes,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+}
+
+// CreateTemplateParams defines parameters for CreateTemplate.
+type CreateTemplateParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetAuthorReputationParams defines parameters for GetAuthorReputation.
+type GetAuthorReputationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetOrganizationReputationParams defines parameters for GetOrganizationReputation.
+type GetOrganizationReputationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditReviewJSONBody defines parameters for EditReview.
+type EditReviewJSONBody struct {
+	Completed *bool `json:"completed,omitempty"`
+
+	// Description Описание предложения
+	Description *BidReviewDescription `json:"description,omitempty"`
+
+	// Rating Оценка автора предложения
+	Rating *BidReviewRating `json:"rating,omitempty"`
+}
+
+// EditReviewParams defines parameters for EditReview.
+type EditReviewParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// HideReviewJSONBody defines parameters for HideReview.
+type HideReviewJSONBody struct {
+	Reason string `json:"reason"`
+}
+
+// HideReviewParams defines parameters for HideReview.
+type HideReviewParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetReviewHistoryParams defines parameters for GetReviewHistory.
+type GetReviewHistoryParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// ReplyToReviewJSONBody defines parameters for ReplyToReview.
+type ReplyToReviewJSONBody struct {
+	// Reply Публичный ответ автора предложения на отзыв
+	Reply BidReviewReply `json:"reply"`
+}
+
+// ReplyToReviewParams defines parameters for ReplyToReview.
+type ReplyToReviewParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// RestoreReviewParams defines parameters for RestoreReview.
+type RestoreReviewParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTemplateParams defines parameters for GetTemplate.
+type GetTemplateParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditTemplateJSONBody defines parameters for EditTemplate.
+type EditTemplateJSONBody struct {
+	Certificate *string              `json:"certificate,omitempty"`
+	Criteria    *[]TemplateCriterion `json:"criteria,omitempty"`
+
+	// Description Описание тендера
+	Description           *TenderDescription `json:"description,omitempty"`
+	MinCompletedContracts *int               `json:"minCompletedContracts,omitempty"`
+
+	// Name Полное название тендера
+	Name              *TenderName         `json:"name,omitempty"`
+	OrganizationTypes *[]OrganizationType `json:"organizationTypes,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+}
+
+// EditTemplateParams defines parameters for EditTemplate.
+type EditTemplateParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// RollbackTemplateParams defines parameters for RollbackTemplate.
+type RollbackTemplateParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CreateTenderFromTemplateParams defines parameters for CreateTenderFromTemplate.
+type CreateTenderFromTemplateParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTendersParams defines parameters for GetTenders.
+type GetTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ServiceType Возвращенные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// Username Пользователь, для которого строится список. Закрытые тендеры попадают в список,
+	// только если его организация приглашена и не отказалась от участия.
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// ImportTendersParams defines parameters for ImportTenders.
+type ImportTendersParams struct {
+	Username Username            `form:"username" json:"username"`
+	Format   *ImportFormat       `form:"format,omitempty" json:"format,omitempty"`
+	DryRun   *bool               `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+	Report   *ImportReportFormat `form:"report,omitempty" json:"report,omitempty"`
+}
+
+// GetInvitedTendersParams defines parameters for GetInvitedTenders.
+type GetInvitedTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username Username          `form:"username" json:"username"`
+}
+
+// GetUserTendersParams defines parameters for GetUserTenders.
+type GetUserTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// ExportUserTendersParams defines parameters for ExportUserTenders.
+type ExportUserTendersParams struct {
+	Username Username      `form:"username" json:"username"`
+	Format   *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// CreateTenderJSONBody defines parameters for CreateTender.
+type CreateTenderJSONBody struct {
+	// CreatorUsername Уникальный slug пользователя.
+	CreatorUsername Username `json:"creatorUsername"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OpeningAt Время вскрытия запечатанных предложений в формате RFC3339. Обязательно для запечатанного тендера.
+	OpeningAt *TenderOpeningAt `json:"openingAt,omitempty"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Private Закрытый тендер. Виден и доступен для подачи предложений только организациям,
+	// которые Ответственные пригласили и которые не отказались от участия.
+	Private *TenderPrivate `json:"private,omitempty"`
+
+	// Sealed Режим запечатанных предложений. Название, описание и цена предложений хранятся
+	// в зашифрованном виде и никому не видны до вскрытия.
+	Sealed *TenderSealed `json:"sealed,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// Status Статус тендер
+	Status TenderStatus `json:"status"`
+
+	// TwoEnvelope Двухконвертный режим. Сначала Ответственные оценивают технические части предложений
+	// и допускают или отклоняют их, затем видят цены и коммерческие части только допущенных предложений.
+	// Несовместим с режимом запечатанных предложений.
+	TwoEnvelope *TenderTwoEnvelope `json:"twoEnvelope,omitempty"`
+}
+
+// GetTenderAttachmentsParams defines parameters for GetTenderAttachments.
+type GetTenderAttachmentsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Version Версия тендера. Если не указана, используется текущая.
+	Version *int32 `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// UploadTenderAttachmentMultipartBody defines parameters for UploadTenderAttachment.
+type UploadTenderAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadTenderAttachmentParams defines parameters for UploadTenderAttachment.
+type UploadTenderAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// DeleteTenderAttachmentParams defines parameters for DeleteTenderAttachment.
+type DeleteTenderAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// DownloadTenderAttachmentParams defines parameters for DownloadTenderAttachment.
+type DownloadTenderAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetAuctionParams defines parameters for GetAuction.
+type GetAuctionParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// ScheduleAuctionJSONBody defines parameters for ScheduleAuction.
+type ScheduleAuctionJSONBody struct {
+	DurationSeconds  int  `json:"durationSeconds"`
+	ExtensionSeconds *int `json:"extensionSeconds,omitempty"`
+
+	// MinStep Минимальный шаг снижения цены
+	MinStep float64 `json:"minStep"`
+
+	// StartPrice Начальная цена. Первая поданная цена не может её превышать.
+	StartPrice *float64 `json:"startPrice,omitempty"`
+
+	// StartsAt Время начала в формате RFC3339
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// ScheduleAuctionParams defines parameters for ScheduleAuction.
+type ScheduleAuctionParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// StreamAuctionParams defines parameters for StreamAuction.
+type StreamAuctionParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetClarificationsParams defines parameters for GetClarifications.
+type GetClarificationsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AskClarificationJSONBody defines parameters for AskClarification.
+type AskClarificationJSONBody struct {
+	// Question Текст вопроса или ответа
+	Question ClarificationText `json:"question"`
+}
+
+// AskClarificationParams defines parameters for AskClarification.
+type AskClarificationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// AnswerClarificationJSONBody defines parameters for AnswerClarification.
+type AnswerClarificationJSONBody struct {
+	// Answer Текст вопроса или ответа
+	Answer      ClarificationText `json:"answer"`
+	BumpVersion *bool             `json:"bumpVersion,omitempty"`
+	Publish     *bool             `json:"publish,omitempty"`
+}
+
+// AnswerClarificationParams defines parameters for AnswerClarification.
+type AnswerClarificationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CloneTenderParams defines parameters for CloneTender.
+type CloneTenderParams struct {
+	Username  Username         `form:"username" json:"username"`
+	OpeningAt *TenderOpeningAt `form:"openingAt,omitempty" json:"openingAt,omitempty"`
+}
+
+// GetCriteriaParams defines parameters for GetCriteria.
+type GetCriteriaParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SetCriteriaJSONBody defines parameters for SetCriteria.
+type SetCriteriaJSONBody = []struct {
+	// MaxScore Максимальная оценка по критерию
+	MaxScore *CriterionMaxScore `json:"maxScore,omitempty"`
+
+	// Name Название критерия оценки
+	Name CriterionName `json:"name"`
+
+	// Weight Вес критерия. Доля критерия в итоговой оценке равна его весу, делённому на сумму весов.
+	Weight CriterionWeight `json:"weight"`
+}
+
+// SetCriteriaParams defines parameters for SetCriteria.
+type SetCriteriaParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// DiscardTenderDraftParams defines parameters for DiscardTenderDraft.
+type DiscardTenderDraftParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderDraftParams defines parameters for GetTenderDraft.
+type GetTenderDraftParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SaveTenderDraftJSONBody defines parameters for SaveTenderDraft.
+type SaveTenderDraftJSONBody struct {
+	// Description Описание тендера
+	Description *TenderDescription `json:"description,omitempty"`
+
+	// Name Полное название тендера
+	Name *TenderName `json:"name,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+}
+
+// SaveTenderDraftParams defines parameters for SaveTenderDraft.
+type SaveTenderDraftParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// PublishTenderDraftParams defines parameters for PublishTenderDraft.
+type PublishTenderDraftParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditTenderJSONBody defines parameters for EditTender.
+type EditTenderJSONBody struct {
+	// Description Описание тендера
+	Description *TenderDescription `json:"description,omitempty"`
+
+	// Name Полное название тендера
+	Name *TenderName `json:"name,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+}
+
+// EditTenderParams defines parameters for EditTender.
+type EditTenderParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetEligibilityRulesParams defines parameters for GetEligibilityRules.
+type GetEligibilityRulesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SetEligibilityRulesJSONBody defines parameters for SetEligibilityRules.
+type SetEligibilityRulesJSONBody struct {
+	Certificate           *string             `json:"certificate,omitempty"`
+	MinCompletedContracts *int                `json:"minCompletedContracts,omitempty"`
+	OrganizationTypes     *[]OrganizationType `json:"organizationTypes,omitempty"`
+}
+
+// SetEligibilityRulesParams defines parameters for SetEligibilityRules.
+type SetEligibilityRulesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CheckEligibilityParams defines parameters for CheckEligibility.
+type CheckEligibilityParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// AcceptInvitationParams defines parameters for AcceptInvitation.
+type AcceptInvitationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// DeclineInvitationParams defines parameters for DeclineInvitation.
+type DeclineInvitationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetInvitationsParams defines parameters for GetInvitations.
+type GetInvitationsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RevokeInvitationParams defines parameters for RevokeInvitation.
+type RevokeInvitationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// InviteOrganizationParams defines parameters for InviteOrganization.
+type InviteOrganizationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetLotsParams defines parameters for GetLots.
+type GetLotsParams struct {
+	Username Username `form:"username" json:"username"`
+	Version  *int32   `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// AddLotJSONBody defines parameters for AddLot.
+type AddLotJSONBody struct {
+	// Budget Бюджет лота
+	Budget LotBudget `json:"budget"`
+
+	// Name Название лота
+	Name LotName `json:"name"`
+
+	// Quantity Объём лота
+	Quantity LotQuantity `json:"quantity"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+}
+
+// AddLotParams defines parameters for AddLot.
+type AddLotParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditLotJSONBody defines parameters for EditLot.
+type EditLotJSONBody struct {
+	// Budget Бюджет лота
+	Budget *LotBudget `json:"budget,omitempty"`
+
+	// Name Название лота
+	Name *LotName `json:"name,omitempty"`
+
+	// Quantity Объём лота
+	Quantity *LotQuantity `json:"quantity,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+}
+
+// EditLotParams defines parameters for EditLot.
+type EditLotParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// AwardLotParams defines parameters for AwardLot.
+type AwardLotParams struct {
+	BidId    BidId    `form:"bidId" json:"bidId"`
+	Username Username `form:"username" json:"username"`
+}
+
+// CancelLotParams defines parameters for CancelLot.
+type CancelLotParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderRankingParams defines parameters for GetTenderRanking.
+type GetTenderRankingParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RollbackTenderParams defines parameters for RollbackTender.
+type RollbackTenderParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderStatusParams defines parameters for GetTenderStatus.
+type GetTenderStatusParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// UpdateTenderStatusParams defines parameters for UpdateTenderStatus.
+type UpdateTenderStatusParams struct {
+	Status   TenderStatus `form:"status" json:"status"`
+	Username Username     `form:"username" json:"username"`
+}
+
+// BatchTendersJSONBody defines parameters for BatchTenders.
+type BatchTendersJSONBody struct {
+	// Atomic Выполнить все операции или ни одной
+	Atomic     *bool `json:"atomic,omitempty"`
+	Operations []struct {
+		Op BatchTendersJSONBodyOperationsOp `json:"op"`
+
+		// Tender Данные нового тендера для операции create.
+		Tender *struct {
+			// Description Описание тендера
+			Description TenderDescription `json:"description"`
+
+			// Name Полное название тендера
+			Name TenderName `json:"name"`
+
+			// OpeningAt Время вскрытия запечатанных предложений в формате RFC3339. Обязательно для запечатанного тендера.
+			OpeningAt *TenderOpeningAt `json:"openingAt,omitempty"`
+
+			// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+			OrganizationId OrganizationId `json:"organizationId"`
+
+			// Private Закрытый тендер. Виден и доступен для подачи предложений только организациям,
+			// которые Ответственные пригласили и которые не отказались от участия.
+			Private *TenderPrivate `json:"private,omitempty"`
+
+			// Sealed Режим запечатанных предложений. Название, описание и цена предложений хранятся
+			// в зашифрованном виде и никому не видны до вскрытия.
+			Sealed *TenderSealed `json:"sealed,omitempty"`
+
+			// ServiceType Вид услуги, к которой относиться тендер
+			ServiceType TenderServiceType `json:"serviceType"`
+
+			// Status Статус тендер
+			Status TenderStatus `json:"status"`
+
+			// TwoEnvelope Двухконвертный режим. Сначала Ответственные оценивают технические части предложений
+			// и допускают или отклоняют их, затем видят цены и коммерческие части только допущенных предложений.
+			// Несовместим с режимом запечатанных предложений.
+			TwoEnvelope *TenderTwoEnvelope `json:"twoEnvelope,omitempty"`
+		} `json:"tender,omitempty"`
+
+		// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+		TenderId *TenderId `json:"tenderId,omitempty"`
+	} `json:"operations"`
+}
+
+// BatchTendersParams defines parameters for BatchTenders.
+type BatchTendersParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// BatchTendersJSONBodyOperationsOp defines parameters for BatchTenders.
+type BatchTendersJSONBodyOperationsOp string
+
+// CreateBidJSONRequestBody defines body for CreateBid for application/json ContentType.
+type CreateBidJSONRequestBody CreateBidJSONBody
+
+// UploadBidAttachmentMultipartRequestBody defines body for UploadBidAttachment for multipart/form-data ContentType.
+type UploadBidAttachmentMultipartRequestBody UploadBidAttachmentMultipartBody
+
+// SubmitAuctionPriceJSONRequestBody defines body for SubmitAuctionPrice for application/json ContentType.
+type SubmitAuctionPriceJSONRequestBody SubmitAuctionPriceJSONBody
+
+// SaveBidDraftJSONRequestBody defines body for SaveBidDraft for application/json ContentType.
+type SaveBidDraftJSONRequestBody SaveBidDraftJSONBody
+
+// EditBidJSONRequestBody defines body for EditBid for application/json ContentType.
+type EditBidJSONRequestBody EditBidJSONBody
+
+// ProposeTermsJSONRequestBody defines body for ProposeTerms for application/json ContentType.
+type ProposeTermsJSONRequestBody = NegotiationTerms
+
+// CounterTermsJSONRequestBody defines body for CounterTerms for application/json ContentType.
+type CounterTermsJSONRequestBody = NegotiationTerms
+
+// QualifyBidJSONRequestBody defines body for QualifyBid for application/json ContentType.
+type QualifyBidJSONRequestBody QualifyBidJSONBody
+
+// ScoreBidJSONRequestBody defines body for ScoreBid for application/json ContentType.
+type ScoreBidJSONRequestBody = ScoreBidJSONBody
+
+// BatchBidsJSONRequestBody defines body for BatchBids for application/json ContentType.
+type BatchBidsJSONRequestBody BatchBidsJSONBody
+
+// CreateContractJSONRequestBody defines body for CreateContract for application/json ContentType.
+type CreateContractJSONRequestBody CreateContractJSONBody
+
+// CloseContractJSONRequestBody defines body for CloseContract for application/json ContentType.
+type CloseContractJSONRequestBody CloseContractJSONBody
+
+// AddMilestoneJSONRequestBody defines body for AddMilestone for application/json ContentType.
+type AddMilestoneJSONRequestBody AddMilestoneJSONBody
+
+// DebarSupplierJSONRequestBody defines body for DebarSupplier for application/json ContentType.
+type DebarSupplierJSONRequestBody DebarSupplierJSONBody
+
+// AddCertificateJSONRequestBody defines body for AddCertificate for application/json ContentType.
+type AddCertificateJSONRequestBody AddCertificateJSONBody
+
+// DebarOrganizationSupplierJSONRequestBody defines body for DebarOrganizationSupplier for application/json ContentType.
+type DebarOrganizationSupplierJSONRequestBody DebarOrganizationSupplierJSONBody
+
+// CreateTemplateJSONRequestBody defines body for CreateTemplate for application/json ContentType.
+type CreateTemplateJSONRequestBody CreateTemplateJSONBody
+
+// EditReviewJSONRequestBody defines body for EditReview for application/json ContentType.
+type EditReviewJSONRequestBody EditReviewJSONBody
+
+// HideReviewJSONRequestBody defines body for HideReview for application/json ContentType.
+type HideReviewJSONRequestBody HideReviewJSONBody
+
+// ReplyToReviewJSONRequestBody defines body for ReplyToReview for application/json ContentType.
+type ReplyToReviewJSONRequestBody ReplyToReviewJSONBody
+
+// EditTemplateJSONRequestBody defines body for EditTemplate for application/json ContentType.
+type EditTemplateJSONRequestBody EditTemplateJSONBody
+
+// CreateTenderJSONRequestBody defines body for CreateTender for application/json ContentType.
+type CreateTenderJSONRequestBody CreateTenderJSONBody
+
+// UploadTenderAttachmentMultipartRequestBody defines body for UploadTenderAttachment for multipart/form-data ContentType.
+type UploadTenderAttachmentMultipartRequestBody UploadTenderAttachmentMultipartBody
+
+// ScheduleAuctionJSONRequestBody defines body for ScheduleAuction for application/json ContentType.
+type ScheduleAuctionJSONRequestBody ScheduleAuctionJSONBody
+
+// AskClarificationJSONRequestBody defines body for AskClarification for application/json ContentType.
+type AskClarificationJSONRequestBody AskClarificationJSONBody
+
+// AnswerClarificationJSONRequestBody defines body for AnswerClarification for application/json ContentType.
+type AnswerClarificationJSONRequestBody AnswerClarificationJSONBody
+
+// SetCriteriaJSONRequestBody defines body for SetCriteria for application/json ContentType.
+type SetCriteriaJSONRequestBody = SetCriteriaJSONBody
+
+// SaveTenderDraftJSONRequestBody defines body for SaveTenderDraft for application/json ContentType.
+type SaveTenderDraftJSONRequestBody SaveTenderDraftJSONBody
+
+// EditTenderJSONRequestBody defines body for EditTender for application/json ContentType.
+type EditTenderJSONRequestBody EditTenderJSONBody
+
+// SetEligibilityRulesJSONRequestBody defines body for SetEligibilityRules for application/json ContentType.
+type SetEligibilityRulesJSONRequestBody SetEligibilityRulesJSONBody
+
+// AddLotJSONRequestBody defines body for AddLot for application/json ContentType.
+type AddLotJSONRequestBody AddLotJSONBody
+
+// EditLotJSONRequestBody defines body for EditLot for application/json ContentType.
+type EditLotJSONRequestBody EditLotJSONBody
+
+// BatchTendersJSONRequestBody defines body for BatchTenders for application/json ContentType.
+type BatchTendersJSONRequestBody BatchTendersJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Журнал аудита организации
+	// (GET /audit)
+	GetAuditLog(ctx echo.Context, params GetAuditLogParams) error
+	// Получение списка ваших предложений
+	// (GET /bids/my)
+	GetUserBids(ctx echo.Context, params GetUserBidsParams) error
+	// Создание нового предложения
+	// (POST /bids/new)
+	CreateBid(ctx echo.Context) error
+	// Список вложений предложения
+	// (GET /bids/{bidId}/attachments)
+	GetBidAttachments(ctx echo.Context, bidId BidId, params GetBidAttachmentsParams) error
+	// Загрузка вложения предложения
+	// (POST /bids/{bidId}/attachments)
+	UploadBidAttachment(ctx echo.Context, bidId BidId, params UploadBidAttachmentParams) error
+	// Удаление вложения предложения
+	// (DELETE /bids/{bidId}/attachments/{attachmentId})
+	DeleteBidAttachment(ctx echo.Context, bidId BidId, attachmentId AttachmentId, params DeleteBidAttachmentParams) error
+	// Скачивание вложения предложения
+	// (GET /bids/{bidId}/attachments/{attachmentId})
+	DownloadBidAttachment(ctx echo.Context, bidId BidId, attachmentId AttachmentId, params DownloadBidAttachmentParams) error
+	// Подача цены в аукционе
+	// (PUT /bids/{bidId}/auction/price)
+	SubmitAuctionPrice(ctx echo.Context, bidId BidId, params SubmitAuctionPriceParams) error
+	// История решений по предложению
+	// (GET /bids/{bidId}/decisions)
+	GetDecisionRecords(ctx echo.Context, bidId BidId, params GetDecisionRecordsParams) error
+	// Удаление черновика предложения
+	// (DELETE /bids/{bidId}/draft)
+	DiscardBidDraft(ctx echo.Context, bidId BidId, params DiscardBidDraftParams) error
+	// Получение черновика предложения
+	// (GET /bids/{bidId}/draft)
+	GetBidDraft(ctx echo.Context, bidId BidId, params GetBidDraftParams) error
+	// Сохранение черновика предложения
+	// (PUT /bids/{bidId}/draft)
+	SaveBidDraft(ctx echo.Context, bidId BidId, params SaveBidDraftParams) error
+	// Публикация черновика предложения
+	// (PUT /bids/{bidId}/draft/publish)
+	PublishBidDraft(ctx echo.Context, bidId BidId, params PublishBidDraftParams) error
+	// Редактирование параметров предложения
+	// (PATCH /bids/{bidId}/edit)
+	EditBid(ctx echo.Context, bidId BidId, params EditBidParams) error
+	// Отправка отзыва по предложению
+	// (PUT /bids/{bidId}/feedback)
+	SubmitBidFeedback(ctx echo.Context, bidId BidId, params SubmitBidFeedbackParams) error
+	// История версий предложения
+	// (GET /bids/{bidId}/history)
+	GetBidHistory(ctx echo.Context, bidId BidId, params GetBidHistoryParams) error
+	// Раунды переговоров по предложению
+	// (GET /bids/{bidId}/negotiation)
+	GetNegotiation(ctx echo.Context, bidId BidId, params GetNegotiationParams) error
+	// Предложение условий по предложению
+	// (POST /bids/{bidId}/negotiation)
+	ProposeTerms(ctx echo.Context, bidId BidId, params ProposeTermsParams) error
+	// Принятие условий
+	// (PUT /bids/{bidId}/negotiation/accept)
+	AcceptTerms(ctx echo.Context, bidId BidId, params AcceptTermsParams) error
+	// Встречные условия
+	// (PUT /bids/{bidId}/negotiation/counter)
+	CounterTerms(ctx echo.Context, bidId BidId, params CounterTermsParams) error
+	// Отклонение условий
+	// (PUT /bids/{bidId}/negotiation/reject)
+	RejectTerms(ctx echo.Context, bidId BidId, params RejectTermsParams) error
+	// Допуск предложения по технической части
+	// (PUT /bids/{bidId}/qualification)
+	QualifyBid(ctx echo.Context, bidId BidId, params QualifyBidParams) error
+	// Откат версии предложения
+	// (PUT /bids/{bidId}/rollback/{version})
+	RollbackBid(ctx echo.Context, bidId BidId, version int32, params RollbackBidParams) error
+	// Оценки предложения
+	// (GET /bids/{bidId}/scores)
+	GetBidScores(ctx echo.Context, bidId BidId, params GetBidScoresParams) error
+	// Оценка предложения
+	// (PUT /bids/{bidId}/scores)
+	ScoreBid(ctx echo.Context, bidId BidId, params ScoreBidParams) error
+	// Получение текущего статуса предложения
+	// (GET /bids/{bidId}/status)
+	GetBidStatus(ctx echo.Context, bidId BidId, params GetBidStatusParams) error
+	// Изменение статуса предложения
+	// (PUT /bids/{bidId}/status)
+	UpdateBidStatus(ctx echo.Context, bidId BidId, params UpdateBidStatusParams) error
+	// Отправка решения по предложению
+	// (PUT /bids/{bidId}/submit_decision)
+	SubmitBidDecision(ctx echo.Context, bidId BidId, params SubmitBidDecisionParams) error
+	// Просмотр отзывов на предложения
+	// (GET /bids/{id}/reviews)
+	GetBidReviews(ctx echo.Context, id string, params GetBidReviewsParams) error
+	// Выгрузка отзывов
+	// (GET /bids/{id}/reviews/export)
+	ExportBidReviews(ctx echo.Context, id string, params ExportBidReviewsParams) error
+	// Получение списка предложений для тендера
+	// (GET /bids/{tenderId}/list)
+	GetBidsForTender(ctx echo.Context, tenderId TenderId, params GetBidsForTenderParams) error
+	// Выгрузка предложений для тендера
+	// (GET /bids/{tenderId}/list/export)
+	ExportBidsForTender(ctx echo.Context, tenderId TenderId, params ExportBidsForTenderParams) error
+	// Вскрытие запечатанных предложений
+	// (PUT /bids/{tenderId}/open)
+	OpenBids(ctx echo.Context, tenderId TenderId, params OpenBidsParams) error
+	// Сведения о вскрытии предложений
+	// (GET /bids/{tenderId}/opening)
+	GetBidOpening(ctx echo.Context, tenderId TenderId, params GetBidOpeningParams) error
+	// Пакетные операции над предложениями
+	// (POST /bids:batch)
+	BatchBids(ctx echo.Context, params BatchBidsParams) error
+	// Контракты пользователя
+	// (GET /contracts/my)
+	GetUserContracts(ctx echo.Context, params GetUserContractsParams) error
+	// Заключение контракта
+	// (POST /contracts/new)
+	CreateContract(ctx echo.Context, params CreateContractParams) error
+	// Просмотр контракта
+	// (GET /contracts/{contractId})
+	GetContract(ctx echo.Context, contractId ContractId, params GetContractParams) error
+	// Закрытие контракта
+	// (PUT /contracts/{contractId}/close)
+	CloseContract(ctx echo.Context, contractId ContractId, params CloseContractParams) error
+	// Этапы контракта
+	// (GET /contracts/{contractId}/milestones)
+	GetMilestones(ctx echo.Context, contractId ContractId, params GetMilestonesParams) error
+	// Добавление этапа контракта
+	// (POST /contracts/{contractId}/milestones)
+	AddMilestone(ctx echo.Context, contractId ContractId, params AddMilestoneParams) error
+	// Приёмка этапа
+	// (PUT /contracts/{contractId}/milestones/{milestoneId}/decision)
+	DecideMilestone(ctx echo.Context, contractId ContractId, milestoneId MilestoneId, params DecideMilestoneParams) error
+	// Изменение статуса этапа поставщиком
+	// (PUT /contracts/{contractId}/milestones/{milestoneId}/status)
+	UpdateMilestoneStatus(ctx echo.Context, contractId ContractId, milestoneId MilestoneId, params UpdateMilestoneStatusParams) error
+	// Отстранения на площадке
+	// (GET /debarments)
+	GetDebarments(ctx echo.Context, params GetDebarmentsParams) error
+	// Отстранение поставщика на площадке
+	// (POST /debarments)
+	DebarSupplier(ctx echo.Context, params DebarSupplierParams) error
+	// Досрочное снятие отстранения на площадке
+	// (PUT /debarments/{debarmentId}/lift)
+	LiftDebarment(ctx echo.Context, debarmentId DebarmentId, params LiftDebarmentParams) error
+	// Состояние сервера
+	// (GET /health)
+	GetHealth(ctx echo.Context) error
+	// Сертификаты организации
+	// (GET /organizations/{organizationId}/certificates)
+	GetCertificates(ctx echo.Context, organizationId OrganizationId, params GetCertificatesParams) error
+	// Добавление сертификата
+	// (POST /organizations/{organizationId}/certificates)
+	AddCertificate(ctx echo.Context, organizationId OrganizationId, params AddCertificateParams) error
+	// Подтверждение сертификата
+	// (PUT /organizations/{organizationId}/certificates/{certificateId}/verify)
+	VerifyCertificate(ctx echo.Context, organizationId OrganizationId, certificateId CertificateId, params VerifyCertificateParams) error
+	// Отстранения организации
+	// (GET /organizations/{organizationId}/debarments)
+	GetOrganizationDebarments(ctx echo.Context, organizationId OrganizationId, params GetOrganizationDebarmentsParams) error
+	// Отстранение поставщика организацией
+	// (POST /organizations/{organizationId}/debarments)
+	DebarOrganizationSupplier(ctx echo.Context, organizationId OrganizationId, params DebarOrganizationSupplierParams) error
+	// Досрочное снятие отстранения организацией
+	// (PUT /organizations/{organizationId}/debarments/{debarmentId}/lift)
+	LiftOrganizationDebarment(ctx echo.Context, organizationId OrganizationId, debarmentId DebarmentId, params LiftOrganizationDebarmentParams) error
+	// Шаблоны тендеров организации
+	// (GET /organizations/{organizationId}/templates)
+	GetTemplates(ctx echo.Context, organizationId OrganizationId, params GetTemplatesParams) error
+	// Создание шаблона тендера
+	// (POST /organizations/{organizationId}/templates)
+	CreateTemplate(ctx echo.Context, organizationId OrganizationId, params CreateTemplateParams) error
+	// Проверка доступности сервера
+	// (GET /ping)
+	CheckServer(ctx echo.Context) error
+	// Репутация автора предложений
+	// (GET /reputation/authors/{authorUsername})
+	GetAuthorReputation(ctx echo.Context, authorUsername Username, params GetAuthorReputationParams) error
+	// Репутация организации
+	// (GET /reputation/organizations/{organizationId})
+	GetOrganizationReputation(ctx echo.Context, organizationId OrganizationId, params GetOrganizationReputationParams) error
+	// Редактирование отзыва
+	// (PATCH /reviews/{reviewId}/edit)
+	EditReview(ctx echo.Context, reviewId BidReviewId, params EditReviewParams) error
+	// Скрытие отзыва модератором
+	// (PUT /reviews/{reviewId}/hide)
+	HideReview(ctx echo.Context, reviewId BidReviewId, params HideReviewParams) error
+	// История правок отзыва
+	// (GET /reviews/{reviewId}/history)
+	GetReviewHistory(ctx echo.Context, reviewId BidReviewId, params GetReviewHistoryParams) error
+	// Ответ на отзыв
+	// (PUT /reviews/{reviewId}/reply)
+	ReplyToReview(ctx echo.Context, reviewId BidReviewId, params ReplyToReviewParams) error
+	// Возврат скрытого отзыва
+	// (PUT /reviews/{reviewId}/restore)
+	RestoreReview(ctx echo.Context, reviewId BidReviewId, params RestoreReviewParams) error
+	// Получение шаблона тендера
+	// (GET /templates/{templateId})
+	GetTemplate(ctx echo.Context, templateId TemplateId, params GetTemplateParams) error
+	// Редактирование шаблона тендера
+	// (PATCH /templates/{templateId}/edit)
+	EditTemplate(ctx echo.Context, templateId TemplateId, params EditTemplateParams) error
+	// Откат версии шаблона
+	// (PUT /templates/{templateId}/rollback/{version})
+	RollbackTemplate(ctx echo.Context, templateId TemplateId, version int32, params RollbackTemplateParams) error
+	// Создание тендера по шаблону
+	// (POST /templates/{templateId}/tender)
+	CreateTenderFromTemplate(ctx echo.Context, templateId TemplateId, params CreateTenderFromTemplateParams) error
+	// Получение списка тендеров
+	// (GET /tenders)
+	GetTenders(ctx echo.Context, params GetTendersParams) error
+	// Импорт тендеров
+	// (POST /tenders/import)
+	ImportTenders(ctx echo.Context, params ImportTendersParams) error
+	// Закрытые тендеры, в которые приглашена организация
+	// (GET /tenders/invited)
+	GetInvitedTenders(ctx echo.Context, params GetInvitedTendersParams) error
+	// Получить тендеры пользователя
+	// (GET /tenders/my)
+	GetUserTenders(ctx echo.Context, params GetUserTendersParams) error
+	// Выгрузка тендеров пользователя
+	// (GET /tenders/my/export)
+	ExportUserTenders(ctx echo.Context, params ExportUserTendersParams) error
+	// Создание нового тендера
+	// (POST /tenders/new)
+	CreateTender(ctx echo.Context) error
+	// Список вложений тендера
+	// (GET /tenders/{tenderId}/attachments)
+	GetTenderAttachments(ctx echo.Context, tenderId TenderId, params GetTenderAttachmentsParams) error
+	// Загрузка вложения тендера
+	// (POST /tenders/{tenderId}/attachments)
+	UploadTenderAttachment(ctx echo.Context, tenderId TenderId, params UploadTenderAttachmentParams) error
+	// Удаление вложения тендера
+	// (DELETE /tenders/{tenderId}/attachments/{attachmentId})
+	DeleteTenderAttachment(ctx echo.Context, tenderId TenderId, attachmentId AttachmentId, params DeleteTenderAttachmentParams) error
+	// Скачивание вложения тендера
+	// (GET /tenders/{tenderId}/attachments/{attachmentId})
+	DownloadTenderAttachment(ctx echo.Context, tenderId TenderId, attachmentId AttachmentId, params DownloadTenderAttachmentParams) error
+	// Состояние аукциона
+	// (GET /tenders/{tenderId}/auction)
+	GetAuction(ctx echo.Context, tenderId TenderId, params GetAuctionParams) error
+	// Назначение аукциона
+	// (PUT /tenders/{tenderId}/auction)
+	ScheduleAuction(ctx echo.Context, tenderId TenderId, params ScheduleAuctionParams) error
+	// Поток состояния аукциона
+	// (GET /tenders/{tenderId}/auction/stream)
+	StreamAuction(ctx echo.Context, tenderId TenderId, params StreamAuctionParams) error
+	// Вопросы по тендеру
+	// (GET /tenders/{tenderId}/clarifications)
+	GetClarifications(ctx echo.Context, tenderId TenderId, params GetClarificationsParams) error
+	// Вопрос по тендеру
+	// (POST /tenders/{tenderId}/clarifications)
+	AskClarification(ctx echo.Context, tenderId TenderId, params AskClarificationParams) error
+	// Ответ на вопрос по тендеру
+	// (PUT /tenders/{tenderId}/clarifications/{clarificationId}/answer)
+	AnswerClarification(ctx echo.Context, tenderId TenderId, clarificationId ClarificationId, params AnswerClarificationParams) error
+	// Копирование тендера
+	// (POST /tenders/{tenderId}/clone)
+	CloneTender(ctx echo.Context, tenderId TenderId, params CloneTenderParams) error
+	// Критерии оценки тендера
+	// (GET /tenders/{tenderId}/criteria)
+	GetCriteria(ctx echo.Context, tenderId TenderId, params GetCriteriaParams) error
+	// Критерии оценки тендера
+	// (PUT /tenders/{tenderId}/criteria)
+	SetCriteria(ctx echo.Context, tenderId TenderId, params SetCriteriaParams) error
+	// Удаление черновика тендера
+	// (DELETE /tenders/{tenderId}/draft)
+	DiscardTenderDraft(ctx echo.Context, tenderId TenderId, params DiscardTenderDraftParams) error
+	// Получение черновика тендера
+	// (GET /tenders/{tenderId}/draft)
+	GetTenderDraft(ctx echo.Context, tenderId TenderId, params GetTenderDraftParams) error
+	// Сохранение черновика тендера
+	// (PUT /tenders/{tenderId}/draft)
+	SaveTenderDraft(ctx echo.Context, tenderId TenderId, params SaveTenderDraftParams) error
+	// Публикация черновика тендера
+	// (PUT /tenders/{tenderId}/draft/publish)
+	PublishTenderDraft(ctx echo.Context, tenderId TenderId, params PublishTenderDraftParams) error
+	// Редактирование тендера
+	// (PATCH /tenders/{tenderId}/edit)
+	EditTender(ctx echo.Context, tenderId TenderId, params EditTenderParams) error
+	// Получение требований к поставщикам
+	// (GET /tenders/{tenderId}/eligibility)
+	GetEligibilityRules(ctx echo.Context, tenderId TenderId, params GetEligibilityRulesParams) error
+	// Требования к поставщикам
+	// (PUT /tenders/{tenderId}/eligibility)
+	SetEligibilityRules(ctx echo.Context, tenderId TenderId, params SetEligibilityRulesParams) error
+	// Пробная проверка соответствия требованиям
+	// (GET /tenders/{tenderId}/eligibility/check)
+	CheckEligibility(ctx echo.Context, tenderId TenderId, params CheckEligibilityParams) error
+	// Принятие приглашения
+	// (PUT /tenders/{tenderId}/invitation/accept)
+	AcceptInvitation(ctx echo.Context, tenderId TenderId, params AcceptInvitationParams) error
+	// Отказ от приглашения
+	// (PUT /tenders/{tenderId}/invitation/decline)
+	DeclineInvitation(ctx echo.Context, tenderId TenderId, params DeclineInvitationParams) error
+	// Приглашения закрытого тендера
+	// (GET /tenders/{tenderId}/invitations)
+	GetInvitations(ctx echo.Context, tenderId TenderId, params GetInvitationsParams) error
+	// Отзыв приглашения
+	// (DELETE /tenders/{tenderId}/invitations/{organizationId})
+	RevokeInvitation(ctx echo.Context, tenderId TenderId, organizationId OrganizationId, params RevokeInvitationParams) error
+	// Приглашение организации
+	// (PUT /tenders/{tenderId}/invitations/{organizationId})
+	InviteOrganization(ctx echo.Context, tenderId TenderId, organizationId OrganizationId, params InviteOrganizationParams) error
+	// Лоты тендера
+	// (GET /tenders/{tenderId}/lots)
+	GetLots(ctx echo.Context, tenderId TenderId, params GetLotsParams) error
+	// Добавление лота
+	// (POST /tenders/{tenderId}/lots)
+	AddLot(ctx echo.Context, tenderId TenderId, params AddLotParams) error
+	// Редактирование лота
+	// (PATCH /tenders/{tenderId}/lots/{lotId})
+	EditLot(ctx echo.Context, tenderId TenderId, lotId LotId, params EditLotParams) error
+	// Присуждение лота
+	// (PUT /tenders/{tenderId}/lots/{lotId}/award)
+	AwardLot(ctx echo.Context, tenderId TenderId, lotId LotId, params AwardLotParams) error
+	// Отмена лота
+	// (PUT /tenders/{tenderId}/lots/{lotId}/cancel)
+	CancelLot(ctx echo.Context, tenderId TenderId, lotId LotId, params CancelLotParams) error
+	// Рейтинг предложений тендера
+	// (GET /tenders/{tenderId}/ranking)
+	GetTenderRanking(ctx echo.Context, tenderId TenderId, params GetTenderRankingParams) error
+	// Откат версии тендера
+	// (PUT /tenders/{tenderId}/rollback/{version})
+	RollbackTender(ctx echo.Context, tenderId TenderId, version int32, params RollbackTenderParams) error
+	// Получение текущего статуса тендера
+	// (GET /tenders/{tenderId}/status)
+	GetTenderStatus(ctx echo.Context, tenderId TenderId, params GetTenderStatusParams) error
+	// Изменение статуса тендера
+	// (PUT /tenders/{tenderId}/status)
+	UpdateTenderStatus(ctx echo.Context, tenderId TenderId, params UpdateTenderStatusParams) error
+	// Пакетные операции над тендерами
+	// (POST /tenders:batch)
+	BatchTenders(ctx echo.Context, params BatchTendersParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAuditLog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuditLog(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditLogParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuditLog(ctx, params)
+	return err
+}
+
+// GetUserBids converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserBids(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserBidsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserBids(ctx, params)
+	return err
+}
+
+// CreateBid converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateBid(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateBid(ctx)
+	return err
+}
+
+// GetBidAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBidAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidAttachmentsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBidAttachments(ctx, bidId, params)
+	return err
+}
+
+// UploadBidAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadBidAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadBidAttachmentParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadBidAttachment(ctx, bidId, params)
+	return err
+}
+
+// DeleteBidAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBidAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBidAttachmentParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBidAttachment(ctx, bidId, attachmentId, params)
+	return err
+}
+
+// DownloadBidAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadBidAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadBidAttachmentParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadBidAttachment(ctx, bidId, attachmentId, params)
+	return err
+}
+
+// SubmitAuctionPrice converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitAuctionPrice(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitAuctionPriceParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitAuctionPrice(ctx, bidId, params)
+	return err
+}
+
+// GetDecisionRecords converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDecisionRecords(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDecisionRecordsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDecisionRecords(ctx, bidId, params)
+	return err
+}
+
+// DiscardBidDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) DiscardBidDraft(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiscardBidDraftParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DiscardBidDraft(ctx, bidId, params)
+	return err
+}
+
+// GetBidDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBidDraft(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidDraftParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBidDraft(ctx, bidId, params)
+	return err
+}
+
+// SaveBidDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) SaveBidDraft(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SaveBidDraftParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SaveBidDraft(ctx, bidId, params)
+	return err
+}
+
+// PublishBidDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) PublishBidDraft(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PublishBidDraftParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PublishBidDraft(ctx, bidId, params)
+	return err
+}
+
+// EditBid converts echo context to params.
+func (w *ServerInterfaceWrapper) EditBid(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditBidParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditBid(ctx, bidId, params)
+	return err
+}
+
+// SubmitBidFeedback converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitBidFeedback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidFeedbackParams
+	// ------------- Required query parameter "bidFeedback" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "bidFeedback", ctx.QueryParams(), &params.BidFeedback)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidFeedback: %s", err))
+	}
+
+	// ------------- Optional query parameter "rating" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rating", ctx.QueryParams(), &params.Rating)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rating: %s", err))
+	}
+
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitBidFeedback(ctx, bidId, params)
+	return err
+}
+
+// GetBidHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBidHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidHistoryParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBidHistory(ctx, bidId, params)
+	return err
+}
+
+// GetNegotiation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNegotiation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNegotiationParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNegotiation(ctx, bidId, params)
+	return err
+}
+
+// ProposeTerms converts echo context to params.
+func (w *ServerInterfaceWrapper) ProposeTerms(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProposeTermsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProposeTerms(ctx, bidId, params)
+	return err
+}
+
+// AcceptTerms converts echo context to params.
+func (w *ServerInterfaceWrapper) AcceptTerms(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AcceptTermsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AcceptTerms(ctx, bidId, params)
+	return err
+}
+
+// CounterTerms converts echo context to params.
+func (w *ServerInterfaceWrapper) CounterTerms(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CounterTermsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CounterTerms(ctx, bidId, params)
+	return err
+}
+
+// RejectTerms converts echo context to params.
+func (w *ServerInterfaceWrapper) RejectTerms(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RejectTermsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RejectTerms(ctx, bidId, params)
+	return err
+}
+
+// QualifyBid converts echo context to params.
+func (w *ServerInterfaceWrapper) QualifyBid(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QualifyBidParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.QualifyBid(ctx, bidId, params)
+	return err
+}
+
+// RollbackBid converts echo context to params.
+func (w *ServerInterfaceWrapper) RollbackBid(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackBidParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RollbackBid(ctx, bidId, version, params)
+	return err
+}
+
+// GetBidScores converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBidScores(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidScoresParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBidScores(ctx, bidId, params)
+	return err
+}
+
+// ScoreBid converts echo context to params.
+func (w *ServerInterfaceWrapper) ScoreBid(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScoreBidParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScoreBid(ctx, bidId, params)
+	return err
+}
+
+// GetBidStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBidStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidStatusParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBidStatus(ctx, bidId, params)
+	return err
+}
+
+// UpdateBidStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateBidStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateBidStatusParams
+	// ------------- Required query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateBidStatus(ctx, bidId, params)
+	return err
+}
+
+// SubmitBidDecision converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitBidDecision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidDecisionParams
+	// ------------- Required query parameter "decision" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "decision", ctx.QueryParams(), &params.Decision)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter decision: %s", err))
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitBidDecision(ctx, bidId, params)
+	return err
+}
+
+// GetBidReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBidReviews(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidReviewsParams
+	// ------------- Optional query parameter "authorUsername" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "authorUsername", ctx.QueryParams(), &params.AuthorUsername)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter authorUsername: %s", err))
+	}
+
+	// ------------- Required query parameter "requesterUsername" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "requesterUsername", ctx.QueryParams(), &params.RequesterUsername)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter requesterUsername: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBidReviews(ctx, id, params)
+	return err
+}
+
+// ExportBidReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportBidReviews(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportBidReviewsParams
+	// ------------- Optional query parameter "authorUsername" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "authorUsername", ctx.QueryParams(), &params.AuthorUsername)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter authorUsername: %s", err))
+	}
+
+	// ------------- Required query parameter "requesterUsername" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "requesterUsername", ctx.QueryParams(), &params.RequesterUsername)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter requesterUsername: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportBidReviews(ctx, id, params)
+	return err
+}
+
+// GetBidsForTender converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBidsForTender(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidsForTenderParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBidsForTender(ctx, tenderId, params)
+	return err
+}
+
+// ExportBidsForTender converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportBidsForTender(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportBidsForTenderParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportBidsForTender(ctx, tenderId, params)
+	return err
+}
+
+// OpenBids converts echo context to params.
+func (w *ServerInterfaceWrapper) OpenBids(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OpenBidsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OpenBids(ctx, tenderId, params)
+	return err
+}
+
+// GetBidOpening converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBidOpening(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidOpeningParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBidOpening(ctx, tenderId, params)
+	return err
+}
+
+// BatchBids converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchBids(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchBidsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchBids(ctx, params)
+	return err
+}
+
+// GetUserContracts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserContracts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserContractsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserContracts(ctx, params)
+	return err
+}
+
+// CreateContract converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateContract(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateContractParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateContract(ctx, params)
+	return err
+}
+
+// GetContract converts echo context to params.
+func (w *ServerInterfaceWrapper) GetContract(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", ctx.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contractId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetContractParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetContract(ctx, contractId, params)
+	return err
+}
+
+// CloseContract converts echo context to params.
+func (w *ServerInterfaceWrapper) CloseContract(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", ctx.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contractId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CloseContractParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CloseContract(ctx, contractId, params)
+	return err
+}
+
+// GetMilestones converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMilestones(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", ctx.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contractId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMilestonesParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMilestones(ctx, contractId, params)
+	return err
+}
+
+// AddMilestone converts echo context to params.
+func (w *ServerInterfaceWrapper) AddMilestone(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", ctx.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contractId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddMilestoneParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddMilestone(ctx, contractId, params)
+	return err
+}
+
+// DecideMilestone converts echo context to params.
+func (w *ServerInterfaceWrapper) DecideMilestone(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", ctx.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contractId: %s", err))
+	}
+
+	// ------------- Path parameter "milestoneId" -------------
+	var milestoneId MilestoneId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "milestoneId", ctx.Param("milestoneId"), &milestoneId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter milestoneId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DecideMilestoneParams
+	// ------------- Required query parameter "decision" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "decision", ctx.QueryParams(), &params.Decision)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter decision: %s", err))
+	}
+
+	// ------------- Optional query parameter "comment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "comment", ctx.QueryParams(), &params.Comment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment: %s", err))
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DecideMilestone(ctx, contractId, milestoneId, params)
+	return err
+}
+
+// UpdateMilestoneStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMilestoneStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "contractId" -------------
+	var contractId ContractId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contractId", ctx.Param("contractId"), &contractId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contractId: %s", err))
+	}
+
+	// ------------- Path parameter "milestoneId" -------------
+	var milestoneId MilestoneId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "milestoneId", ctx.Param("milestoneId"), &milestoneId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter milestoneId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateMilestoneStatusParams
+	// ------------- Required query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "comment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "comment", ctx.QueryParams(), &params.Comment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment: %s", err))
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMilestoneStatus(ctx, contractId, milestoneId, params)
+	return err
+}
+
+// GetDebarments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDebarments(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDebarmentsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", ctx.QueryParams(), &params.Active)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDebarments(ctx, params)
+	return err
+}
+
+// DebarSupplier converts echo context to params.
+func (w *ServerInterfaceWrapper) DebarSupplier(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DebarSupplierParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DebarSupplier(ctx, params)
+	return err
+}
+
+// LiftDebarment converts echo context to params.
+func (w *ServerInterfaceWrapper) LiftDebarment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "debarmentId" -------------
+	var debarmentId DebarmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "debarmentId", ctx.Param("debarmentId"), &debarmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter debarmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LiftDebarmentParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LiftDebarment(ctx, debarmentId, params)
+	return err
+}
+
+// GetHealth converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealth(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealth(ctx)
+	return err
+}
+
+// GetCertificates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCertificates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCertificatesParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCertificates(ctx, organizationId, params)
+	return err
+}
+
+// AddCertificate converts echo context to params.
+func (w *ServerInterfaceWrapper) AddCertificate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddCertificateParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddCertificate(ctx, organizationId, params)
+	return err
+}
+
+// VerifyCertificate converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyCertificate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	// ------------- Path parameter "certificateId" -------------
+	var certificateId CertificateId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "certificateId", ctx.Param("certificateId"), &certificateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter certificateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VerifyCertificateParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyCertificate(ctx, organizationId, certificateId, params)
+	return err
+}
+
+// GetOrganizationDebarments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationDebarments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationDebarmentsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", ctx.QueryParams(), &params.Active)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationDebarments(ctx, organizationId, params)
+	return err
+}
+
+// DebarOrganizationSupplier converts echo context to params.
+func (w *ServerInterfaceWrapper) DebarOrganizationSupplier(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DebarOrganizationSupplierParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DebarOrganizationSupplier(ctx, organizationId, params)
+	return err
+}
+
+// LiftOrganizationDebarment converts echo context to params.
+func (w *ServerInterfaceWrapper) LiftOrganizationDebarment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	// ------------- Path parameter "debarmentId" -------------
+	var debarmentId DebarmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "debarmentId", ctx.Param("debarmentId"), &debarmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter debarmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LiftOrganizationDebarmentParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LiftOrganizationDebarment(ctx, organizationId, debarmentId, params)
+	return err
+}
+
+// GetTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTemplates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTemplates(ctx, organizationId, params)
+	return err
+}
+
+// CreateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTemplateParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTemplate(ctx, organizationId, params)
+	return err
+}
+
+// CheckServer converts echo context to params.
+func (w *ServerInterfaceWrapper) CheckServer(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CheckServer(ctx)
+	return err
+}
+
+// GetAuthorReputation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthorReputation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "authorUsername" -------------
+	var authorUsername Username
+
+	err = runtime.BindStyledParameterWithOptions("simple", "authorUsername", ctx.Param("authorUsername"), &authorUsername, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter authorUsername: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthorReputationParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthorReputation(ctx, authorUsername, params)
+	return err
+}
+
+// GetOrganizationReputation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizationReputation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationReputationParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrganizationReputation(ctx, organizationId, params)
+	return err
+}
+
+// EditReview converts echo context to params.
+func (w *ServerInterfaceWrapper) EditReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reviewId" -------------
+	var reviewId BidReviewId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewId", ctx.Param("reviewId"), &reviewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditReviewParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditReview(ctx, reviewId, params)
+	return err
+}
+
+// HideReview converts echo context to params.
+func (w *ServerInterfaceWrapper) HideReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reviewId" -------------
+	var reviewId BidReviewId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewId", ctx.Param("reviewId"), &reviewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HideReviewParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HideReview(ctx, reviewId, params)
+	return err
+}
+
+// GetReviewHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReviewHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reviewId" -------------
+	var reviewId BidReviewId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewId", ctx.Param("reviewId"), &reviewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReviewHistoryParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReviewHistory(ctx, reviewId, params)
+	return err
+}
+
+// ReplyToReview converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplyToReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reviewId" -------------
+	var reviewId BidReviewId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewId", ctx.Param("reviewId"), &reviewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplyToReviewParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplyToReview(ctx, reviewId, params)
+	return err
+}
+
+// RestoreReview converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reviewId" -------------
+	var reviewId BidReviewId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewId", ctx.Param("reviewId"), &reviewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreReviewParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreReview(ctx, reviewId, params)
+	return err
+}
+
+// GetTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplateParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTemplate(ctx, templateId, params)
+	return err
+}
+
+// EditTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) EditTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditTemplateParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditTemplate(ctx, templateId, params)
+	return err
+}
+
+// RollbackTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) RollbackTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackTemplateParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RollbackTemplate(ctx, templateId, version, params)
+	return err
+}
+
+// CreateTenderFromTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTenderFromTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTenderFromTemplateParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTenderFromTemplate(ctx, templateId, params)
+	return err
+}
+
+// GetTenders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTenders(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTendersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "service_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_type", ctx.QueryParams(), &params.ServiceType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter service_type: %s", err))
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTenders(ctx, params)
+	return err
+}
+
+// ImportTenders converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportTenders(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTendersParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// ------------- Optional query parameter "report" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "report", ctx.QueryParams(), &params.Report)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter report: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportTenders(ctx, params)
+	return err
+}
+
+// GetInvitedTenders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInvitedTenders(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInvitedTendersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInvitedTenders(ctx, params)
+	return err
+}
+
+// GetUserTenders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserTenders(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserTendersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserTenders(ctx, params)
+	return err
+}
+
+// ExportUserTenders converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportUserTenders(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportUserTendersParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportUserTenders(ctx, params)
+	return err
+}
+
+// CreateTender converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTender(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTender(ctx)
+	return err
+}
+
+// GetTenderAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTenderAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderAttachmentsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTenderAttachments(ctx, tenderId, params)
+	return err
+}
+
+// UploadTenderAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadTenderAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadTenderAttachmentParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadTenderAttachment(ctx, tenderId, params)
+	return err
+}
+
+// DeleteTenderAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTenderAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTenderAttachmentParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTenderAttachment(ctx, tenderId, attachmentId, params)
+	return err
+}
+
+// DownloadTenderAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadTenderAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadTenderAttachmentParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadTenderAttachment(ctx, tenderId, attachmentId, params)
+	return err
+}
+
+// GetAuction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuction(ctx, tenderId, params)
+	return err
+}
+
+// ScheduleAuction converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleAuction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleAuctionParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleAuction(ctx, tenderId, params)
+	return err
+}
+
+// StreamAuction converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamAuction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamAuctionParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamAuction(ctx, tenderId, params)
+	return err
+}
+
+// GetClarifications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClarifications(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClarificationsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClarifications(ctx, tenderId, params)
+	return err
+}
+
+// AskClarification converts echo context to params.
+func (w *ServerInterfaceWrapper) AskClarification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AskClarificationParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AskClarification(ctx, tenderId, params)
+	return err
+}
+
+// AnswerClarification converts echo context to params.
+func (w *ServerInterfaceWrapper) AnswerClarification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	// ------------- Path parameter "clarificationId" -------------
+	var clarificationId ClarificationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clarificationId", ctx.Param("clarificationId"), &clarificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clarificationId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AnswerClarificationParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
 
-	// Status Статус тендер
-	Status TenderStatus `json:"status"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AnswerClarification(ctx, tenderId, clarificationId, params)
+	return err
 }
 
-// EditTenderJSONBody defines parameters for EditTender.
-type EditTenderJSONBody struct {
-	// Description Описание тендера
-	Description *TenderDescription `json:"description,omitempty"`
+// CloneTender converts echo context to params.
+func (w *ServerInterfaceWrapper) CloneTender(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-	// Name Полное название тендера
-	Name *TenderName `json:"name,omitempty"`
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
 
-	// ServiceType Вид услуги, к которой относиться тендер
-	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-// EditTenderParams defines parameters for EditTender.
-type EditTenderParams struct {
-	Username Username `form:"username" json:"username"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CloneTenderParams
+	// ------------- Required query parameter "username" -------------
 
-// RollbackTenderParams defines parameters for RollbackTender.
-type RollbackTenderParams struct {
-	Username Username `form:"username" json:"username"`
-}
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
 
-// GetTenderStatusParams defines parameters for GetTenderStatus.
-type GetTenderStatusParams struct {
-	Username *Username `form:"username,omitempty" json:"username,omitempty"`
-}
+	// ------------- Optional query parameter "openingAt" -------------
 
-// UpdateTenderStatusParams defines parameters for UpdateTenderStatus.
-type UpdateTenderStatusParams struct {
-	Status   TenderStatus `form:"status" json:"status"`
-	Username Username     `form:"username" json:"username"`
+	err = runtime.BindQueryParameter("form", true, false, "openingAt", ctx.QueryParams(), &params.OpeningAt)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter openingAt: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CloneTender(ctx, tenderId, params)
+	return err
 }
 
-// CreateBidJSONRequestBody defines body for CreateBid for application/json ContentType.
-type CreateBidJSONRequestBody CreateBidJSONBody
+// GetCriteria converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCriteria(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-// EditBidJSONRequestBody defines body for EditBid for application/json ContentType.
-type EditBidJSONRequestBody EditBidJSONBody
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
 
-// CreateTenderJSONRequestBody defines body for CreateTender for application/json ContentType.
-type CreateTenderJSONRequestBody CreateTenderJSONBody
+	ctx.Set(BearerAuthScopes, []string{})
 
-// EditTenderJSONRequestBody defines body for EditTender for application/json ContentType.
-type EditTenderJSONRequestBody EditTenderJSONBody
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCriteriaParams
+	// ------------- Required query parameter "username" -------------
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получение списка ваших предложений
-	// (GET /bids/my)
-	GetUserBids(ctx echo.Context, params GetUserBidsParams) error
-	// Создание нового предложения
-	// (POST /bids/new)
-	CreateBid(ctx echo.Context) error
-	// Редактирование параметров предложения
-	// (PATCH /bids/{bidId}/edit)
-	EditBid(ctx echo.Context, bidId BidId, params EditBidParams) error
-	// Отправка отзыва по предложению
-	// (PUT /bids/{bidId}/feedback)
-	SubmitBidFeedback(ctx echo.Context, bidId BidId, params SubmitBidFeedbackParams) error
-	// Откат версии предложения
-	// (PUT /bids/{bidId}/rollback/{version})
-	RollbackBid(ctx echo.Context, bidId BidId, version int32, params RollbackBidParams) error
-	// Получение текущего статуса предложения
-	// (GET /bids/{bidId}/status)
-	GetBidStatus(ctx echo.Context, bidId BidId, params GetBidStatusParams) error
-	// Изменение статуса предложения
-	// (PUT /bids/{bidId}/status)
-	UpdateBidStatus(ctx echo.Context, bidId BidId, params UpdateBidStatusParams) error
-	// Отправка решения по предложению
-	// (PUT /bids/{bidId}/submit_decision)
-	SubmitBidDecision(ctx echo.Context, bidId BidId, params SubmitBidDecisionParams) error
-	// Получение списка предложений для тендера
-	// (GET /bids/{tenderId}/list)
-	GetBidsForTender(ctx echo.Context, tenderId TenderId, params GetBidsForTenderParams) error
-	// Просмотр отзывов на прошлые предложения
-	// (GET /bids/{tenderId}/reviews)
-	GetBidReviews(ctx echo.Context, tenderId TenderId, params GetBidReviewsParams) error
-	// Проверка доступности сервера
-	// (GET /ping)
-	CheckServer(ctx echo.Context) error
-	// Получение списка тендеров
-	// (GET /tenders)
-	GetTenders(ctx echo.Context, params GetTendersParams) error
-	// Получить тендеры пользователя
-	// (GET /tenders/my)
-	GetUserTenders(ctx echo.Context, params GetUserTendersParams) error
-	// Создание нового тендера
-	// (POST /tenders/new)
-	CreateTender(ctx echo.Context) error
-	// Редактирование тендера
-	// (PATCH /tenders/{tenderId}/edit)
-	EditTender(ctx echo.Context, tenderId TenderId, params EditTenderParams) error
-	// Откат версии тендера
-	// (PUT /tenders/{tenderId}/rollback/{version})
-	RollbackTender(ctx echo.Context, tenderId TenderId, version int32, params RollbackTenderParams) error
-	// Получение текущего статуса тендера
-	// (GET /tenders/{tenderId}/status)
-	GetTenderStatus(ctx echo.Context, tenderId TenderId, params GetTenderStatusParams) error
-	// Изменение статуса тендера
-	// (PUT /tenders/{tenderId}/status)
-	UpdateTenderStatus(ctx echo.Context, tenderId TenderId, params UpdateTenderStatusParams) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCriteria(ctx, tenderId, params)
+	return err
 }
 
-// GetUserBids converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUserBids(ctx echo.Context) error {
+// SetCriteria converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCriteria(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserBidsParams
-	// ------------- Optional query parameter "limit" -------------
+	var params SetCriteriaParams
+	// ------------- Required query parameter "username" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCriteria(ctx, tenderId, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+// DiscardTenderDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) DiscardTenderDraft(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
-	// ------------- Optional query parameter "username" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiscardTenderDraftParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUserBids(ctx, params)
+	err = w.Handler.DiscardTenderDraft(ctx, tenderId, params)
 	return err
 }
 
-// CreateBid converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateBid(ctx echo.Context) error {
+// GetTenderDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTenderDraft(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderDraftParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateBid(ctx)
+	err = w.Handler.GetTenderDraft(ctx, tenderId, params)
 	return err
 }
 
-// EditBid converts echo context to params.
-func (w *ServerInterfaceWrapper) EditBid(ctx echo.Context) error {
+// SaveTenderDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) SaveTenderDraft(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "bidId" -------------
-	var bidId BidId
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params EditBidParams
+	var params SaveTenderDraftParams
 	// ------------- Required query parameter "username" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
@@ -528,32 +5606,52 @@ func (w *ServerInterfaceWrapper) EditBid(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EditBid(ctx, bidId, params)
+	err = w.Handler.SaveTenderDraft(ctx, tenderId, params)
 	return err
 }
 
-// SubmitBidFeedback converts echo context to params.
-func (w *ServerInterfaceWrapper) SubmitBidFeedback(ctx echo.Context) error {
+// PublishTenderDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) PublishTenderDraft(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "bidId" -------------
-	var bidId BidId
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SubmitBidFeedbackParams
-	// ------------- Required query parameter "bidFeedback" -------------
+	var params PublishTenderDraftParams
+	// ------------- Required query parameter "username" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "bidFeedback", ctx.QueryParams(), &params.BidFeedback)
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidFeedback: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PublishTenderDraft(ctx, tenderId, params)
+	return err
+}
+
+// EditTender converts echo context to params.
+func (w *ServerInterfaceWrapper) EditTender(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditTenderParams
 	// ------------- Required query parameter "username" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
@@ -562,33 +5660,52 @@ func (w *ServerInterfaceWrapper) SubmitBidFeedback(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SubmitBidFeedback(ctx, bidId, params)
+	err = w.Handler.EditTender(ctx, tenderId, params)
 	return err
 }
 
-// RollbackBid converts echo context to params.
-func (w *ServerInterfaceWrapper) RollbackBid(ctx echo.Context) error {
+// GetEligibilityRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEligibilityRules(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "bidId" -------------
-	var bidId BidId
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEligibilityRulesParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version int32
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEligibilityRules(ctx, tenderId, params)
+	return err
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// SetEligibilityRules converts echo context to params.
+func (w *ServerInterfaceWrapper) SetEligibilityRules(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RollbackBidParams
+	var params SetEligibilityRulesParams
 	// ------------- Required query parameter "username" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
@@ -597,25 +5714,25 @@ func (w *ServerInterfaceWrapper) RollbackBid(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RollbackBid(ctx, bidId, version, params)
+	err = w.Handler.SetEligibilityRules(ctx, tenderId, params)
 	return err
 }
 
-// GetBidStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBidStatus(ctx echo.Context) error {
+// CheckEligibility converts echo context to params.
+func (w *ServerInterfaceWrapper) CheckEligibility(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "bidId" -------------
-	var bidId BidId
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBidStatusParams
+	var params CheckEligibilityParams
 	// ------------- Required query parameter "username" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
@@ -624,32 +5741,25 @@ func (w *ServerInterfaceWrapper) GetBidStatus(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBidStatus(ctx, bidId, params)
+	err = w.Handler.CheckEligibility(ctx, tenderId, params)
 	return err
 }
 
-// UpdateBidStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateBidStatus(ctx echo.Context) error {
+// AcceptInvitation converts echo context to params.
+func (w *ServerInterfaceWrapper) AcceptInvitation(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "bidId" -------------
-	var bidId BidId
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateBidStatusParams
-	// ------------- Required query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "status", ctx.QueryParams(), &params.Status)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
-	}
-
+	var params AcceptInvitationParams
 	// ------------- Required query parameter "username" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
@@ -658,32 +5768,25 @@ func (w *ServerInterfaceWrapper) UpdateBidStatus(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateBidStatus(ctx, bidId, params)
+	err = w.Handler.AcceptInvitation(ctx, tenderId, params)
 	return err
 }
 
-// SubmitBidDecision converts echo context to params.
-func (w *ServerInterfaceWrapper) SubmitBidDecision(ctx echo.Context) error {
+// DeclineInvitation converts echo context to params.
+func (w *ServerInterfaceWrapper) DeclineInvitation(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "bidId" -------------
-	var bidId BidId
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bidId", ctx.Param("bidId"), &bidId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SubmitBidDecisionParams
-	// ------------- Required query parameter "decision" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "decision", ctx.QueryParams(), &params.Decision)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter decision: %s", err))
-	}
-
+	var params DeclineInvitationParams
 	// ------------- Required query parameter "username" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
@@ -692,12 +5795,12 @@ func (w *ServerInterfaceWrapper) SubmitBidDecision(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SubmitBidDecision(ctx, bidId, params)
+	err = w.Handler.DeclineInvitation(ctx, tenderId, params)
 	return err
 }
 
-// GetBidsForTender converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBidsForTender(ctx echo.Context) error {
+// GetInvitations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInvitations(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenderId" -------------
 	var tenderId TenderId
@@ -710,7 +5813,7 @@ func (w *ServerInterfaceWrapper) GetBidsForTender(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBidsForTenderParams
+	var params GetInvitationsParams
 	// ------------- Required query parameter "username" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
@@ -733,12 +5836,12 @@ func (w *ServerInterfaceWrapper) GetBidsForTender(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBidsForTender(ctx, tenderId, params)
+	err = w.Handler.GetInvitations(ctx, tenderId, params)
 	return err
 }
 
-// GetBidReviews converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBidReviews(ctx echo.Context) error {
+// RevokeInvitation converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeInvitation(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenderId" -------------
 	var tenderId TenderId
@@ -748,133 +5851,240 @@ func (w *ServerInterfaceWrapper) GetBidReviews(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBidReviewsParams
-	// ------------- Required query parameter "authorUsername" -------------
+	var params RevokeInvitationParams
+	// ------------- Required query parameter "username" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "authorUsername", ctx.QueryParams(), &params.AuthorUsername)
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter authorUsername: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
-	// ------------- Required query parameter "requesterUsername" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeInvitation(ctx, tenderId, organizationId, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "requesterUsername", ctx.QueryParams(), &params.RequesterUsername)
+// InviteOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) InviteOrganization(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter requesterUsername: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", ctx.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationId: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InviteOrganizationParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBidReviews(ctx, tenderId, params)
+	err = w.Handler.InviteOrganization(ctx, tenderId, organizationId, params)
 	return err
 }
 
-// CheckServer converts echo context to params.
-func (w *ServerInterfaceWrapper) CheckServer(ctx echo.Context) error {
+// GetLots converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLots(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLotsParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CheckServer(ctx)
+	err = w.Handler.GetLots(ctx, tenderId, params)
 	return err
 }
 
-// GetTenders converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTenders(ctx echo.Context) error {
+// AddLot converts echo context to params.
+func (w *ServerInterfaceWrapper) AddLot(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTendersParams
-	// ------------- Optional query parameter "limit" -------------
+	var params AddLotParams
+	// ------------- Required query parameter "username" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddLot(ctx, tenderId, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+// EditLot converts echo context to params.
+func (w *ServerInterfaceWrapper) EditLot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
 	}
 
-	// ------------- Optional query parameter "service_type" -------------
+	// ------------- Path parameter "lotId" -------------
+	var lotId LotId
 
-	err = runtime.BindQueryParameter("form", true, false, "service_type", ctx.QueryParams(), &params.ServiceType)
+	err = runtime.BindStyledParameterWithOptions("simple", "lotId", ctx.Param("lotId"), &lotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter service_type: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lotId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditLotParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTenders(ctx, params)
+	err = w.Handler.EditLot(ctx, tenderId, lotId, params)
 	return err
 }
 
-// GetUserTenders converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUserTenders(ctx echo.Context) error {
+// AwardLot converts echo context to params.
+func (w *ServerInterfaceWrapper) AwardLot(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserTendersParams
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "lotId" -------------
+	var lotId LotId
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "lotId", ctx.Param("lotId"), &lotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lotId: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AwardLotParams
+	// ------------- Required query parameter "bidId" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "bidId", ctx.QueryParams(), &params.BidId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bidId: %s", err))
 	}
 
-	// ------------- Optional query parameter "username" -------------
+	// ------------- Required query parameter "username" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUserTenders(ctx, params)
+	err = w.Handler.AwardLot(ctx, tenderId, lotId, params)
 	return err
 }
 
-// CreateTender converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateTender(ctx echo.Context) error {
+// CancelLot converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelLot(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", ctx.Param("tenderId"), &tenderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenderId: %s", err))
+	}
+
+	// ------------- Path parameter "lotId" -------------
+	var lotId LotId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lotId", ctx.Param("lotId"), &lotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lotId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelLotParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateTender(ctx)
+	err = w.Handler.CancelLot(ctx, tenderId, lotId, params)
 	return err
 }
 
-// EditTender converts echo context to params.
-func (w *ServerInterfaceWrapper) EditTender(ctx echo.Context) error {
+// GetTenderRanking converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTenderRanking(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenderId" -------------
 	var tenderId TenderId
@@ -887,7 +6097,7 @@ func (w *ServerInterfaceWrapper) EditTender(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params EditTenderParams
+	var params GetTenderRankingParams
 	// ------------- Required query parameter "username" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
@@ -895,8 +6105,22 @@ func (w *ServerInterfaceWrapper) EditTender(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EditTender(ctx, tenderId, params)
+	err = w.Handler.GetTenderRanking(ctx, tenderId, params)
 	return err
 }
 
@@ -996,6 +6220,26 @@ func (w *ServerInterfaceWrapper) UpdateTenderStatus(ctx echo.Context) error {
 	return err
 }
 
+// BatchTenders converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchTenders(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchTendersParams
+	// ------------- Required query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchTenders(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -1024,103 +6268,529 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/audit", wrapper.GetAuditLog)
 	router.GET(baseURL+"/bids/my", wrapper.GetUserBids)
 	router.POST(baseURL+"/bids/new", wrapper.CreateBid)
+	router.GET(baseURL+"/bids/:bidId/attachments", wrapper.GetBidAttachments)
+	router.POST(baseURL+"/bids/:bidId/attachments", wrapper.UploadBidAttachment)
+	router.DELETE(baseURL+"/bids/:bidId/attachments/:attachmentId", wrapper.DeleteBidAttachment)
+	router.GET(baseURL+"/bids/:bidId/attachments/:attachmentId", wrapper.DownloadBidAttachment)
+	router.PUT(baseURL+"/bids/:bidId/auction/price", wrapper.SubmitAuctionPrice)
+	router.GET(baseURL+"/bids/:bidId/decisions", wrapper.GetDecisionRecords)
+	router.DELETE(baseURL+"/bids/:bidId/draft", wrapper.DiscardBidDraft)
+	router.GET(baseURL+"/bids/:bidId/draft", wrapper.GetBidDraft)
+	router.PUT(baseURL+"/bids/:bidId/draft", wrapper.SaveBidDraft)
+	router.PUT(baseURL+"/bids/:bidId/draft/publish", wrapper.PublishBidDraft)
 	router.PATCH(baseURL+"/bids/:bidId/edit", wrapper.EditBid)
 	router.PUT(baseURL+"/bids/:bidId/feedback", wrapper.SubmitBidFeedback)
+	router.GET(baseURL+"/bids/:bidId/history", wrapper.GetBidHistory)
+	router.GET(baseURL+"/bids/:bidId/negotiation", wrapper.GetNegotiation)
+	router.POST(baseURL+"/bids/:bidId/negotiation", wrapper.ProposeTerms)
+	router.PUT(baseURL+"/bids/:bidId/negotiation/accept", wrapper.AcceptTerms)
+	router.PUT(baseURL+"/bids/:bidId/negotiation/counter", wrapper.CounterTerms)
+	router.PUT(baseURL+"/bids/:bidId/negotiation/reject", wrapper.RejectTerms)
+	router.PUT(baseURL+"/bids/:bidId/qualification", wrapper.QualifyBid)
 	router.PUT(baseURL+"/bids/:bidId/rollback/:version", wrapper.RollbackBid)
+	router.GET(baseURL+"/bids/:bidId/scores", wrapper.GetBidScores)
+	router.PUT(baseURL+"/bids/:bidId/scores", wrapper.ScoreBid)
 	router.GET(baseURL+"/bids/:bidId/status", wrapper.GetBidStatus)
 	router.PUT(baseURL+"/bids/:bidId/status", wrapper.UpdateBidStatus)
 	router.PUT(baseURL+"/bids/:bidId/submit_decision", wrapper.SubmitBidDecision)
+	router.GET(baseURL+"/bids/:id/reviews", wrapper.GetBidReviews)
+	router.GET(baseURL+"/bids/:id/reviews/export", wrapper.ExportBidReviews)
 	router.GET(baseURL+"/bids/:tenderId/list", wrapper.GetBidsForTender)
-	router.GET(baseURL+"/bids/:tenderId/reviews", wrapper.GetBidReviews)
+	router.GET(baseURL+"/bids/:tenderId/list/export", wrapper.ExportBidsForTender)
+	router.PUT(baseURL+"/bids/:tenderId/open", wrapper.OpenBids)
+	router.GET(baseURL+"/bids/:tenderId/opening", wrapper.GetBidOpening)
+	router.POST(baseURL+"/bids:batch", wrapper.BatchBids)
+	router.GET(baseURL+"/contracts/my", wrapper.GetUserContracts)
+	router.POST(baseURL+"/contracts/new", wrapper.CreateContract)
+	router.GET(baseURL+"/contracts/:contractId", wrapper.GetContract)
+	router.PUT(baseURL+"/contracts/:contractId/close", wrapper.CloseContract)
+	router.GET(baseURL+"/contracts/:contractId/milestones", wrapper.GetMilestones)
+	router.POST(baseURL+"/contracts/:contractId/milestones", wrapper.AddMilestone)
+	router.PUT(baseURL+"/contracts/:contractId/milestones/:milestoneId/decision", wrapper.DecideMilestone)
+	router.PUT(baseURL+"/contracts/:contractId/milestones/:milestoneId/status", wrapper.UpdateMilestoneStatus)
+	router.GET(baseURL+"/debarments", wrapper.GetDebarments)
+	router.POST(baseURL+"/debarments", wrapper.DebarSupplier)
+	router.PUT(baseURL+"/debarments/:debarmentId/lift", wrapper.LiftDebarment)
+	router.GET(baseURL+"/health", wrapper.GetHealth)
+	router.GET(baseURL+"/organizations/:organizationId/certificates", wrapper.GetCertificates)
+	router.POST(baseURL+"/organizations/:organizationId/certificates", wrapper.AddCertificate)
+	router.PUT(baseURL+"/organizations/:organizationId/certificates/:certificateId/verify", wrapper.VerifyCertificate)
+	router.GET(baseURL+"/organizations/:organizationId/debarments", wrapper.GetOrganizationDebarments)
+	router.POST(baseURL+"/organizations/:organizationId/debarments", wrapper.DebarOrganizationSupplier)
+	router.PUT(baseURL+"/organizations/:organizationId/debarments/:debarmentId/lift", wrapper.LiftOrganizationDebarment)
+	router.GET(baseURL+"/organizations/:organizationId/templates", wrapper.GetTemplates)
+	router.POST(baseURL+"/organizations/:organizationId/templates", wrapper.CreateTemplate)
 	router.GET(baseURL+"/ping", wrapper.CheckServer)
+	router.GET(baseURL+"/reputation/authors/:authorUsername", wrapper.GetAuthorReputation)
+	router.GET(baseURL+"/reputation/organizations/:organizationId", wrapper.GetOrganizationReputation)
+	router.PATCH(baseURL+"/reviews/:reviewId/edit", wrapper.EditReview)
+	router.PUT(baseURL+"/reviews/:reviewId/hide", wrapper.HideReview)
+	router.GET(baseURL+"/reviews/:reviewId/history", wrapper.GetReviewHistory)
+	router.PUT(baseURL+"/reviews/:reviewId/reply", wrapper.ReplyToReview)
+	router.PUT(baseURL+"/reviews/:reviewId/restore", wrapper.RestoreReview)
+	router.GET(baseURL+"/templates/:templateId", wrapper.GetTemplate)
+	router.PATCH(baseURL+"/templates/:templateId/edit", wrapper.EditTemplate)
+	router.PUT(baseURL+"/templates/:templateId/rollback/:version", wrapper.RollbackTemplate)
+	router.POST(baseURL+"/templates/:templateId/tender", wrapper.CreateTenderFromTemplate)
 	router.GET(baseURL+"/tenders", wrapper.GetTenders)
+	router.POST(baseURL+"/tenders/import", wrapper.ImportTenders)
+	router.GET(baseURL+"/tenders/invited", wrapper.GetInvitedTenders)
 	router.GET(baseURL+"/tenders/my", wrapper.GetUserTenders)
+	router.GET(baseURL+"/tenders/my/export", wrapper.ExportUserTenders)
 	router.POST(baseURL+"/tenders/new", wrapper.CreateTender)
+	router.GET(baseURL+"/tenders/:tenderId/attachments", wrapper.GetTenderAttachments)
+	router.POST(baseURL+"/tenders/:tenderId/attachments", wrapper.UploadTenderAttachment)
+	router.DELETE(baseURL+"/tenders/:tenderId/attachments/:attachmentId", wrapper.DeleteTenderAttachment)
+	router.GET(baseURL+"/tenders/:tenderId/attachments/:attachmentId", wrapper.DownloadTenderAttachment)
+	router.GET(baseURL+"/tenders/:tenderId/auction", wrapper.GetAuction)
+	router.PUT(baseURL+"/tenders/:tenderId/auction", wrapper.ScheduleAuction)
+	router.GET(baseURL+"/tenders/:tenderId/auction/stream", wrapper.StreamAuction)
+	router.GET(baseURL+"/tenders/:tenderId/clarifications", wrapper.GetClarifications)
+	router.POST(baseURL+"/tenders/:tenderId/clarifications", wrapper.AskClarification)
+	router.PUT(baseURL+"/tenders/:tenderId/clarifications/:clarificationId/answer", wrapper.AnswerClarification)
+	router.POST(baseURL+"/tenders/:tenderId/clone", wrapper.CloneTender)
+	router.GET(baseURL+"/tenders/:tenderId/criteria", wrapper.GetCriteria)
+	router.PUT(baseURL+"/tenders/:tenderId/criteria", wrapper.SetCriteria)
+	router.DELETE(baseURL+"/tenders/:tenderId/draft", wrapper.DiscardTenderDraft)
+	router.GET(baseURL+"/tenders/:tenderId/draft", wrapper.GetTenderDraft)
+	router.PUT(baseURL+"/tenders/:tenderId/draft", wrapper.SaveTenderDraft)
+	router.PUT(baseURL+"/tenders/:tenderId/draft/publish", wrapper.PublishTenderDraft)
 	router.PATCH(baseURL+"/tenders/:tenderId/edit", wrapper.EditTender)
+	router.GET(baseURL+"/tenders/:tenderId/eligibility", wrapper.GetEligibilityRules)
+	router.PUT(baseURL+"/tenders/:tenderId/eligibility", wrapper.SetEligibilityRules)
+	router.GET(baseURL+"/tenders/:tenderId/eligibility/check", wrapper.CheckEligibility)
+	router.PUT(baseURL+"/tenders/:tenderId/invitation/accept", wrapper.AcceptInvitation)
+	router.PUT(baseURL+"/tenders/:tenderId/invitation/decline", wrapper.DeclineInvitation)
+	router.GET(baseURL+"/tenders/:tenderId/invitations", wrapper.GetInvitations)
+	router.DELETE(baseURL+"/tenders/:tenderId/invitations/:organizationId", wrapper.RevokeInvitation)
+	router.PUT(baseURL+"/tenders/:tenderId/invitations/:organizationId", wrapper.InviteOrganization)
+	router.GET(baseURL+"/tenders/:tenderId/lots", wrapper.GetLots)
+	router.POST(baseURL+"/tenders/:tenderId/lots", wrapper.AddLot)
+	router.PATCH(baseURL+"/tenders/:tenderId/lots/:lotId", wrapper.EditLot)
+	router.PUT(baseURL+"/tenders/:tenderId/lots/:lotId/award", wrapper.AwardLot)
+	router.PUT(baseURL+"/tenders/:tenderId/lots/:lotId/cancel", wrapper.CancelLot)
+	router.GET(baseURL+"/tenders/:tenderId/ranking", wrapper.GetTenderRanking)
 	router.PUT(baseURL+"/tenders/:tenderId/rollback/:version", wrapper.RollbackTender)
 	router.GET(baseURL+"/tenders/:tenderId/status", wrapper.GetTenderStatus)
 	router.PUT(baseURL+"/tenders/:tenderId/status", wrapper.UpdateTenderStatus)
+	router.POST(baseURL+"/tenders:batch", wrapper.BatchTenders)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdW28bR5b+K+3efZgBaKplSx4P32xnshjsTBL4koe1jKBFlqSOeUuz6bXXIKCLEzsr",
-	"R14ssoARJPYm+7BPAagLLYoSqb9w6h8Nzqnq7uob2ZRoXfthMhbF7qo6der7zq2OnuvFWqVeq7Kq09AL",
-	"z/W6aZsV5jBb/rRoVU3HqlX/ZlUsBz8qsUbRtur4mV7Q4WdoQ4+vQBcOoA37/DX0YQAdjb+ELl+BfRho",
-	"sAUD2IUtvgxt/j20oQMHfJ1/q8EANvl/Qgd6fBUGsJXX4C1fgUMY0It2+Rp0+Cpf4W802IF9/L9daMMh",
-	"X4YBX8EnNL6iwSG0YRu60Ic2/w660IG9/Fx1rgq/Qocvwxb+F18wgH34AB3oR2bEV/lrjRYQtxR69JCv",
-	"8RW+Sr8Mry+8jLmqntMtFM83TWY/03N61awwvaCXSYg5vVFcYhVTSHPBbJYdvTCb0xdqdsV09IJuVZ3r",
-	"1/ScXjGfWpVmRS/MGjm9YlXFD0ZOd57VmfgeW2S23mrllJ36fGGhweK26idcn1hRj4TR5S+hQ6vailmG",
-	"L7I+/naTrwsxkfhJHt+jMGFAm4DCf4lig/ZEtzFBkjWxyFhRGnGiHCa9lvsa0vl5qxQjvLfQ5y9gwJdR",
-	"OXB+uIyBkEYHlwUDoVzQha6e09lTs1IvM3yT2XSWavZfS3pBvzFtztycXTCusmt/nr86M12auWr+afrG",
-	"1ZmZGzdmZ2dmDMMw9Jx84r6Y54MGs/WcXrSZ6bDSLVzVNcO4cdWYvmpcuz89WzBmCsbsvxl/KtCzOHt9",
-	"dtZgN2eMUeNIYcKPuBF8FdqwBT1oa0IBoE07vaXBf8E+6cUKbgoK3TGdZkMv6HfEpPSc/oTZDSGradRG",
-	"u1ZntmOxRlAAz/V/ttmCXtD/acqHnSkp/Kl5q3TL/WorKIWUD9KXWwFhRXZSQQXSNdLKNq5eg65GoIAA",
-	"9UbDleNhP8Bt5as5cWy28duar91SUqvQwZ81GPBVUgqUZRf24zWkQwdGI+3fxReKT/NzVXhPE+vQh96x",
-	"2dIU5VuFjnb30zvXr1//szggnq4NVQyp9g3HtqqLKKSAXEYK+BPl2y2hZiOfEfsotGzklz/Dr7V85Rr5",
-	"wD3xxVZOd1i1xFIomPe9llDYdCv/Un4TccJm3zQtm5X0wkMUgVxcUJbeEpSJqSoZ0Oycfzr8OT3ydqs2",
-	"/zUrOjhf9XBElfr/SIF6Lm/xddjToAs7QnWhy1+IX+PJRjZsw5b4J+lyVEP5m5z4HEkO2RI/Fa9FFPAO",
-	"0AAO8gENTIk8FfPp31h10VnSC9OGEaOcwSMdXfBv0IXDwDpwGlWE+If65/aiWbX+w5TbQQj6KH6QT1jR",
-	"cvUgNMT/Qoe/8g/sYQLc8w1l5Fv1ul17Qoh4l+HWsVLyyIHjFxr8HRyS7Nve8HGbFJTkbJIkP2WsNG8W",
-	"H8eNw1dhl68j1vUTdAE6kQ1LGGciqnlGtfEziWKh1b0nG0kain1oo1k51qYlDniXPbHYvw/fsnQGSFrT",
-	"ITjOrXJZW6zVarXSlStXroxlWUQsgLPEx4M0Gn+WmVgoxlH4WDyJ/BdHZUEO87csgY2i05gMiiXDizf9",
-	"44OMpwTQPn1ouedZPOGjgaeAr6LrmSw6l3p8W/yL5nzZaizRv++Y1SIr0z/H4KYvfePIc6umwwABv4jD",
-	"iPIkIZHv3KWzSK6CNH7bJNeePswtm466ZTmd2XbNvssa9Vq1EYe9o7zMoJcvJYZ7/wq6sAk9OdcY3NgI",
-	"AqjNzAYNOdc0jOtF4SnzN3wF+i5g5MSyXxJWrQU83KRB3iAAdQSmfcDJK042joCS2yRNbUOfRmZRZHWn",
-	"NvroBZaNLjfJZxMGsEP8tecjW0dsjWdXRJQkBB9yEnFAUVNMsQmd3GXYlqvalaGC7imfYWHmp48akAL0",
-	"cY14pidB1vALX3MjNTuKN9/11AlnwdcopoMikkohTwpFg+AAVQbasMPXhblLMhMqiP/r8G/lZnUnHmjA",
-	"IX8i86kNff5au6rBzwQjPfw9+lTMfmIVmQyKfMLK1hMRERozGHGGTBFVDc5ZQEBo/Ng2iOqApwkLiO+7",
-	"kYEolgx7NvTtVkiF0gx8T3kgdWRCPuoFJ1IGGsRjR4g1KHNUIg+h1fvTGGXZRXd2NLcE8KydxilVIzbH",
-	"I4Tg2GeCCI7iKg4T4bCx7gV1OjTkf6PgNLQfYZ+vwTYxZY/QSoZNkPfphz6hLfEFQYw6IdXKrFUbjt0s",
-	"Su1TcPjvZrW5YBadps1izcrAuRhu6yaNHW/hlmuNBEs2eKZO15htNphdjVeMOI1vlJuLiYZjUHkd1nC+",
-	"aoosQdROa7Bi07acZ/cQZwQFzjPTZvatJurWc5H6wIfEx/5LlhynLrIjVnWhFp33rS/+6hoQfM0Tzr5n",
-	"aAdVGg5Qmt0EPwZ/m9coa/eOzGpcL0qio/EXfA360BOWnkajovWwzzf4S2jzDb4aM37kSNH4fwgTbE4j",
-	"M5J4PGjK44Ehc16+bEViXg/af8R1xA6ZvLhJDS0I3rEc2vr7pODa382qucgqrOqgeBSwL+jTeQrH1Oqs",
-	"atYtvaBfzxv5aT2n101nibRhat4qNaYqz/Dfi7GJw/dDpxQfQdkT4u/JNOE2haoStJm2/UdXkXbI+RFZ",
-	"SbS7VCdPPOg6cr4WdGQoh5wZseEf5NxCWcWuECBahJ4dof8Lcx40mH3bKjVIMn4K/GE8aftfmQqnyFu5",
-	"MR6RuVp8Ji7L6WGGmuccZkR4D7Raj9CAEK4zbfM1wyDzt1Z1WJV22azXy1aR5jH1tXQi/WEsh1XSZGEI",
-	"ZwVgmLZtPhOIETGshboMkHzi1SVJO3JET/gsXyb6X/aUQNoGFJhH7HzhuTxreZzWzJhLHrbSYCwibo2/",
-	"QMd1HYTFoRjowYw3ajU6XJp/MNoSotDAX+brcvrTJzj990leS5/OuzjH4liKUIu3CESvHm3Psqwe6ECf",
-	"VjArNiDZy5IRkG1hjlBE2wt8oDGwLqaC01AEyNdzGs5FDD8gpMAJCddS2BDI5tqsYXiz3OcbFOzY02CH",
-	"POFt/AHNIWEQQke7Zhj5AGfS+VfZ8uEjPFeNZqVi2s/SQCNO/xV0+bcJak/DCQSuimB/vdaId00D5JHA",
-	"NB4fh/aLb3goHGTFfAQLhZF1mxwO9EBYw7ldKz0bSxNjPO6a/UAxgNJh2DFT1GPmnY/tXZ5E3jrkFqbO",
-	"PkfcwfCmRF3BGIz40YNd8mBEJGk7KS9KZqo/W8dustYxSWkkF8UBW2z9BWHEIWV4RQmTcr4GeS0IUr5L",
-	"2YYu/bcjjM7xQpeBEFLQHERhZYyVMdbHZ6wIkYw+xwpJPacsf2uKlUQ1aN10ikvxtRM0Ri9ksnVjtkYh",
-	"p2QcCZLUX0qWIygqZKyTFY2ujW9Ei7qEMBLl0qOKwN2RFvrR3h+22CdBuCfHmq00rCED2F7FrFJREwAR",
-	"cZy6mjCXSC1FmfCurC71XAUva7EptdcPOiRoELmY/+MewOALO/JMHyqB9j4M0PEQCRU3Z9H34++b0IHd",
-	"qAMvK1bPCemFpj8QDBUu1kbcDEu6j6cWnw+ntzZOgcZCVklHzbGIIm6EHHeeChrxdZURBCN72Kyg06pG",
-	"6tkhNPbiD3CQcd454bxhbBSPQml5cEGprKs3nYRqLU8hZTbWr0BKLiqMkt695nyFaM8r5zt1+psPTObI",
-	"Q3jvODmePWE8fpdmyyP4rCaM973zerLoGigQTapWSZjneUfGGeP6CbtjXu0G+YwvhRpI0fp1TXxdREtd",
-	"u0MEfjqwJ8Pmvjs5c5I7kHTRQaY794RnDINLwjoq8lMwUCk6HFpOHqUZu1YuI0BOPZeZndZwwhFBB0k3",
-	"EV89MXjY0/gaxTN2hUklRKKkQ/Ma/I67geJEc35VrUlxHck9TVm2kOMhDPgPtI1UHOe9ER/rUnKPwiJe",
-	"4GTZr+aL8uBdKYwTdwBTZYxjE+x9vzprENme2Ppj995bcCl+EUfyYsbKS19Uvk3n//h70Vb8n1G66ZmP",
-	"7Sxml7H0BWFpKeWA9oeZW2rM5WDunjhRwVqglF6hnwoaVVAhOEBdYiI5k8Egc3ihzIPLq4m5B76Wjyt6",
-	"uO0loy5eIPXjEY6bv4s5XL95JS9UATN6WzMGySJgp1SnEC7PCsyqnQh2uQS3460XSz5FTHtQL4nihbMC",
-	"a14u/shv99Dmglrqo++4DU9bZAia2eBZpOx8s9PbcB10WjKKWt6UI/mqpHR1SJuWoRy13+/hD1RLLAQp",
-	"IzVdX1Q9monkuj8eMY/j9Z44dZoq+TM58vu91VxYqkrdEGR0Ikce7ZPlrdD806dzvNlmNJXRVJbQ8W4v",
-	"K4zheTMjUzpuVW5rqmyJUu+0t27iS8hzdJWfv3GTNlQzxVdCqRy+DgfB2m95CTIuKNT4tGaLq0WpqEmp",
-	"Mz4arqv1zR+fOnInc6fnnF3Aye7ZZLR2OWjtN7XrgpTtYUZ1Y91rSrjDJ28fBe+yx3KfTb2chuRI3gUL",
-	"MZWr/Ciy2D4wfCNgz4qb2wcEa8v0Jrd7LX8F+6Jc1CvJ4OvJ7cBQbZVGgzklve/2FnBr6pP7fcozke4S",
-	"lqDhu1JGp8DBkeDugTRw4vsYjWon6dJLSNaqGDuBKgkXwgO7l09oBiw6aD6YrIkwlgQiKuEda7r+510Z",
-	"UmWQtBp5B4BNekEXyeaRvRHHtnz8CqzBsP5/4iJ6sBBqW1g/np5nlk9m+VwEyyeEype04uN9kG+SkUKx",
-	"HYbEpOtWdTHZtPld9FPT+A+0G6goXeiTvo/oaHgovTIUPPXx8yQu+veg4xZsddROuxWy+8r/01Hzbr0O",
-	"YFvixgG+apP6c9DR/CBao4kCUxpPYJIS+RRM6O+ruHolDv6B+ycD+uJXkRMjU9S0JvcmGF/h34leH3yZ",
-	"/kLAVlw3jztLrPj4HrOfUPhiBB857KkzVS+bVuig+e11ao9j+urE8YxyGiZyEF5Ko2EOp619/q9zen6u",
-	"Smd4340pk1xxY+j4oZXxQV5di4UplzzWaAZzeu0xvfMCMNm5RagQBHlnu+1tIV+Dw8TTLeBG2O5DnCnV",
-	"CAoFAcUf3xC3/oTzJMfir/mGRiUX+/w1GeGyg47sDbkKXTjka0p3seAtS/VRj1tQtjvi7l0u+mdYNrz2",
-	"hrjKwEz5ekLjnvty7afVtyfScS3cc1Z2sQqsRfnbKnw99uKhayPx13GBXLrciHI8SBb/SjDktyaRdC8X",
-	"vzPU40CWOb9xdyL5D7DItoNfObLvYBQ4k3vFPcqlM/RjmzCGDP4T8Thkf9dx3Y3wSRseYqUeMTEhVvlp",
-	"oGHgqdyzzUB70mG0sIIE0Pw4LdmiGH/umrGdNq6fy35sEwKqrBlbVuR8rtFWVnSFbK4EpQ6i7pht2NTu",
-	"OcGoPtnVnr0rDDdhPAf7DVBn0qQ+bF4W/jy1YottDn452n2n6s+WsmP3ZFu0RVNOJ9elxqWlESHJIT3Z",
-	"so5sGW1dyo5scbl0l6qUdPqofmzRCuvYvjfH6Bv6l5LlXNyasdPozTYhGj0eDZ69Dm8hRbyond2OxpnB",
-	"eWcN3bKGbpeuoVtKxpxwS52Q73fOWumcHnF/lJ466mZknXQ+Kt8EWuhkDXSyIqpLWUSVtc0Z0jYnJSEf",
-	"q2lOmH4/QrOc+2qg7+y5uGeQTI7WKCccaMgYIvMozmKDnDCsHakxzhDcOkrnm7OIUcdsgRPOr1xYK/vX",
-	"ZLXIGuBkNvTFtaHD9nLW9CaeYFoymO/CedMuy7/SWpiaKteKZnmp1nAKN42bxpRZt/TWo9Y/AgAA//+o",
-	"9lI33pEAAA==",
+	"H4sIAAAAAAAC/+y9a3Mcx5U2+FdqeveDvVG4UCI1NiLeDxQpjfWubMkk5ZlYUzFR6C4QNWpUw93VIDkM",
+	"RhCAaFIvZMJk6N1xaKyrJ+z54ohmE000bo2/kPUX9pdsnHMyszKzsqqrGyBuXV8kAqiqzDyZee7nOQ8q",
+	"1cbSciP0w6hVmXtQWfaa3pIf+U3+050g9KKgEX4YLAUR/Krmt6rNYBl+V5mrsD+zDtuNV1mf7bMO24u/",
+	"ZAdswHpO/IT141W2xwYO67IB22bd+BHrxF+wDuux/XgjfuywAXsZ/y/WY7vxGhuw7rTD/hSvskM2wA9t",
+	"x+usF6/Fq/Gmw7bYHvxvm3XYYfyIDeJVeMOJVx12yDrsFeuzA9aJf8/6rMd2pm+Ht0P2A+vFj1gX/gsf",
+	"GLA99pr12EFqRvFa/KXD9jOWgq8exuvxaryGfzTXZy7jdlhxKwGQ53dtv3m/4lZCb8mvzFXqSES30qou",
+	"+kseUXPBa9ejytwVt7LQaC55UWWuEoTR229V3MqSdy9Yai9V5q7MupWlIKQfZt1KdH/Zp+f8O36z8vCh",
+	"q+zURwsLLd+2VV/D+mhFu0iMfvyE9XBVXcsyEpIdwF9fxhtEJiQ/0uMLICYb4CYA8Z8A2VjnWLcxg5IN",
+	"WqSVlLM2UuZR76H4DJ55L4q86uKSH9po+AJ2nE4RTNGJ1/CfW3jSOg7rA1mJRj22pT4cb1bcynKzsew3",
+	"o8DHkaqLfvWzVnspPc7NX1ydeuvKOw6Shz7+mh+9V3ijnEX/3nRFrqUVNYPwTuWhW6k2wsgPo1v4+weW",
+	"vzd9L/JrVyPrX/0wCqL7H9Ry/ii+7Icw8d9WbvlhzW9W3Mq7Qa3yqWVGC0Hd/xXumuWbAQ71fzb9hcpc",
+	"5f+YSXjRDN+RmWQ7PqjBG63g3/FT6ha/c7mS3la30l6uN7yaX3v3/rBB2i2/iSfroVtZ8ZutoBG+32ws",
+	"WQ9AL34ETCLedOJVvAQHbECswRXXgA7xJh7rXTaA+4SXZgc4j36AWJf12RZ8Y9q6Bj6bW40TmEu8ynbj",
+	"R3DP7bN56Faa/u/aQdOvwc4HtYp2KJTjo+y6fiT5/rnJ2dcJrh7Q5DA15v/Nr0ZADu00pCnyF1zJrmDf",
+	"8QYsE+jbYwdAlfhz+jNRwaBAvOnSzQXGDhICfkvfiFcTUcIGbB+o49/zlpbrML0rV2b9n12enZ3y3/r5",
+	"/NTlS7XLU94/Xnpn6vLld965cuXy5dnZ2Vli5x/64Z1osTJ3aXbWclO8dpUWklrX98BN4nW2i5xxACLs",
+	"kA007hOvp/jLvN+KPm4GVfXqhe2leTpZ1XqjlckI8tlErd1EUXPTrzbCWkt5Rjm5flhrXbXx0B9RxpD4",
+	"gAuAvHIfxMIABdMBCpIDfqydeD1+Ej9HmbQvpA9wVjq1OzYm6N+L/LA1bIJLQXgz8petxGlFXjOHdvjn",
+	"VgZ1WpEXtVsqj7xZXfRr7boPF+NGOwzhQbdyDTfAyjMjZKofDGWO8rmHbuVuEIZ+892gNvy1eXzIvM/y",
+	"Y3IJykLlfqZ330LvhLhDLzSdeUlq46z8Fbe54+J5Z1t4LoifgYLSUa8E66UvwAjEGHbmA7tQXM48I6Pv",
+	"oY2/KptCExVDFiTszciLcH5evf7RQmXut0OkLb1Veeg+MGjZ9MLPYM1zDypB5C/h745C7cbdUCHbfKNR",
+	"970wn6IwBdtVNuiGj4nv2EjDf+E1m95969uwzvSLnyJVa0H0Xhg171sO63+AVgvSA9Tk1/F6/IgdkEpM",
+	"R3WL9eM11kkdUy+L7f8ZFGc49w4olfusEz+KnwKrj9d0HbqjC6SPP7nlzHjLAT9brZk5cYxm5M1OS59q",
+	"1GiOoihV6wHI4eUxBMii11oc5ZbVg/Azv2Y/L43mHS8M/h1Z0vDzZzwN77ejamNJ02lvtqtVvwVUuu6H",
+	"AbLt972gnsGsl5v+yi+yFgRHy29FGSp1IirS4inymnesdtyfMvWZUawRq/Ww4jeDhcBOaRt3ojOjrlI5",
+	"Fq442AmNFckiqcZPgzK43O9hPG7ei6qLN/xWu26lE96dV0gBNH3hMnVAyzgkAsW/5wqEcR2jxlJQtR+2",
+	"amNpKYgi36Z8/sAG8WP4LsgiUBwdJD84DnDEp/A71jMn0J922AuH9pDuOPod9h3cNTL7es6CV2/5rgP2",
+	"On31MejyIAdfwkADtCHMxcGfD0jH2iB7nKZG/CK9uiaSspXD4/1mk5hE+uaGNf+ehSrf4KrQBWOsG0V4",
+	"sjM9m8kyazWKmllb/mNy/vNOP5DkEBnnHutpG0SGkTHRiuXoJTdXn8Ivbt36eApNMPAqrcerrm55xRts",
+	"n3g3GhPxU5hmvKptZB/NuCfCflEYvZUaLc6tCtxZ3KPkDbmMkaUkvyPqhUiOj/WqBjUrKzuIPweysP3k",
+	"xA7se9ZXRdyDiteOFhuoXlXeueRd/tmVhWH2F71B/ovKJy2/qfGXucpbs7PvTM1empp969alK3Ozl+dm",
+	"r/w/s/84h+8GteJ2HndRsa+4LQ5bvcs6DrnV8IKje+2PaMSAx7HHdpK9mKtco0lx05hodelhik1JAgzV",
+	"uK6KRx8KKtzwl9uRJ9SOvNebyZMPdRoWHBYf5qzTb1YDr/5xs7HcaHn1Ap+4ln7JVC5SXDix1LmlsIXM",
+	"tYNKlGJtdh10qO2TMHXxkrJX8LSTOC/5loFg3QO1Dq7xITJ5cNzsZbIXYMbovdvmVkuf9aZvh+w71uMv",
+	"dBKvaNdRbsEa6zk33r/29ttv/5z8n4lel3dC00a6P+81m1ZJ9UfWFR4Qq24geWeP3I0wtVd8FdvyotLB",
+	"BWMdONwAF7Omyr9ph6vEKHjiTW3Bq6QqA/O3E3HHUGfsIktb2dDjdF15upD3UZor9Ub0QU2XjHnv4eNp",
+	"LiqYw9BBf8V1bGkMDXme7OeHbuV3ba8eLARVryBFfq09D9LE9+rWQ/Od/aCjdGI9PAZruPcDEuQQYBEn",
+	"3yWZCvvdES/2nfj3pIwojkcQgVvo4U5+Z2iryuYnQnjIKm/Sg2iVVxfDoDoSE7qVemdMFw33dBYY8jf8",
+	"SavWHZJfVd0fRbFWPAYJn9Q4t5vIjmROGUL7qiJljuhs7QiugwzWxndO2f+qiyybdtlnh9o6Kq60GT9S",
+	"DMuKS/rFp/ZBrlklYSpaB6IJNWcK0yEpNx3it0koLm3YoTmxxbrxevyYHKpc11wThoXKV5UQRMdh32Iw",
+	"kPg0/otvwT48tc+jhT10y9LlHbCd2yGQAw/BLlxdvNF/QGZwyHpOstr3Vrx6GwmEt199KV5PwqxfiFHj",
+	"x9Ylirigsp9XZrM29LpfDVqZTvX4qWYQ2Cn6TNnlq8vLzcYKOXJ9uCYZPgFD1qQH/9Zkh1khQ32VGUM1",
+	"vQWbLvQ3rgUNcIt3Ham57Ga7BRz2DeshV+feVrJZSSHa5BcU/rfOXoKSwHaFmcRtTdKnNuNnJOxB6fnf",
+	"3Gid91o+52ykSVEMWtGEBvGaU203m34Y8QddJ35iLIOH4js4/z06DIYrMhloSNwsSwESyxRWIl6QHlye",
+	"Vd3Mx5WbU0TOkLbURvRHH6PCrJE0NyZDvvWhFLKu70jK2JvWjFreijAZZOy45kX+VBQs+clyFOectzJq",
+	"7PiYtAtD6AvHv3qqU3uaLDBDkr/v+7V5r/qZjRnFa2w73mBdYt52myYlQTOY0S+CVtRo3lfOWrHQA3gI",
+	"0mGH0L/TiAIUGjca7bCW62FCl806ijZYBTe1XuGtpKh3V6gYyKLWYF1sX3HP8AQLzXLraPfBHhe3hArk",
+	"ZT+i2nRGNSWR0mFaCdzLOGC9lBVQSMhlDvjRsh/yAJTJz1VbwW6T5GkThp1piyK2rjXaYZShpaUSqbqq",
+	"PZM5rJWDNpb9MDNqQn8clSMdMQoZJeaEHF+Zp6uQJ4PxDIntZp+KhE032vN1357GlYQHU9ZsIZUPNj9+",
+	"zA7EJqLGvCPV7LxsLqEU8lFRK7wetH4nf8zQDG8koVRLwA8GHWTqJV0KC+zgUT9gr1ivwPEdQelQbnZB",
+	"QVxTNOyhEp8/mhPMdSutaqPJ80+L3LaB3WSJH7sY95Au2D7rgrcd80658bKr+vaVGdz1gzuLkV+71Yis",
+	"ttkL5GriKHEvY/LRDumxs+TKuKS658RptYespaDn1DVnktAm46rd8FcC/26+hC/mXi/qGNfHuVqvO3ca",
+	"jUaj9g//8A//MJLfPOXfhiNU9+2htq8xTYicjbvxGt1ku/HmoJBUgl9WT9JZ8igPimhjJ+1LHkWxp1No",
+	"qPeLQa3mh1YCv5AU1J1+CilYh0jLmZxU1vazF1xxC+r5NLPRxCq9c8P3Wo0ww1faF5HMMVZlm2ZQK0x6",
+	"YuWQpRXeKfzSDXocXvSX64FfG7JXCeNlHc0plie7jrZTMLH7xReET4vXyLP3idjDEfa6vVzzouHkMF0F",
+	"O4a7Rdv1I5LC5hXW3cFDEidsl/R4nFTZhqE8m0e3iRRanr4lpF0fm+hV9ILh10SdZX5RiKIOX8rwNqkX",
+	"wcaluA8vyTWQd7rYjT5gHWUzih6Em6DEDKNUxoiU6NJn+zRuhq/6kA3EowO2D87lXTwja3gYyKV7pFRR",
+	"8LKF46ROV5tB5DcLpaqpj2anxrUEMbMU6SOzvAK8Ryiu6pRT4/NfFGFONzOya9gPSWLNcLssyaP4uD1f",
+	"D1qL+O9rXlj1KRd7BG/+LZtnL+1ENSzJQgGboqEMzXvLa50uuTn5VtJrlXiye2wvEUsDtlvJq5WycpUq",
+	"3Bk0sf0sXVnn2Nb0AdZP3UDt3hTTB/gro6luw/UoZYl09cT1GSoNjpqLuuLVg9onYRTUU0TIS9cchWbi",
+	"nVGIZrvyxuRlVDr/buukPbIiQDLe+MNpqwTVutfMc0K9YAORz2cr43GEssjlMMrYA/Jjp3NVw9Zdf2jW",
+	"tjajW/49KhDAVzMFlfjzaJfLG1vLLlJ9UXiNdJswJ7mAyWolzzj5HfTvYoFH3Wvn6iEH7mZIB1l7mpKm",
+	"JUWpRYNBK5gP6kF0X81q/7gZrBAjQXFYtYi6IRUoxu4qFNbGHMoEjK06jjq+QVILcZauP56mrHDrKlxv",
+	"beoy8U7Z4kJaNdRYNr1qVNhXBhnhffYKf9tFRyaqyVn5DwbXWcoIh/wQr2PSSsdhu/qwuC0v8fw/F66q",
+	"PtvmaSTxhn1oexTA5vkfRXlXCh8L6hn4xmic8AypM/xs0OqPqqI0FSt+BGdUsVQ9MdUkX6/VXgZn1Fgi",
+	"ZRwWrlk+47piLEV7KXUptTAlhW8I+0z28+icM3VN3wxP1Pd1iF2XmtPc7fD/cq5Wo2DFd6ZSf9c8/EmW",
+	"MbxzTcQQnCkKy/ZkZlq8oWUCxBtu/oeBgPDJW35zCZA26JvmG/APiuChzXMApXrkeBcJZLgKMEPF1Cpu",
+	"Jfmm1fyUVrWNu6t+DVIOZGwrO/CcY3mNo3rpjool75708BR67ZfihYJZQPLFXx3holNkrfBQ/0yPD7vu",
+	"oRq2qyi0GHqpdb/QUW+15u3SgpP901aPUruu+TNm3SIIQ+mAKyo1aSffEF+GdpBsmUVG/koeXTVqieSG",
+	"kSjyz/JIWuyG1dTo0w77SmRlpibWdfDngch+0llDz+EuIDQueZUHbPxqvO46eK724ufsIPGfUhyL1Lt4",
+	"nT+MaEe47mq93QpW/F8KckfNtj8kXQPrUzJgdr41i0mUmj0K58df0ImnUDtgRIh8DVq9bl7Fj6cd9hzy",
+	"Wh1dENNad/DNLgEVOTzli8pbYNA9NkDcqi2gnHs75AWKTyj3Qsl8Tg9L4oZT3+IFm3ZEFJcWBsqxNicR",
+	"LQbvYdchDAattLEPjwNZHlHNIGV4r8r8tr4tP9bjjs83rtImICAFn7+3HDRHG2K4fJInjZfyBAsjroLe",
+	"GI1YR9ezRWx5uPWnwpEUW5HQPj864izHV89tgpSv2Q47ki8/1T0+lviiyX9OPedS5Fnd8KuNZu0o9QxO",
+	"vKoKgw7bp+T9jpa+golm8VPFEn9z4Cpj5pDlhcTyqtjzw3eKsj2SFjp+aE/VmDPCeMcd4UvU33Rm2pBS",
+	"cLfSHntAJacuPyNOCyvK46EMLbd5GGfw68Ed7pnMhIoAfC3AKdyLvyRDVABN0Z0GWwq1HTPkTI5cvGMv",
+	"SVLTZTGLrNJZmTSrum+HmSBG2LKqpL0UmgOV6NjmUXGTK5A66bkF/nJ+yWSGEbdd920z/jE9rRRQyW6G",
+	"epTgPsXP4i+4PmZNh3Mgm4Esb+LIlLkygHIhUIPgM13Q3Q5gm1mPWLgG42m6HbBIvy+EwXqy6QSVZwk8",
+	"OejC51CYUAAFxUvwJcvmUH48T1Ynr//Apqrlx1tNCyUzIKYCUOwYaKgJtCfQmh9xQJzcAhvAYf0MjbSI",
+	"1FoKQunpuMa9QC1r5nOf8joyUWQNX4xI6bfs2hC8T103g1pP24S+0kFfOXKL5ZAOyEyaUsLqXbqQPMMr",
+	"3lCvYVH9SsAmmLz3RJya8pUjRInVwoEUvbMOhpXHNJsAWNFaboQt34rzkw82q4P9JplEiL3ykjvKrNm5",
+	"z/ScaKGSV263Z2ffrhJgbrwJxpbgQ8SwwDQkM1nBbskaZFPWTSI/i9eUCwkjwKF6ibe3ww5wZD+dLN3M",
+	"ykRNpfBpy0YcBqDPS5kttSPPLZV8BaHMTBnm++aTsO7hveVGM3qfHz3F4VOptlZSaeTsv5LcSApVvYLI",
+	"ONsWfhbuTKV379Vb9yqfKjvFf59O9PW9erSY7YPm3oVBvKlxU84OMdaqUz0BH867IfR/WdGvAUTKKTc+",
+	"GxpaSEAZaVgbnYOlsekcf846bIfQ6oAPwzl+JCICGsHD2r/xfR5KcprPDR/+m+HneUI+jwF7qY/bS9O7",
+	"1rx/o50BG7hAAG1WNbnZuFscuoNPuXGXa4sWHoz4SX4ta7jIUHGzgAr5csQL6nflevjks/f6hp+14/9G",
+	"tnTe1RoI+rOObQeUnecfy7px/M9Z+y+JmYnS5nB7e0CMSTuA6YOQDUNWD0I/v0ZUHSc5864QF9du/iaN",
+	"OruN2OQD1DkRzMmed9EqFN5SYvtpxYxkxDrWDuwVwgSbHhUU7BiKApHKBXHEgnAliLJyobBQgr2CHVB9",
+	"F1ZjwKHS/8TR+wzd3NusI8sr0ugWx5FmiCs4ef8fKDy10WYqXxptrsWC88lGqnA6x1hemgqRF4uHp+ZV",
+	"IG3YOHNa2vDHflgjhOar1aq/TCHa634VDr09QMsPCIfCL1zxTmu3FL3bVjTazqSA/4wHLOi2rg3OVpYx",
+	"G2XSLl49k3elCNvJwg7L0oU51JZlC/+TAj1Dal3bNY6ZOgSc61168BjyDiXSVxGHWL0RCW/h79oeouUX",
+	"eOXX4lG4qn5zJaj6RRD46HjdVF6wgpNDXT2c9btes6YkyNePEZo8r5yAgMuD8M4RkMszovAqrRSCu+KY",
+	"FOYwyYFJn8vn8TO2JQy3PbLThlWq05k5epiCD3fKgQlxqAvEzxX6FPjqr5UrkjJrwfR+zva1b+YG+5eC",
+	"ut+KGlb18O+UE2RPh8rKfRwRnkBP2yqesDeGwgL+8tpYr4ymNwi//LWceErNrwcrftObr9sIn3a8S+Sw",
+	"TrGgmFa/ODxUWmv71zNKV8gqgEuzRTDE+lyOKxotz+EoiZHypXRmZB7xjyWbUTmO+nYm1HTFtSjMUlUq",
+	"HL0MRO7TSSU0mvuRr3Im88NMRq5gOlNO4tyLN7gbUEQP1uINePaD8ONm407Tb7Ug3TCVAIOJOOCnj9e0",
+	"j8GrN9vzBM1sf5POOduTs6MkS9J4nSluWAEHj58k6HGYLmm8JGrnrC8Bc95Fw/mA9ZUXXZl3RI5PaiRG",
+	"yAoiFiEKUA/YQEuiTDT0hDwVtyIXrKvuuaV9BfClvhegUhmQUkOgA4+IxvKb0TFDx5IZQU17tt0OarbH",
+	"lr1mdD/juBM9DqRCklCDsFewGnKd4jkUPFWLNdutqLHkN3EfibNldFsYEfdtGQs2RxVtJ2l+E256EN45",
+	"CmRhVgWRiXWmYqTZwfVHglmzgfZfGt85pn4aeSVYJ8BXXnMmp6NObGFY4BWP+SZHkBigwsz0Y5fK/Dbx",
+	"3g64VVoICpESzdth5DdFovkG4K/iAOvE2rvc7djjLryeOSMBHRGvY6S7r+/UgO0bjNZckMpme0SAipuy",
+	"8RKmKCc8jEFGfnOplQsH3UH7vsdjpObEhoeYRqgvp7MpuJCYnKJ0KGxwmAaicP5bGWv8i75Fw9EFU+x+",
+	"ZG715sk9TEdOOehgQjYCpj2cR866y0gBOUXjNhWStwWxiiUFEHsUW6GG1T54r+JWPvzwWsWt/M+b16zX",
+	"UMN1L5oCOFB612YC7WP6OOnNJt60mTV+RG3myNj0o+TEjpPzcNQkVsGm9IVqSWvJtGxXqqm15UhvLm7l",
+	"WuJFHYbRwnZkrnhW+vn3GE5ZxUybNYLUSTIoDtlAwXeBTFFE7tYSQyXeivVssX1AKvuKgPtSuVQo7dZ4",
+	"ltcuL3TnxQaUOCV78cCcvuSA0kYnGLBH7/gCgOfy9BU3AY/jwJ1vyV/B9UEHwKXE6RHUWpjeQLWN/JXL",
+	"6JmkZ2en33ErdxshPfl2uheLPgWrbwFxoeJNe+WKTmM9P3eAJVsFymDNRRfHT6ShOTyumQTGk+8QOdFM",
+	"wuqznt3kthTbGPS3ZlxhOow90yte5UTsp+cMGHsgC8n0hFMuy18scy5aVqwdj2LEtN5BV5Qn6U2gVN18",
+	"oF0q1rNSUDueY2yuHesyGLofA7bFM5Hsu5GBqrtqrIntFyO7vGcFl2iZXWHE3VQGU0Jhff+TaaXumZ2R",
+	"E/DWSk7zATQ3dmXXKxUebov27okEeCQ9TgWUq+TiZY6GcKmi2CUjHANg3zHgRvq1YMya+KG+jCMAJI5R",
+	"A28veOF/HQ2+T88oG9oY/DFZC4C/AI65jXQ+DceSt+N5J6iG8aY4In1eJfI8cTXsCzwJOtGaOv7W7Fvv",
+	"XJq99PPZt2dRWyvQvL3uRX6r4IyMod3E3h6oCL1dMXc9dfxIEzV2VRBSzt+2fZG/tAx/vjZ2XXfXAXca",
+	"YsOgwT8sLn7yJdnHU16tl1Pn0fJYwggqTTsphJ5TtUMjmVtSrIViKgvqyNjO7Jt4nfvpecUpx9WWqcqo",
+	"2kCbY6o45trhllAi9nhWXx8KHhB+gdSeAU9vHiRQ7FzAHW/XRRjya6Efxl+Cp+7P8DDbhb8b+QJzlesU",
+	"6bo/emfGMwQmrR6Ds9GUsLBCQCd+5I59at5JEc5Fzwu21aAmE1ejYq99JB8/hoy/ZY7PVWhkAealdewr",
+	"kg2Ezx5HIlGj3az6t5RsoDHbVPOTfSjQzfJ6sfByeiMRbLoIAy0WZ+dLTfIb7zbeC1f8eqMolW4pLxTv",
+	"+KcDx43Q9E/LcJJe8FQm5UpBn3j6zg2vKEmpHkM7lvFRxmlaZrQEnbBmZWbZ5BtpUnaUXmGps3CkHmFW",
+	"CTA6Tz+Jxl9H5abHmUd9hDZhUTY7H1WXPnvK8zgdq/KYW95YH6l6RLa3pWu2hhixc1W2kuZgiuQm26bf",
+	"i5paqY5bBhLyNtV1udit0fUStRJpwau3/JHSy6GHqThcTgJxsxavs0P6HV+GqA5/kuOH1CB6rOnobB8q",
+	"w1X3bC+7FWpPT3RfFQGWvmN+ASUMBebJ6gAJssrVdAOziARDVqnOTaUpcy5Rwa34muDxRztKKEpH6dmc",
+	"RW0udeJNgcLHa3Sw99LnHFRBnLd90XwWv805jEScSlrTZraFHkI1jSmnBGufbfH4OSaR9F0RCJX6Jwfb",
+	"PUAB2xcRKO2oqilMjbAVNdtVrpwpBuQvvbC94FWjdtPPSaovlkuYMbYV616i1ietdwl6MNWRF36NKKc5",
+	"87vFfS2WGf534jlJaSlZkUewxSm8pPmdOxDHgeu2x1vo8Iikqmk8czgiR3LcVMMVrxntIWQQ3A4t+5pk",
+	"HYorGq8lW3wBkRjGBaQdF84bPXte4bLbtFPUUnh7Eu4D6U88q+gVY3g1juiZOGm8DBvSH1Hm2GA0jqqw",
+	"j5FeovgC8ipVRmm2kOcNkDdwFPyNUV0Ft3T/yBDt5Ct7U3pS/x4J1WXaYT+INHiCI8hRxURYBMUEmPG2",
+	"hp59uox5DT2hjT1XNGW+FH1PhWTnGZbkMIBLDU0mhYotlBlQfRRc8749t8qYk66ljtQKH3wghMUpO/T3",
+	"KYkkoSkbjKES5ipXZ6MbjZqyVcBgbdXbdzJrT3XbE6J3/wqft2aotvxquxlE928Cj+BuHN9r+k1oMyeh",
+	"QZBw+OvkI4tRtFx5+BCrhhca6Xlf/fgDYd3E65I4ezJXT9euCLqvn5l8BYrW7fB2yL5FlBpYL08//hxT",
+	"WXe5NwxHBe1rL34mwKss46csYhz/J2ZMwUVsf3Ku6cg4HNuglyhJZGHsss5PUaezDZm9uOMamh/1IMKt",
+	"J6e280sv9O74UFsF5FFY41zl0vSsiBZ4y0FlrvL29Oz0JUxMjhbxNMx47VqAwsFeNprF0gjbwW6nPtNA",
+	"gkTTiH1MquMJcg57Ha9TaAnuVgfDcX0ML0nfSxYW7RzYaoiRnpAQOd0XSldtGpTzNWnbws9dw0p3WC9+",
+	"Tkr6Go8LCvuuO41nEjwAtAeozHfJXUFcs0vgR19AhiAxU8JV2o3XHUhowLSiHYTPBaWq54i+RjKXUObT",
+	"9VQcNnyVryUZGu7FIfciA3Hj1WT2/Ki/5loNugoO+aD1IPyM0vHp88tNf+UXXmuxyAByFfvygEPdAOf6",
+	"O8YnFAqg8fQXKt6iBIx4gx9yBUlY3iD86+1QiDH+kGLZc0mqDseT/tb4Av8HinMHSK+XKfTkeSXtt699",
+	"hm4VmFBS86z8kx9dhZvxYeMO5fF7S36E3Z5/+6ASwNX4XZtsZh7QVXJnE7WI4KWLwS+p2br2J5NZzCx7",
+	"dwCIP2iEHwZLQVQZ6ZWPFhZaPkEpNDlcGfKCt2ZnK5igFUY8ycaDAidKD575N57SnKymkJKL/OW9MGre",
+	"t2A5PrR62+TuKjwCTdIBoV6jIo5WiSNiFnDgp2GAyyOuIW/qOqCbbbaIcknRClIOP1dRixRGpLTM4dWE",
+	"h6zDBVMP+c4Gn/6lE5z+d1nheUzVWaUrrmOO80WAzAIHwSO8o7skbPkK3j7hDZBJHvGagBaX2loCrmdg",
+	"kfbjTd1HgalgD93KFTpA2ekQRB3YRIG9maDfdURqOsk4TRJBISkP2Slt08TMUZUH5ffK7Kyk8l78DOtj",
+	"drQKMpdg9FFhdd6anZ3WND1kUKqO99tP4aK32ktLXvM+rOX/zZK8dnGLX4cUwdbM0v1sReG7XM0lx+kt",
+	"gnTyXtiVXpTEXwl9k1Jpaec6itcieVE2c5bKovAHo6gUwpLmhsVKVBn4e9bPlAjgYOK5tYZEOAl27Q4V",
+	"PCMLmhORAPNBrRDr/0HBws04Llmnw+UVGxyJra+67yVemYOKyOcyGWy9FBhnQWBcfIY7jDV2edQpsx4g",
+	"4cChfxfLNRste9KeZmNmltFxs93YL64p22OrOi+kKM67PDccuyu+26jdH+kkWqDuMdajNi4ewlaupV86",
+	"WjRgtDoAw2mPaEmtDBs6iSALeKF4I518cMCRQdTorAgdy3aEdmDxok5niQNmepqL0Go+qB2Xr37kiuNi",
+	"iXFJU25Yoq0Z9pC30w20jyPtxe4HF3lweeCC5mlO+7gtfPor1WA+4HnOrzKLH6dTRuvDIyoGQ/UBm3Cx",
+	"nWsKfB+iC+LAyLJkA3TBK4IiSd7pKAHZeJ17dIoWeGsJztqIwkootYZSa3jDWkNKmA+/x4qi8ABrvB/O",
+	"eFHkVRfBOdzKtt1emBAtPJ9Fxke7uqEmq7XVSlZR+6fHT6zsxmFfKR5YtNf/KCrEs7r7buK1tLqjRSbO",
+	"tlEMM22z4d4NalcVmth9e+AeTywsieoxll9PQkGeiN9wZDyeaUdmEtN9VLcUdJJ+RtsC5UR0SITYlpeE",
+	"aZPVjBQ7OyEvpTwSo5uqXSMcWcqH0g15ZDfk5dnLJ7kDVtVLULlr/l6AX7Ad0qHYYGJkcva9z5TJbpa5",
+	"DrEO0TCEqhMFzr+T4QKLn1lkp56SMVyQJkHNpFuJWm9H+GUUZllH8Ho1nzED1C19SHhKLMwphbwG5yB+",
+	"bvOyfrJcb3g1TUhfLBn9aZ7HZAnw9QA0bAZk5FTNi7w8p8lCQIWyUqDOB6GHE8/HysL37IbkyZmBqsi1",
+	"sKQX5nnalgf2tcJzSkFbCtqJE7SXL50kzb9Hc0BmxxlGGckDCMg/FfnvW6kUW64UX7py8kfFnAlvs5ha",
+	"yYRoMKbYz9rQkTwLMw+SHz6oPSQSQsaulZjCFf6lTWlI3BzjuhLGU4deiES1p2yPkkuUelH60ZjqIOmE",
+	"Ltr4KRPBDCylZMNYTBefXeXZrZQEpVSQoMywqUfXkaynrR7p31b3fuwhtI+cnCJ2NnQbTGjosD2VxZd6",
+	"TanXlA6EiyZ+/6JcdG4lFxO/bobr/pQc6dcbd8OzYKhPoCRqVCM/mmpFTd9b0q/wcDeAzZOtJY6zgeVQ",
+	"lgKpFEilQLqoHu1d7pxV2p+NbxMSqMCMTO5ZbtuElpRRmRJKSXpSXdG8WnF92mF/Ffm8ojAdf5CF6bCS",
+	"16ynRikxbo15eFSUA5UtkIe1ia9x1DlwYryitOhdWQZsyYCjHj5Xab2UozQ5bupREvtGzPMyPNX09qm7",
+	"qtVttnGbv6pgIwIru3MKcvOP6rnlzKkvWtLwnA2JjHKQxeMp/3+X4vql8CyFpyY8O5YzpojOeGNCROd3",
+	"EtOpo5TRd03h0bPISdGQMicn63u1d0Ve6zZHqafvGS0ZkrrrLoK+iE8emC1T8EdkCgqWq9FAA6GXJAjG",
+	"I3N+uLHg9mR9a+LVdb5magpz8TKvLkzFZk3bqJHzodSTUWZDleLvwoi/ibUR/5SglMWbxv3Ok0w2wZeA",
+	"6WbEB/+WwqXlCAIKctpLANPVGjzkpRZ3hocA0/7NoFX1muDeJPjfi2fbvbniDqKY7WJl7u1zyadKQVEK",
+	"igsoKBzz7HP7O16b0MBXGuR75NDXDwqs5vMEqdn4sCEREL5yHIlApSOlNHhj0qAUAKUAKAXAJIEgjCIC",
+	"hgWS0iDLdMqBGEjA+CkXEuuiigH+sJstAVI7pmIx68Mh3XknYpF5yOlGFRD44DaBtyOYtIAS7YAdg27U",
+	"bWImGkQ0uuf2BNi461C+5rCKPr1liPgYpTmO34ZkILIV43XDDDNxDHdsiYs3vRX/AsvPMwaBcSQcixGB",
+	"IMZoHX8sqAwPTzkoOJqlu6orq6WyUyo7pVv03AM0GL2rRtJp7L7RmWVqi5GdPGPtO9ZP6Q6ZFaSO0Er0",
+	"Rs0DaoVtL78gyAeLL5a0CgkgIDt8WTp7xZs26uRkqcLHNvFB7F9Dd50uv97Oy9LNDNQUi6rG+smB4gu1",
+	"KSu8NUlp779ZaB9DcywLH0qheLFSZSxsyZ4sc3n25yc457/lt36cEH+EaeTa5VNh6e1zGP1lL6ou2vN5",
+	"UHjuGvikfdbLQ2Is6p5+rxZEhMdYmtalaf1GTOuU7CEUf9E8KumCoQtG4g59hwBOFfT2bY4LIjMJZAf2",
+	"l/SY0k0kr3RXap/6B4Vpcqg0DT9gA2wXyNupifrbXjGP1jmBSDTVKp7/N4AMQNgYAOwiXcCkNHeO9lOt",
+	"+p+dgmpmYFj21H7xvElovCrnqbBUbPqhAJqBRBno5W7xOm/BhPR8qSKGs/0LosddfBGeJ1LtXKioMF/w",
+	"/dq8V/0s2wqH+kl5IIlIQAvs5ZKXE5VVSfJuUHtfDHrqMnxem8zYQ8hv2JAJv02SlJWAfKbGheR1LlF7",
+	"1StZaINA1vBOZYQ53vBXAv/uDXrPNs+v9c6H+ZnYRvfErGlWRUM7baZmB7OL6gz4tsg9SQm1gXLh9hRj",
+	"9SRFkjJzHmKTXa5k4VnWPEu3QOkWmFxfuSoud6nVzJpofDZiCvFi0IoazZxONC+oN9xw8CCSKaJ0hda8",
+	"qzix0U1O3dteJB9zdURwrdU6Ff71sVkadezmroSdxA55hb+i/s5dSAkAUuyjIcVLiA5EM0r4P2YJIIL5",
+	"Fuuk0ps7RZLZsgEfoFzHAvdg737zblD7Bad96RAfowkOJ55oSDoGzrA4g2VVTSkSS5F4oapq1Ntd3E4N",
+	"/TuNKPCEMzJPHiZihPqWW4SREMTwc7zJtiA7zpQ48cYbkTg2efMrZXGlwBlV4ChH40ajHY5Vxyn0DuiE",
+	"W0qcUuKUEuec+0uLSgC7KZYJZD+kSbjK6TkkavyIyEWkkJwma1oyMYnwBTTbijqsU/O0JBeKUsI5CpBE",
+	"POA+xwFGdG6H7DtzNERwQOuO53rj9vFO3fm+vwOxFgUXwTXWfiCAzDkGrsP6JkiLBh4xLbuP97Db/Zps",
+	"tNPL6APn8MaokP3Em3iLbi0KKm6Sp4Wd1PlsBJI/3F0IkTyGh9kBV03WyC7VVsR6zpT6NdxJ7XsO5a7T",
+	"lmB8fVUkm8GCEJgZVvk3HkUcGHIH1vNKJm89ERGtA3oXj8dOAnRxCF/A+NYW9h+35XZhlNO/5TeXWmW0",
+	"vMAIiiJBRDvhPOu0ImPHKM/nIAnbiddKXabUZS62LnOy+WRWKXrAemlJqkh66uOh3UtxaHRTtR+v8rys",
+	"Q4ruT0yCWmauh+ZVHsF1rrDSGa9a9Zej7AD3V3wl1LGHeyoGGsodalqixaaqkGm7yjsWGm7sv2jPG+V0",
+	"z0Q+TnYyesG0uKu4yosq7E9T4uobqMY/pIQqBWwpYEsBezy0jdeGMdUkf1JILeWoHxYU0RMkW5VorSFV",
+	"h0jOaqMdRn5zPNEplv2EC06wvykNEe5Lpntj2jG6yO9oXhPpUhA+lXiVTHnj471sQY1bhkmRPPbN09Ax",
+	"2pjq/08kKI3oC2VEvxh6WlJaVynrS1lfyvozIevd4lK+NLRVZWAY2xuiDjR9LJEZWxvYxZEEaM3IhnTe",
+	"WTYhYFJy/AbOvTSP37h5rGx0KTZLsVmKzdJEPgcZ2wrHKmYm/67t1YMFvsf5xVM5+QJZ4WfRvlcJqMsG",
+	"vxgDVi99WrRmBcwPqWlsL34sYsz2GPXtcEivWRDp8R9gp+AwObKw9r0Vr95Gkrg6enZPhZQTBzBehV9y",
+	"jQQPyJb618fUdQIy1ZMC5WSEaSWrYQcmnvWgMrRLQfpX+F1zgmpi+4CIJUDsRLvAgYYYJ6rwZDdBex2c",
+	"JTT/azw998sy9qwy9tT1GrLAX2vPo3/B40OYNxI4CCbMaLgEWjJLxa0sefc+9MM7wDAuzc7OphsM6t2b",
+	"9PmedhcnbTaiuYU1l0C9ACkgoxKmplTdStXtGOf2YypDzhCkGcJZaV/UnxCl7KtE3cmDTRuuzlh0t2aj",
+	"Xodq7ZkHK1Sh9DBfgcO29qL2PcVlMqeHXR9AgdiWWoLe6X/aYX9HXQPdUX08BT1LQoAGXUdJmnhmuNah",
+	"4eT24ZTgfHhrK9bHTcl2jHBinLgukuI7vBRQIxEcI9TZpDdpR0W8Y4PU9tiuv6xO15fCdz93MbLTcBBG",
+	"b78FikEQBkvtpcrcJSnkgzDy7/jNSQO1s9Wt78rUXQHGMuxsSjWsU2obpbZx0ZovK6d/YrtHShFapFLd",
+	"Iq5b1UbTbw2tgld0pPwqeKgD6MWPFarozpn4cbqYI6tLyk2aWxlRGKNKHGk3enH4QJa2lI1USolRluqd",
+	"e+kwjG1nN0UZsRrv95zcohovz02OiwKTrIf/3zTLwTqirG4gAb0OCK57oMJ9HQrXOVpqWAagf/hZqh+K",
+	"mBaEYJ7Z3cfIOUvnsUXkWMBQ6f1h/ly3Um0Gkd/kJM6ftPooHHYUZHMPEttw1mYbag5j9RPiC2m3sU02",
+	"Hq8b+fiFtXqhTZfyWc8GcI3rSb3Cee3OAT7TT7oOqAwhXpd8UmcAeJcBB/ap4Dr7mMIKSvB+vM72Sy2g",
+	"1AJKLSB9ZwoYhpEXtXMMw6QRW5/3LUvWletU5ho7JlMgOLrA4SUx3mdb0n/1OT0gUGIy7USaamknFl8G",
+	"J1lWHGUXuVSf7RTZ1tJQLHGaT6n145o8q5ypqLMavQ/knyTi+SnytE+Wa17knyG21hLzGPvrkttc0BDO",
+	"D8OPSS64fslBSyW7VLLPOQ6j0eyjV1QYpTVvRPL/15pfDVpD81/15gFG1uVPMMmTCEkPWNJa6S8/HbPb",
+	"wHUxy1MXU7VkJmN/X67mwoqq74203CMg559KCqMx/+L4+WxQiqlSTJW+IANBX8/NLgoEFECiH7Y8yfEQ",
+	"fY9xlj7bp8PxEhOSlCS8Q5syue547Wix0fyEs0mKBP1g/NYJas5Ujl1lhpOcobUioPy+4hnr26JdlcZa",
+	"CJ7QqKmgDX7K9gi+X7YjiDduhwnIkcVqVFrVuEb9hzwo8hm1VcBeZmSNX0Ruilriac+xLdmIlMzw+UAv",
+	"A60VWIK2pBBBycEdZMwbWhdgS7cuTALqQ9SFj4EL3bN2IoBDpOBecKnBySPzH/dTs++lep6JhWa3N7jB",
+	"b0ZKJUq5G4odX+cn8aqxbT9N6s2sBPqJ6EF3QOfhp6DC+fc8aBhUmatcuTLr/+zy7OyU/9bP56cuX6pd",
+	"nvL+8dI7U5cvv/POlSuXL8/Ozs7a0zqDfP1NjwRaCjssRNjnzMAiKTeH93RClpg6cklWK222ktUq7Frt",
+	"Jme1WNKpXhlDyRt1xa4+951EkHTip2p8XVlz1ux5xNdXF3BkrdW1P5kc9Zll704Q4qX4MFgKospIr3y0",
+	"sNDyo8qJJUrRZR0nU4qTn9SAHF6fSlRHL4p2rqXS10k/3cvMuy6dRqU2fr60ca1GJ1uA2TV014EX2Evt",
+	"9sjurFjOyrrZGtIOv19a4unEwJQpEm8E3pWh88/495YbzSgva3gD4LZR6KbFlcO6zrWbvxGr/pcPb/4L",
+	"QLPjziD+9mtb502Ozc1PzIAzIo7Z7TrxE1Iy+056vi4q4wPZmPeQddgrrJhARd+O8P0erjFPnXsj6tEb",
+	"00JOUkGwjcWLfYp+kI7Y+/TSqL6ylbA23Vj2w3tLdRq2NdVYWAiqfq1RbUPW2nRruel7tdai70dL9Wn8",
+	"v87oZG3SfBB6uI7UZlUi/140U22tjPpmmjH+FzK6PWLcdHO2sSDR4WX4XQn8g4cfTPcp4jevkM0P0J7v",
+	"Uwn+HhuUOdyljnDudQQ3Uzvomx6SlL4wMahjKr/oGLJdld+RH9b8JgSY6kErKpLYpYF3WMzueJV1401h",
+	"qmBtT7xqGDDYqEtzZwwgK9PuOGm932jewmkWErZiRWMLLfmBEwn3XDDDeXSTOcd7A48gOo9atMr9s4ek",
+	"8O/Fn8tI53op2ErBNlHG7+Tm+K1yFrKb6QAWG697zbNl3zjma5fqYDM8fNrAGeatzWzVV2edLCBx/ZW3",
+	"dE56YsEPa6wDXPU167mEpQXNfdUv9qYdDMIB1Mcaj2lpHnU8IV3D6IjX+SnqcpCwfryWax9feKldWrOl",
+	"NVsK/VLoHxsq1aTbqEeW4sANx8YF1SuXZePKJLLKZf12umMmBZOLKQEgOqnZqATlQUUOcxFeqO0tkyQD",
+	"BcYTJS87QOBZ8Fn383pvJiP0U604px32g9oWS+nrsStc5gpwZ/J6RiDFnvnw0bIfgi5wEVWAN5ynCaTL",
+	"kqTfWY+ausWnUvX7o7UbrXZZEpweOp3GtGkdvfiL+HnCFyUoLrxa5muW8tImL0+4KWVWSsdr1rPdw4no",
+	"c6GLLquchIw+q5jPFujAAzNN8q+Hiqojiessj7TgzaVUO06p9gMqZVsKdJghG1j/FIRaaeuVsuvNya4h",
+	"CbuaKjSJYqUATxgqUebmvai6iIZho5Xl2OUHRzRKWtWSOnl/BEoLoowxtmUdlxoqzjlQdlbzjYIj8fFH",
+	"RautwMv7NW+HQEBX6kxEz7xB4pFF+SUypTTHspYLJcxIukt6PvEgXnXxmUPpdO8ImsDhSRnPfeqGwi1Y",
+	"h+4o8sdHVOvucGytPh4Cg5p97d6q7Zm7ibEMjTnIUOXNKPHNOdG3osuJg5nQL5OAt4bw1UU2R2jS4AWP",
+	"1yD/i7MVKszn1Rrm/BRC8B6WyTVwLr912Wb4vgtHLsfyPQ8NIbyosRRU6b4seO16VJlb8Oot3825P6Kg",
+	"lDtLUrSUsqWv7G7iPZ5vNOq+h90kJD1bOWhjVPxZrELUrai1s4VLPmEm8IIfAsrYbyt0syufpj3eOtpY",
+	"Y7ni8vkVABkz35VrP+2+Fsg7b/itdp0PbZYt0O1nfXOzd3iYaTteh9tFV8YoH0WcfeOITJ2dxPbvBKdw",
+	"CBc/XnOt6GKyTxAKpH3ZQJdqv5W47kFxXbLEnzk3sWl+SDLlxzBtgTQV2OSmV41aM0v3cwxebDlFwnAX",
+	"yOM6aPb2siqHvsQLJeVcFwGcdiVNcpziltpH1p8iAQxnGzC+4F8urhB/OuABPMUPjTy+Hz+2mtKQX3xN",
+	"rPvkJeVFSr0Sx2f0/Ktd/UjB6SnN7JJ3ngTvTHGzzPpHk0eG/t0cg26kUB8xtL34mbR0zCvBTTSwU/oQ",
+	"skQ++pT1cjrQzd0O41XD0mP7ztXl5WZjxa9RRxksPAFDTPla14EPkRknQiUONpkkqwc7JmLGRCZER3pF",
+	"or1Nz8FNBHXsKYmqraT00Fi0KA//AQBT4R5anoDP9eJH8XMxRJ9tOwQrmdmkx5X+GoUCSmGvttIOVaFv",
+	"y7uOdhhSa0cOZLO/rjV9L/KFbDnHRtgoFo5hRWQZHydrQCSCycI2vzYvmnJ04+clBFrp7y274Z3U3FTx",
+	"tscG8lyYwlBEOW1X9eKrLP+RrDqhX0owmurKA/HPD2oPCxt3GviJzYTLRTspar5ZDbMhglOPcCZrG1ty",
+	"Kp8491HOUQReKd9K+Xbe5FuKS01o/moKP2EkKTBTrTdafna26n/ofJqIgIRL/M6mZP7JtQYgOUV+7ada",
+	"JughAn0+Z/uUPS+asckmuEh9ohV8C2kpxAcORPf/J7f85hL4tvzaTykyqQ/PcRT0z6rT5rGhA7qybBuM",
+	"QS191Rj9ACpU4i/JDkWCqAlGUh7KwGpS5ZqNXoFcQgYt8aigK8y4aFB/yA4c2clA5gGL2CR+WSmh4YFC",
+	"XUajDZ22TWHnJ0LCHocBvOD7NWiWW8AGfl88CvasF/HMsUJITzfocWAAssOFiPjJW1VxK8kVGB4BlAjp",
+	"cgnn0BanG1fqKaWecjH1lJO1v7/Os6XpqqnnQORRCJEqnKp9zJSZnCywlOwfTdVaCup+K2qEeR1t/55Q",
+	"2OLm7pJwfxRvsi2I9gIRqEQRomUOtM6XVQro+ibPNqDcSn0G/dmgbqQd7TYT/JfJpEsjfPy4qNz7kQOj",
+	"qh5byr9S/pV2+vkUHnmcHSiQEUNOGeDYMkNP5OUcQtvteD1+JjtPWXi9o0RUdVPZ7FqgZZqJzlP4arxu",
+	"+3JKilyt1aQUKe3MYdmuS412GGnYBrVGe74Os7Q0mQ3bS/N+k7JK68GK3/Tg0eFgjsY1LdIlt9b2r3uR",
+	"r08NfjHMAFVnlnzGFUs9bWtUEc0W9vX35G7Je1d2xSpFcWmKnpgpOiEKwlc6gyEfsZDMnfGNzZkH8t/w",
+	"h+Gdu1IqB7f1sXKmo91MpVNXUrK0peDjiQWktYLrWMBwNhQDfRSFXGMPo37jaE3BhPv3arXqL5P394YP",
+	"cjLD92sfSvTDz8Z7Lg74fF4i4PmC3dJrTPq31jX/1qm0EiuFfSnsj1fYE30TY/EMCP+/q3MRkiMz42sC",
+	"dYLvlJC5YqePKfqTmKJd8H+XTu7i9atJTjrV2FBqHqfkAGL+H4QfNxt3mn6r9VOHoCOhVvhJvK7qMD+h",
+	"zpw8cn87ZN8q+sPzlNLgqJBTXQjU86pOZeR4XWwBH3KNimsHEkeSYK0yOllL/WOEftYXTAsp0EFb6CDJ",
+	"JlfcitzLUg05ghqidudWtX3ZgrvMPy/1j1L/eKM0TlVN89LZHiFapHpll/pJ4b7iKktL56UN2D5pMjV/",
+	"3muCZMgJjZsN7jhAb0cOviPS7bB07QvW4UFycVHg531yZIh3E6jpI5QPX08mfxq1w7YhvGoUrOi9mEzw",
+	"DBPq4kKVIcvzNFbrxPTJKgVwKYCPLoAnooG3eXniTTtbzol5/zGLUZsf6RvXNUnAtvbLtiRGO6w/k9iP",
+	"A+ryTdxeAjgpqep6qyCZ340/90FXILP2G9nQN+k3hNvWasOB9ZsfNe94YfDvXIy48vey8bV0sMPO28xX",
+	"FDo3+WvnuLDYD2utq9biea5fPWGdxEcr6UzZcCpPZD3nxvvX3n777Z9XXD1APRUFS5YoNazAaxWNfrci",
+	"rxnZZ/qN4hIpOkdeOo95FMAo+SrjZ84UnbJd4pAKgjYwkaIrs5+zYfvb0J9WviPO5SiaiZYDwEntiv0+",
+	"7aC/oiDYhZfFEabwmdOxyS3cVYRKKeY2sEnPgxKseiIN8G8tQnAzbXazzuTqJjzwl1YK7AqLbqnOPJD/",
+	"pnZIC1G2a30UlYYO3SN54OJVPfZus1AAiwXi9mjIYIqeratC2nz9MFhI7NdC3m9l0WMrEuo3zr1/N1+U",
+	"2E8dbGkZWi4ly/mULF9Z0ox7GXxpgtsFfqXzcfXa9zPoFW/myZ5F36tHi3ke0gHS6AvZKwjoGK+iXRGv",
+	"xo/RjNiACmnI7tpwWN/wccbP4mep16h4mSq2eU+0XtJHoStyZQwwl32B4sJhWG+H7M/ar/riRY6PnEAu",
+	"46/Frq5Tt0AnXk2OBqI9wsT/QCDP8bqyDhPcFRmX+q2OskJu97MuOyQtGmZhs7f/yY9+QeR/g6KENpiH",
+	"ou2eyuR6JDFw2m6X7yusb0stBDvvGp4Kwz7gdWybiSBVTgVdE9WMbc080K3ahzNVcD4swAb5udGGHm/5",
+	"+zkphYQLqEDhjAp6g7ctMwYRb5iXvm+HxFFnX0Rf05c/tjJlfObiVOYpx2H02rzV9Ckp6/RKpa50F1yE",
+	"lh9WAWBl7TmxjFGRra0FfhY2w1FR03/IA4tZwzvyUvaw78eb1qiGCtoDnmy+hkfYAUSe2bx4+r7Fr0Fr",
+	"c9hA9+xTr13RaTd1Eyy62NVaTRGDEyEFjyPUIhz4Q0sDV7x6UPskjIL66MV+OMipA8yoMj1LjTauTVng",
+	"V8rwUoZPQEmdTWcf3XKceaD8BH9c8ZvBwv3jiQCkhW4nUxHI0CPSxuNvcIJnTXDqo2k0HXsw/SvnH8l1",
+	"HGmWOkJlHnkp0y6QTJM5W5azb8q7iQEo+85urB1N6h1PbrZLB2xLbcFsE1w9fHTcVGw132loWvaFsRDL",
+	"JPAyCbyUnqX0LC3CcRPU37xX15qnbs09G8RraZdsvMq6GHfPHuF0U9HVzwxJSy89s2USfJkEXybBl0nw",
+	"pf5T6j9nNwk+wzwf0WkwUpr8WNHy3GR5xemAKZisFz8zGNkxpNBb/Q5n0L9eJu+XyfulR6CUiFn+dCuC",
+	"ePHU/nijTO3PS+0/ikSN/KXlen7O8n8jAQlNLStVzeWTg7/CbMm/IBFbORImbuq2zBJ7ZustkiWr9wtH",
+	"pP/Jj27JVV18H/2FcaxHfljzm2LrRs+afpqc0zJbupSipV15/gWiLnrSGcVv3MGettY0PiPPk4IMiiVn",
+	"X0AsGROkzUnPGVKQ9UB2cj4mfnM7pJIgKjrbQ3L2OTH78EX4P+3O7/HzPBPalortINZ62hJn++TKN8R7",
+	"UsM2wPcfocK2JtbSga+9xknv4g/q+uINa0fQpu9FvuTrpfe+mPdezc8qkl5dbQaR3wxGkbe0I9foxUaY",
+	"Frmpfi7D5fd15YWH2FxGdhgVXWFxZpauM0EY+Xeo7UwRhziN9ysku6sdmVv3l/1WYTqYb9rI0PKbK0HV",
+	"xz8XmtZN5QVrMrv+zdP22Zu6l0U2KXwC2SLbJg9YqWeVelapZ533qjR5nckXqdpSHV2H4el9y7wBdXbD",
+	"Ucw4+AO+B0egzw7wJIt25HAB6HhTIZnQpLjrAIZCrUZSFU4Y0qZv1GcXJfe0gwrP3/AS4bbRYK84R4Cm",
+	"dewlAvXhpXutIr/jeMRtFCAAoTuKveMAA0iwfZFweJDEDLS7AIRe4zcd1EfIXoxXuUbXQRWvx7pWlWrR",
+	"r34GIgazIYZIhci/F80s173AuEL+PQ8EM8iczywFWPkwAcdz2AX+/22YtvPR/327AoAOPwI/ZIPksTUC",
+	"T4J4PetQ3onr2BmQEAvYW9+5XWl8ht+8ADLqAgAtfKfd7RSghP12E7tp+svtiHbKa0eLjWZr5gH9Q+RP",
+	"PMzmRt+zHsJ0rCWs/tAqg+kPRIZtIhi/xsnmcOASBZcEXus78aOk9U+8yfaly7PP9q1Oyqs4/RtyYYUs",
+	"I33NbzDN97yE2pKDkdWPydz6jrDUuXgvdddT1l0vvn6VfwqtTEXEbxTGlx/KGYn9ZaQ9HGZyOLaf4BWz",
+	"Xvw8M1BToIRiRJY3wVAzx8v4MuJnJQs8C+Z7af6eKfacEVkgjrwS+HdbMw/oHxBF92sBpZ95UXXRWqXd",
+	"FVXZimrpCIw6WjelJq8i5Jr06yeNyNDxP2CvFBNadap08QPxE7VlikA/4QydGryqsXlCQrkdombeA8sq",
+	"3gQ/f/L87zmSii1pDQalsnMkFzATBy63bHSj9GztCZV7U7aC1+D5bFbue7UguoE0LiQnxHaMzbjng9oN",
+	"8Y1zFisQLnb4way+G8mJL2lg+PFhW8I7hd++QY8/fHjKfm05oaxEPLqLZWO00qV97l3a8iyfgRZoP5Ds",
+	"yZU8XHbEz9muPA1SOoLImaymZ99nUYr1FLok/rCUErIY1PzsHPg/Y9lULw8gRpBcALw5yG/wcrKXrC8u",
+	"ter1FnullAHSlNH/hdkKWHAPznf2g9hR833aukPSVth2MgMJMbfKEyQAYeCxw/lGHibdI12ls+UJ/iKo",
+	"+aV6UUS9KF7MZy9N+/ScaAEGzyk1gFIDKDWAI88GS8V7kyfQpbhLiXDqQK5J46RpqVWyt6JG8362h1ea",
+	"7ziUbr6zvjH2gDACiNC46F0TCKfHdszcfNZRfNbxuvZN1/hTZozMsu6OBdTV6kEmDv4LTooLLLHfdJ49",
+	"kQUW1rIm/Vku858SJ4/I0ejg4dktBWUpKC+aoJyABtuZ97mYqdn0l+u5oKTS3Z0hCg7jdcwv64ujY2YE",
+	"kcCRc5lW4o0kRQh9RmZoxWv8Wu3i19gWWJ3UzGQ7LU5uwPxvNUrrr5j1xze7mOcXn04bgvDbs28Hihup",
+	"hDtKf3Ap5Epr8MjU7GgOQzIJKT6o589OvB9YkXO6DMyRxmATHdn3qwKcJFTnsWRNL7AJVJzCxReopx2n",
+	"7GKmfhe38otSNpWyqZRNxzybiRM4LxSWonF+3uQyZRBKzJCZB+KfufmnP0rMxQ4eStkHdNMoNLL5HI8V",
+	"D6SQZErWNLbUUD5x7kXTaKWhpTgqxdF5E0dqZfOkegTpFK6r+aPDa0DtgmBoNuyfjc7Muu8uL3cUoX3N",
+	"P+8k6SHowNRBMnqsK0iHxsxhNnLIDm2OmpTL/ZevebeJ2yG1e8btwQaDa0mLwa69HUYvZVulQDoQJPJr",
+	"LG3cwg+Lm4MfjtdR7O3xXJqutQ23LkczkmknQgyW2Bsl9oaOvXGekDXKRORSmyq1qYucyzu+XtVs1Ovz",
+	"XvWzmQcrfhNyNx7mQ16T/tFHQuHSt3gDrz5GUFNTYbt6Gu8B0UXqGVjfg8geQFPMvFWzbg9wmfhKor4Q",
+	"MSG76A/wItuP15UvxggRe4DE7nG2t0agY9nI2Dc4Gc6ENqOPwvcldwjZZSIIo7ffqriJNL6UlsaT5Tyg",
+	"W8u7yMGP/SJnRd6uspy/FJfnW1xy2mpnfmLRsKUI00SQIbRyBSZxHxSSdjxQiXsVPzfaY5E5r6y55xCO",
+	"ZY1QErRGQjt5M4S/7fNLRJFUzUEB0AoGkuftMAPKk57MhPN0lPWIi6yrF84U3QPuziegUhTYdj6QB+cJ",
+	"pH2/2VgqfetHFY9WvvCjchZLuMVSxpUm4QWDWNQZMwkVRXDE60K0AYso2CTZ0uBRpIzInFlYe/wl+IzB",
+	"R70Xf0kNJVQIIHKUx+sK/DTBRPxvTkr11XiD743slYZU1xJVUNSI4kwUpsNhozFsS2tPSZaT6Hngpij9",
+	"QltST0Yn9LUgqh3bY68Jsxw2ZmDEsAfysOr2NoW1Efkb62Iyya/FO0REAg+pdWewENeOuGGTntzh+q9o",
+	"jLo2xMjfVq41wlbUbFc5itN1vx6swEc+dUdp9qB5alN1KO6DgqzclQxwl/gDwXqSn4IiNH0Ra1JIxztz",
+	"JUXJ6a08RGh2PNdAN60WecB23duhHrySvKYnciesSDu0Ia/YHutwyMCOLGoWNjA8jw9A1zCGYKoQnuvg",
+	"Xvfz9k/Rfs5mBZJQe0bt8GFwt/ymM/FjYmZAxfhzdEfBIVjPaEVTIoSezyi1ggyQOiCaBJ0JlpYbzWgs",
+	"axBYQZ9tO3iOduBWOtdu/kYs9lfX/+fNj35Fd1TwWS5KbRijGOsdcPMQcm/pUwlAEn3vdkhnVJpq2T0Y",
+	"5Ju6UjHnwKV2HWWZrqNE01ynFXlRu+U6DbNbs+/V/Zp7O2ws+2EQ3rkauU50t/FeuOLXG/DicjNY8SJf",
+	"xuO76Xg4rGqKDtIrvlyqGERRpka7tdf4qXtMNTx8HxxKFzewoV0nfoLeaN6oI0e/cvVn4uecmnictwSu",
+	"BRtgndFT1mcveVa0mNnt0CYL4PX4CR4Xyb73EQaWPyLMdAGbAUb6C4fnFOBRcWrN+zfaoUqEvpkRkSw7",
+	"Ed+ug4zriWAJPHXRWCAR+9tkloZapmJm0CHGI61ceFVDcZo+XCE3uQdw7ZWb8C8f3vwXmy73AV69THXu",
+	"BLpk2YbgnviiHyT+8T69lPlR2k7tozV/wWvXo8rcgldv+W4KoCvrU0TtEed3w9dmWTgl4t5UWEtLuDS8",
+	"B6KJV1sr+c+daIhdXTjOUf3USlibBi52b6lO+92aaiwsBFW/1qi2l/wwmm4tN32v1lr0/WipPo3/18eW",
+	"EZv5IPRwg4qSZfib9u6g/LIO2EsSKIdcxeF99FSGyfZPQXX5L7r+AoUH2e4eMrsvec2l0CxIm1D8KQU1",
+	"nhLa+LyUEifHc5j+Fa4EHKLQ7sn4NikLBnLq/SO3cy01F6WxtP7oFauVlWWR2XU11/Te89unEdkyUN8W",
+	"uP4nP/qAaHDajo0LgWMRqLR8w8Yk27Ebk6U3vuSyJ8Fl/+NNcj+dTS/l4QyNZn/rUVJ+BO02MdopX5ER",
+	"u44e1Jd0CPAUx6vmixL2mO0CyfmMyI/OtmSmE1mUaIL22QEtmPUz3M3QVeOMc+YL7c/Lk8Ilby5585l3",
+	"SvYFFE0qhmA51CbXnfHvCf+knfm+iDegVRliJ4gSEEssLXNAEBKGrybp4qpGlJRJoZdp4LCXrMe27Zw0",
+	"MQaVyp3EwXbI93WXq8rwtX16kNx3CTwcjPCSXJ7WChakTy6TPh+OJP9e2kVTmEWfO3+GPB5deX63EeQv",
+	"x3ec8htjJ8Bd/uNBCTxXcvcTq47XTm2nuNKi8/fQv1sk8sS1aplL/4oN9BE7mNIhUy0oqMD6qROG/+pP",
+	"52bvVY6tXA4+qnSlK8xQj17jNkaxmghpFXvtI/m4Uej2QW3UjlNuhYfNio38MX8YjyvE44rmVOCzRy6c",
+	"cysUHSz4Kj0LkiOJExasDlBeyGiVrZ4SfV1ylm66T5h5LO3wc8ZV/Ep1OmZfw+nKyRcRDs8TVTrToqNS",
+	"SRt1dK5M2PyraEUlNcXxOjKgXSViueNgOpKotkjqmhFGCpLVqR4j3tTGUzINSxFdiugTTa3Muba6UH5A",
+	"/4BSAS+KvOoi6M85qZYvdBhTV+Tq9dk+8Ytuqi6gnwqiW8rqTN7i5IRCdrSH9Ra6PXbAbUK27zrcTntC",
+	"FqKST2CF1KHavOnsNMyrCoWKpfoTbY+Q6M8/cCJp/rZ0TwWnyNwgkYopIqDJ9mKuSUaTc+V0dChCZFtW",
+	"UkU4btXgiXj9kiszsuePdfVWp6WkKIsazltRg6p4JYV76rHuWVpqDiZGJmff9pRMdrPsYgh7CeObO1c/",
+	"F+4kPX4Qr6fA4wbFxR5SUfseBaTU8dH41xLsuKIRr8fPDDQeM/cwdS5IbzjgicjUI4u7wXDr4+c2D+gn",
+	"y/WGVzPF8UWUxrlpa0vtehQse81oBqTiVM2LvDzvxEJQ94u6PVXbE987bchyVchamNAL82BtyxP7WuE3",
+	"pWgtReuEiNbLl06S0t+j2r/PK8bTXS7gHHTjDSzsw5OyBUnkvGCLzEau/F66cvIHxJwJL/xLrWSCkmxU",
+	"aZ/a0NF9CTMPkh84Rm7NB0A2KylFhs+XNo1BA9jJdR4cuxr0QlRlPGV7VFilIFTEjy2zFbxHhoFVNwXG",
+	"LFwF7EVfD6wxXqUP4AWHryc+ShIVNvXoOlL2zKhH+gjqORh7FO0j5x79YETdRuaZl3pNqdeULoMLJ37/",
+	"olxvbiTni1935DqCk3aeX2/cDc+UyT6BMqlRjfxoqhU1fW/p6NlMP1hgFK3qcimaStFUiqaL08qZe2kT",
+	"HNexrUMOmpJdBpfR8aSXNs+4IzneBLI8wkO0hpLrFTqSMxM3KDvM2p2T7ZDN9xeJNUIZIf2csZAmAw5y",
+	"f8AGrmwYhkyFYGAdzDYZCLYjiuo6GcUYVyW2zMV0br8pm4rIBulYfpYA481fN8VB7kD8GvO5BwKJRglU",
+	"Y8BG2+9Svk2efMtfQQJMJOff1flh74zINO2sTzIY3RAmgEHZ9ijiaccmnghliSwpnmKIEkcdbE49P0LY",
+	"8J4przmQCoGjxuuEJU7eWdyDPr7Vk/AmIhlRFBTmeUK5RgZXDLeJ2k/HawqCVDrbm6OzOQLxDMsYDA6j",
+	"dagWsGtr8Zfq7DMOItJpj0O3sL/i6B1XlDXixKhDDNDavxf5IaQK3fSrjbDWQoPW4cU2B/gpkaiGZxBW",
+	"jIyUtiI9h/QnbdL5ZnXRr7Xr/kUX0ceRGl9rE+E4ObXuK5ds3VfMDdAAZGbdIb1bloLwZuQvW5su9WUX",
+	"oiS3FwNTr5LbJnVZum8bFTexkmuN9nzdT6zksL00T6O2Iq8ZfdwMqrawxjfyUH/Jzy59nHWMCqDUGRfP",
+	"SWAlVFIxBBA/N6JrcL2mR5ht66q9xE7kMid3kbd2UhQO1nNuvH/t7bff/rk2oBf5U1Gw5A9NLJATcFPH",
+	"I9nBU0884JfbJlj/mOIbksn3zgBCcc+i9Nm0XNEUTJNXPX4OFQQyISiML5R65KT7Sc5A81r9Koq+6roi",
+	"MRk67Tc6F8rQavOdMjOJm9jqm4ECKb85ddMPI+e9FdiqOcoJfClbpfMvgQcE/qKr2WzfwoeAavz0w9FP",
+	"IPngl32q0AaoRCBj0h6Mr7Fv2OasczsUdO4mnVJwd0y1EOUvr86mXaUw/FMVFxBbDYDaqEEw0XU0GaJN",
+	"V0SCTrQzB0upfTgt1ijE8JhDsknaWdspBVDpyJhwR4Z+NVRma+FPmdy/Wvea1O4zaISt0T3zO5meedIf",
+	"CaYDvd+ShAKZVceH7WlednKY9403nfRv0N2eGfXe17aJV4bbve7XdEpczDqvk4ByOpEKLO3cjlOEJc8Q",
+	"9205HMpxk22xXW4KSQfaXhnfPlti82zZPRMABaJz4UOjujhez6lk+k85IxRUazirLXJ3a64tDu0hsoDl",
+	"iDReFpfn+btGRVSKw19tfaZx+NJ1m+O6xW8UwCXRuPAt/16UcvjJL522U88QGNak2+TEnb+2YxZnnvXC",
+	"lCKgFAFHFQF2AVDIwph5oP0MD3hh6y5vVXn0qKsgkYi5ijaUytz3WF94Xjusy3Fj1yX1lDsjTmNC98RF",
+	"u8p68WPVaj4Qt0xvWDXfXlr+DaEaOP/DQcaujZHgcCtmypjVtrdDePURysWedOerqD6WrCcRftonqiXd",
+	"ypQGK9o3INCKg2zH/yvexPiZ0I4tltVV3N2zIXr1EYyDOPZA5nfOl6hPbt+Igt6tKEe7SK8PyKqYrwet",
+	"xWKNQTQ1gs/y7CsR3yosGnr50G2Mn5fdS8uQ30VJjVZk6RkIA35jCngRCdyiRvpSrE5Q7/Bulu4zkt7W",
+	"CP0c4M4ctcwCtS9AbMEH90jcVr2xyU6qCzlXe2x/ympQPkicx5yTya5009h7TU4hUXS0fojYW27AfYXJ",
+	"b9A1rXQlxRZsspdZvKFNkHX4JspW50abcyejy3l2h3PQ7ay9Vvh53U0vbNph39jphyXGG/CdJNh5wLsN",
+	"8OQPLjCecFzrbafVaDervML4g5ratECMzfq0o0YyoR3KFTIaRZqgKO7uqYiKqGIrIVdrP3Y4ohLQ9SL6",
+	"621DJCiuo01bgXM9I53d5aE1nBOljlTqSGVa1HHMpxd/ET/n01Kk7YSoQl+b/MXSHjVb/2kGkd8MvOyY",
+	"+NcpCZ8ZobBJQA6EFm+QrKPaazja+Z/5/x59ZSTzDzI0sX0rpOk1sa6yvGzM0DMRcJyws64T9li3FHil",
+	"wLt4Am8CJEuecWfB+Dx6YEMEx58LzKhVOvRAMsSjT/EWY1Kr/DWZBHU7ZN8krx/QPWLbvB+RsJppF5JU",
+	"WNywV1DorAQI6HWE0YKiFG698rSZjgM2ZbwGH34J0g7tS9ZR52dPoJ0AYTWeC1/KKd2Xv+Tdu1ltNP3C",
+	"EuyX4oWC7UTki6KjyF0/uLMYFX7tn+nxjFYX/GNpJ79NzB6v0/8NCH6TRajxAKowO9vCn1deigApdN8H",
+	"b1TP4jOLNwW7TfMs1eIoNYVSUzhN0/g7NjDkZLzJ9mXAICW64g1VRvVL3aZf1HiuNb2FKBfy82/EhfBu",
+	"QiKggDhUq4BE60WciFL6g75ts8A9U53at2B7puHKglbVa3K0sus4+9I+HsPtS6SzXb7MDS9j46Vwu3DC",
+	"zTHPO6/lj9cmFNIyfpKiR1FQyx/0RBo9Nik/aIgExLQ8kkiQ7Z9KcfDmxUEpAUoJUEqAC9ocfiQZcHRf",
+	"qZZ5uSlSoXpqmT+XI5hQzU8bpHunGwqYWqsCb6UPgzvBcxpEZI5Tkrod4YPbkMfDUQ2I6eBvHapk3iaW",
+	"wg4UIwhtLxEJhAhhR2tBkM6rIbwg7SPC2dszuif3RAfnBHEhlZlOx4h3JIjXDdPMxGTYsfpxvRV/EiTp",
+	"sYBmnXhT6KO1Sn54yhnZI1udZVZ2qXeUAdgLAeOpBFOKqhd57soZpTzFroSklAHFM6n6Lc3+jCLkiUSQ",
+	"6gYvic5obkQtlC1uUb28TI3Mrpo0iTetNFlPKQ2HCB3SxS3riMjyQIATKuqGm/qgmsmrzaCfHB++QJtu",
+	"8DHRvDS033S6baKqlWKvFHsXpBjJwowMcRhvnEKcMR3q4ESHA7A3Kea/pcZ5fAnt1wLctmUvqi5aKPcn",
+	"wxBNA6EiEc2LikkNPUt6btod/F4tiC5urUlpwGoGbIr5YxkUtEEhZSvvjGF5F7SG5NVr8E8dnXNHstoB",
+	"e0mPJRBT5kHU1b0UzCeH7e3xwv4ONSFB1LeB4rlJBdUzPDcnbbkPLxMCjgXre0or0uYt8eC2sXKsE38h",
+	"YB9MwnJfX58dKHoHMKVnp6AKfaV64GAhwiboc6hwUKXlPJX6BUTAk1oF6ttm9j/V/1mrC9k+X+t515su",
+	"vvD8nl/mXSx+HKt+xa8Hd4L5oI7jZZWw/GirQbUWpuyKPrld0VtpePj0vWQKN9p1v1WadiPeLpN+VmZp",
+	"2UJTlymNvdLYK32cFyCAmpLqMGQWZsAbqDzJBC2wQD6AwpG07+Hp4/sZGFSk5lrFkRIVjZ9Zo6KOaOg3",
+	"oJ1XHuRxTRXPj2KainKe1QrQVpAyMSLtOGzBKvwT4ZJ8Xp7yoR/egbN+aXY21SoGG8BcaywtQ8Zw7Voj",
+	"jJpeNRqxC1CjeccLg38nnKr7y36rcHWH+aalyOOUY53jqwNnofQk825vCcsmLTuTZvel9C+l/2RJ/x9H",
+	"gQcqYgXOVBf96mfZtuB35ocNmQqEcDN8DnQLVDXeqhBkuCXS4ElbbCA0B7mDBJyUoz18l8xWZNv14ydi",
+	"QgdGiFRkhqG3bpUdikvPFwNTcqguSPHOwOTiJzAfJ61V9LJnl4Yugr1QlInSNB5fFvot0A1sfATcKLCh",
+	"e/GXdH+1Iy1rukrbeKKk44/FkLK1eW+ZpQWHVjI8K4XlKQUbH3GXf0cYfaoksAmtHHmUKU2DcCWIaBe9",
+	"atVfjsZCrdZw/CgTmQiSgZtIz4sOpr14zfhEEgwayA6hCephGpEZZ/6BXEopeka7kckhsPNH+9bwTYRE",
+	"cWnUlFJnUnsISKx561k5E0ksiKtrn+CEI+x+p1xl5W4bwKxFhEjNr9aD0D8RKQIk20XU/g3RBp08pCA0",
+	"lD4GiTmVtL+U76acwzzzgO8CghNhk8b4SzVSaJ8TFbMIjN/s7gQHCv8kISghbi2W1XWiaSnfTla+0RHZ",
+	"w8STnuK4K2VcKeNKGXcuUeR3eWlivDa+iDuulqKHMhba4SRRcTUtspBCeza5s5Nk6CUm4YY1geUDZR1l",
+	"Q9Az3RBUlVqjwrLaOMCOpSeo7u89L91ALZ3hjMYLpRexjLFNrNvQ0lFDuR5W7O4icm/mgZpQ8EHtYS4a",
+	"2hBZOKRTCtBQMesyVJpph/2FB7QKtoWzW5IG7oEwxVLy84a/0vjsLNhh+gj6tow9jvGZCbb6BhIIdFAK",
+	"xFIgXqhmZ6MZr5NhFqKkybQIx0w5HSLh9MFEjU9aPEGNz67uzXwmvZkCyxhOZYbblvWsn0Vf5S7tGmyX",
+	"aNfKfaKiat7NKEbKGEjvYuZ87Ie1ILxj82miEPU/UkROKUzPsTD91pobZTkmnVKglgL1QglUe1rg5ArU",
+	"70aQQZlmZ70R5fhZ/xNWGG9kZDmdZnsqrbQ5Xpfi9IB1nBVqLG0XqVQDspokrDpguoojAK8jTh9Vjaw5",
+	"8R/wTxq+Tj9dZ23zAH8IpJ2c3pKc6lpnyYVGc8mLqMzi7bcqSgXGpXQFxsk4e+uNaHQvrzwhZWSylKUX",
+	"oxN3AgM6uRJUiDcLkmyjNYYlqiuzW0AO6ggiCgaQj0w77AcRDIqfc4mUAZo2FFrmaq32YaPERc0rJZxv",
+	"17h2M0QuvEsPFkSSqTciASPzu7YXRhwsYcgrvxaPHgf6jKURlvpNZWKuoMKnp1yIWG/wIW2apnZnFG5/",
+	"rmzYsnNVKW4nPTj6lX6NyTAlJbqTa4rOPKg3Ih7xHIrXphb1a2ajjmllwpWj91UL0YqpHatkBtC30xTN",
+	"+ghI1rE/T2+XYv9siP2zLMDPEVSsy++9yNfEZHRMASwFeynYi9nRdIAmVcznQc2NJO5nvLteszZWLYsl",
+	"3xebUK7ird5KAr5rWoxY7JwtfemZq+U5CfQ6bEeN7uDElE/b47CQCRH780HtCB+nt899vHaIPNQO4ynJ",
+	"xP+0SznXevoVYFhxAwZ09sWVKWVjKRtzZKNrHJTcQzaxcVspn8Yyj2eqXlj168ckMGFcpRFJtrAUTZWB",
+	"4rilfX7VLCm813CGpQF8noRVchDOlKAqRU4pckpzrFiG7T4l/w0XJ00v/AywM7NrLO25OnlVHyYWG0Ie",
+	"iSq4AQejTdyxDuti/n+Ppy9JEILf44+7ApZmlkDdLs3OUtrPt/KBPm+CmdRsWsVe/JiPJ3ppoT23i3J4",
+	"DScL2b+wU3xZimtZZC7RmLKJdM94H3aVoGrXnNmMhCBqAXKDE74sCj3TRaHzQU3sVJF0oe+RH64hzsSr",
+	"LFzgMnmolLJlNPNcujmH3e2iZZ7NRr0+71U/m3nA8yUf5htxu3hqeEPE1JVMZd7u6rmwB6nU1WmH/R22",
+	"AegILYnWVDgfpZuk1mqS/KE8FZZ38lDyx4Auu0gUnjULzuB4XXzXUt7JiXB6PbFStxQPR/xIWRkV7qi1",
+	"O0iYpGWlKOOR+2M2NbEsI0mSzV7FaEmzk9GC0mzllJC+I1s5DTuRUoctu5qUgrjM4r1oeENw5rJrNDIF",
+	"civyonYrF9qcowdxJq8s0NYMWVzbeB0Vg13WEY3ZSHJyXDtkSZ/TAyRe4vXpbKPxJs3y7NmMZ1CYcFpl",
+	"XaJdZFaosmVvZSkhymZ9p9WnSZ5RzkjUWXVstQrtKC9LckS+NRqD+mS55kX+WeRRLTGbowwgWcnF1bJ/",
+	"yD4Wuf1TSw5Z6tClM+v8SZx0t/MhAkbRm+fmRUp+Rn3ci2TfeS6BDrY54Kn5Ek0T6L9lDMn2WX/OqTZ9",
+	"L/IV3ETeRNBIXFDaFWQ11th0b4fL7fl60Fp01JgWXTLXqdYbLV+JJwsstGmHfc06lEQYbxqTl60i4scY",
+	"Veqn+/4orWLUzj/0ODug2yNzMsRRcW+HyVI00FI4aKlsjj4VMcwlzPqxY3JyanNIIXRVC5AoRWDcgGML",
+	"KPqU9dlLbG+DsbYXDt105LIEf7OPoXn2mihvbmpfu/1qO8VuEn/boe4ZHXbAyY5vzonKDV5tkUymY0lS",
+	"6SKzJK8oxArjNSAdZ05kc8Ub1vkptKWG1ypRLr912Ra+exdOPuk5WSrOeSiK8KLGUlDV+iIuePWW7+bc",
+	"ZOF9RigHCzmlkOorG1yRrsr5RqPuewitKkmqt1bUp9hYhv/6ITg8f1shNgAUpxtccSt4XyufWppAcjVn",
+	"7sGQrunk5bYhV8je+voaaRag/upz1YYpooJdV14oWHBCL4qak8ayHwbhnatRsdc+ko8bDS4/qI0KrARr",
+	"D1Z4P87hI3/MH0bh5NX9WtFaF3z2yGUybiVx7BTX891KdLfxXrji1xtFR72lvJBRk6ueErNCV5opBrnT",
+	"dbpuYjWNYBxp82ks279rtirV35F39rRrh1H7yGnZ9ieShaxvXuCdacfSz003bzDzxLj2U0ZI58Qtnu+E",
+	"aEPFBeSUTNqGBsQSzm6LDcQDIJXJOqLMmH3NY3xQ3IQqfUjnxofED0mmwpOlZdMmAEMSiky7Wa/MVRaj",
+	"aHluZqbeqHr1xUYrmvvZ7M9mZ7zloPLw04f//wDWbi4sGb8DAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
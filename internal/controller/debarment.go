@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// debarSupplier Общая часть отстранения на площадке (пустой orgID) и организацией.
+func (c *Controller) debarSupplier(ctx echo.Context, orgID, username string) error {
+	var body DebarSupplierJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	debarment := models.Debarment{
+		SupplierUsername: body.SupplierUsername,
+		Reason:           body.Reason,
+		EndsAt:           body.EndsAt,
+	}
+	if body.StartsAt != nil {
+		debarment.StartsAt = *body.StartsAt
+	}
+	if body.SupplierOrganizationId != nil {
+		supplierOrgID, err := uuid.Parse(*body.SupplierOrganizationId)
+		if err != nil {
+			return InternalError(ctx, err)
+		}
+		debarment.SupplierOrganizationID = uuid.NullUUID{UUID: supplierOrgID, Valid: true}
+	}
+
+	newDebarment, err := c.organizationService.DebarSupplier(ctx.Request(), orgID, &debarment, username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newDebarment)
+	return nil
+}
+
+func (c *Controller) getDebarments(ctx echo.Context, orgID, username string, active *bool, offsetParam, limitParam *int32) error {
+	var offset, limit int32 = 0, 5
+	if offsetParam != nil {
+		offset = *offsetParam
+	}
+	if limitParam != nil {
+		limit = *limitParam
+	}
+
+	debarments, err := c.organizationService.GetDebarments(ctx.Request(), orgID, username, active != nil && *active, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, debarments)
+	return nil
+}
+
+func (c *Controller) liftDebarment(ctx echo.Context, orgID, debarmentID, username string) error {
+	debarment, err := c.organizationService.LiftDebarment(ctx.Request(), orgID, debarmentID, username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, debarment)
+	return nil
+}
+
+// DebarSupplier (POST /debarments).
+func (c *Controller) DebarSupplier(ctx echo.Context, params DebarSupplierParams) error {
+	return c.debarSupplier(ctx, "", params.Username)
+}
+
+// GetDebarments (GET /debarments).
+func (c *Controller) GetDebarments(ctx echo.Context, params GetDebarmentsParams) error {
+	return c.getDebarments(ctx, "", params.Username, params.Active, params.Offset, params.Limit)
+}
+
+// LiftDebarment (PUT /debarments/{debarmentId}/lift).
+func (c *Controller) LiftDebarment(ctx echo.Context, debarmentID DebarmentId, params LiftDebarmentParams) error {
+	return c.liftDebarment(ctx, "", debarmentID, params.Username)
+}
+
+// DebarOrganizationSupplier (POST /organizations/{organizationId}/debarments).
+func (c *Controller) DebarOrganizationSupplier(ctx echo.Context, organizationID OrganizationId, params DebarOrganizationSupplierParams) error {
+	return c.debarSupplier(ctx, organizationID, params.Username)
+}
+
+// GetOrganizationDebarments (GET /organizations/{organizationId}/debarments).
+func (c *Controller) GetOrganizationDebarments(ctx echo.Context, organizationID OrganizationId, params GetOrganizationDebarmentsParams) error {
+	return c.getDebarments(ctx, organizationID, params.Username, params.Active, params.Offset, params.Limit)
+}
+
+// LiftOrganizationDebarment (PUT /organizations/{organizationId}/debarments/{debarmentId}/lift).
+func (c *Controller) LiftOrganizationDebarment(ctx echo.Context, organizationID OrganizationId, debarmentID DebarmentId, params LiftOrganizationDebarmentParams) error {
+	return c.liftDebarment(ctx, organizationID, debarmentID, params.Username)
+}
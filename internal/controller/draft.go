@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// SaveBidDraft (PUT /bids/{bidId}/draft).
+func (c *Controller) SaveBidDraft(ctx echo.Context, bidID BidId, params SaveBidDraftParams) error {
+	var body SaveBidDraftJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	parsedBidID, err := uuid.Parse(bidID)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	draft := models.BidDraft{
+		BidID:              parsedBidID,
+		Name:               body.Name,
+		Description:        body.Description,
+		Price:              body.Price,
+		TechnicalProposal:  body.TechnicalProposal,
+		CommercialProposal: body.CommercialProposal,
+	}
+
+	newDraft, err := c.bidService.SaveBidDraft(ctx.Request(), &draft, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newDraft)
+	return nil
+}
+
+// GetBidDraft (GET /bids/{bidId}/draft).
+func (c *Controller) GetBidDraft(ctx echo.Context, bidID BidId, params GetBidDraftParams) error {
+	draft, err := c.bidService.GetBidDraft(ctx.Request(), bidID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+	return nil
+}
+
+// DiscardBidDraft (DELETE /bids/{bidId}/draft).
+func (c *Controller) DiscardBidDraft(ctx echo.Context, bidID BidId, params DiscardBidDraftParams) error {
+	draft, err := c.bidService.DiscardBidDraft(ctx.Request(), bidID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+	return nil
+}
+
+// PublishBidDraft (PUT /bids/{bidId}/draft/publish).
+func (c *Controller) PublishBidDraft(ctx echo.Context, bidID BidId, params PublishBidDraftParams) error {
+	bid, err := c.bidService.PublishBidDraft(ctx.Request(), bidID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, bid)
+	return nil
+}
+
+// SaveTenderDraft (PUT /tenders/{tenderId}/draft).
+func (c *Controller) SaveTenderDraft(ctx echo.Context, tenderID TenderId, params SaveTenderDraftParams) error {
+	var body SaveTenderDraftJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	parsedTenderID, err := uuid.Parse(tenderID)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	draft := models.TenderDraft{
+		TenderID:    parsedTenderID,
+		Name:        body.Name,
+		Description: body.Description,
+	}
+	if body.ServiceType != nil {
+		serviceType := models.ServiceType(*body.ServiceType)
+		draft.ServiceType = &serviceType
+	}
+
+	newDraft, err := c.tenderService.SaveTenderDraft(ctx.Request(), &draft, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newDraft)
+	return nil
+}
+
+// GetTenderDraft (GET /tenders/{tenderId}/draft).
+func (c *Controller) GetTenderDraft(ctx echo.Context, tenderID TenderId, params GetTenderDraftParams) error {
+	draft, err := c.tenderService.GetTenderDraft(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+	return nil
+}
+
+// DiscardTenderDraft (DELETE /tenders/{tenderId}/draft).
+func (c *Controller) DiscardTenderDraft(ctx echo.Context, tenderID TenderId, params DiscardTenderDraftParams) error {
+	draft, err := c.tenderService.DiscardTenderDraft(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+	return nil
+}
+
+// PublishTenderDraft (PUT /tenders/{tenderId}/draft/publish).
+func (c *Controller) PublishTenderDraft(ctx echo.Context, tenderID TenderId, params PublishTenderDraftParams) error {
+	tender, err := c.tenderService.PublishTenderDraft(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, tender)
+	return nil
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// SetEligibilityRules (PUT /tenders/{tenderId}/eligibility).
+func (c *Controller) SetEligibilityRules(ctx echo.Context, tenderID TenderId, params SetEligibilityRulesParams) error {
+	var body SetEligibilityRulesJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	parsedTenderID, err := uuid.Parse(tenderID)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	rules := models.EligibilityRules{
+		TenderID:    parsedTenderID,
+		Certificate: body.Certificate,
+	}
+	if body.OrganizationTypes != nil {
+		for _, t := range *body.OrganizationTypes {
+			rules.OrganizationTypes = append(rules.OrganizationTypes, models.OrganizationType(t))
+		}
+	}
+	if body.MinCompletedContracts != nil {
+		rules.MinCompletedContracts = *body.MinCompletedContracts
+	}
+
+	newRules, err := c.tenderService.SetEligibilityRules(ctx.Request(), &rules, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newRules)
+	return nil
+}
+
+// GetEligibilityRules (GET /tenders/{tenderId}/eligibility).
+func (c *Controller) GetEligibilityRules(ctx echo.Context, tenderID TenderId, params GetEligibilityRulesParams) error {
+	rules, err := c.tenderService.GetEligibilityRules(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, rules)
+	return nil
+}
+
+// CheckEligibility (GET /tenders/{tenderId}/eligibility/check).
+func (c *Controller) CheckEligibility(ctx echo.Context, tenderID TenderId, params CheckEligibilityParams) error {
+	result, err := c.tenderService.CheckEligibility(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, result)
+	return nil
+}
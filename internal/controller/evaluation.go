@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// SetCriteria (PUT /tenders/{tenderId}/criteria).
+func (c *Controller) SetCriteria(ctx echo.Context, tenderID TenderId, params SetCriteriaParams) error {
+	var body SetCriteriaJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	criteria := make([]models.Criterion, len(body))
+	for i, item := range body {
+		criteria[i] = models.Criterion{Name: item.Name, Weight: float64(item.Weight)}
+		if item.MaxScore != nil {
+			criteria[i].MaxScore = *item.MaxScore
+		}
+	}
+
+	newCriteria, err := c.tenderService.SetCriteria(ctx.Request(), tenderID, criteria, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newCriteria)
+	return nil
+}
+
+// GetCriteria (GET /tenders/{tenderId}/criteria).
+func (c *Controller) GetCriteria(ctx echo.Context, tenderID TenderId, params GetCriteriaParams) error {
+	criteria, err := c.tenderService.GetCriteria(ctx.Request(), tenderID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, criteria)
+	return nil
+}
+
+// GetTenderRanking (GET /tenders/{tenderId}/ranking).
+func (c *Controller) GetTenderRanking(ctx echo.Context, tenderID TenderId, params GetTenderRankingParams) error {
+	var offset, limit int32 = 0, 5
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	ranking, err := c.tenderService.GetTenderRanking(ctx.Request(), tenderID, params.Username, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, ranking)
+	return nil
+}
+
+// ScoreBid (PUT /bids/{bidId}/scores).
+func (c *Controller) ScoreBid(ctx echo.Context, bidID BidId, params ScoreBidParams) error {
+	var body ScoreBidJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	scores := make([]models.BidScore, len(body))
+	for i, item := range body {
+		criterionID, err := uuid.Parse(item.CriterionId)
+		if err != nil {
+			return InternalError(ctx, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidScore})
+		}
+		scores[i] = models.BidScore{CriterionID: criterionID, Score: item.Score, Comment: item.Comment}
+	}
+
+	newScores, err := c.bidService.ScoreBid(ctx.Request(), bidID, scores, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newScores)
+	return nil
+}
+
+// GetBidScores (GET /bids/{bidId}/scores).
+func (c *Controller) GetBidScores(ctx echo.Context, bidID BidId, params GetBidScoresParams) error {
+	scores, err := c.bidService.GetBidScores(ctx.Request(), bidID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, scores)
+	return nil
+}
+
+// GetDecisionRecords (GET /bids/{bidId}/decisions).
+func (c *Controller) GetDecisionRecords(ctx echo.Context, bidID BidId, params GetDecisionRecordsParams) error {
+	var offset, limit int32 = 0, 5
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	records, err := c.bidService.GetDecisionRecords(ctx.Request(), bidID, params.Username, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, records)
+	return nil
+}
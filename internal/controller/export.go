@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/service"
+	"zadanie-6105/internal/util"
+)
+
+// exportFormat Формат из запроса, по умолчанию CSV.
+func exportFormat(ctx echo.Context, format *ExportFormat) (models.ExportFormat, error) {
+	if format == nil {
+		return models.ExportCSV, nil
+	}
+
+	f := models.ExportFormat(*format)
+	if _, ok := models.ExportContentTypes[f]; !ok {
+		return "", InternalError(ctx, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidExportFormat})
+	}
+
+	return f, nil
+}
+
+// openExport Заголовки ответа выставляются только когда сервис открывает выгрузку, то есть после
+// проверок доступа. Строки пишутся прямо в ответ.
+func openExport(ctx echo.Context, format models.ExportFormat, name string) service.OpenExport {
+	return func() (util.TableWriter, error) {
+		resp := ctx.Response()
+		resp.Header().Set(echo.HeaderContentType, models.ExportContentTypes[format])
+		resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+"."+string(format)))
+		resp.WriteHeader(http.StatusOK)
+
+		return util.NewTableWriter(resp, format)
+	}
+}
+
+// exportError После начала выгрузки статус уже отправлен, поэтому ошибка только логируется, а файл обрывается.
+func (c *Controller) exportError(ctx echo.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ctx.Response().Committed {
+		c.zapLogger.Error(err)
+		return err
+	}
+
+	return InternalError(ctx, err)
+}
+
+// ExportUserTenders (GET /tenders/my/export).
+func (c *Controller) ExportUserTenders(ctx echo.Context, params ExportUserTendersParams) error {
+	format, err := exportFormat(ctx, params.Format)
+	if err != nil {
+		return err
+	}
+
+	err = c.tenderService.ExportUserTenders(ctx.Request(), params.Username, openExport(ctx, format, "tenders"))
+	return c.exportError(ctx, err)
+}
+
+// ExportBidsForTender (GET /bids/{tenderId}/list/export).
+func (c *Controller) ExportBidsForTender(ctx echo.Context, tenderID TenderId, params ExportBidsForTenderParams) error {
+	format, err := exportFormat(ctx, params.Format)
+	if err != nil {
+		return err
+	}
+
+	err = c.bidService.ExportBidsForTender(ctx.Request(), tenderID, params.Username, openExport(ctx, format, "bids"))
+	return c.exportError(ctx, err)
+}
+
+// ExportBidReviews (GET /bids/{id}/reviews/export).
+func (c *Controller) ExportBidReviews(ctx echo.Context, id string, params ExportBidReviewsParams) error {
+	format, err := exportFormat(ctx, params.Format)
+	if err != nil {
+		return err
+	}
+
+	open := openExport(ctx, format, "reviews")
+	if params.AuthorUsername != nil {
+		err = c.bidService.ExportBidReviews(ctx.Request(), id, *params.AuthorUsername, params.RequesterUsername, open)
+	} else {
+		err = c.bidService.ExportReviewsForBid(ctx.Request(), id, params.RequesterUsername, open)
+	}
+	return c.exportError(ctx, err)
+}
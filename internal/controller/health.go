@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+// GetHealth (GET /api/health).
+func (c *Controller) GetHealth(ctx echo.Context) error {
+	health, err := c.healthService.GetHealth(ctx.Request())
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, health)
+	return nil
+}
+
+// Liveness (GET /healthz). Процесс жив, пока отвечает; зависимости не проверяются,
+// чтобы недоступная база не приводила к перезапуску пода.
+func (c *Controller) Liveness(ctx echo.Context) error {
+	ctx.String(http.StatusOK, "ok")
+	return nil
+}
+
+// Readiness (GET /readyz).
+func (c *Controller) Readiness(ctx echo.Context) error {
+	readiness := c.healthService.GetReadiness(ctx.Request())
+	if !readiness.Ready {
+		ctx.JSON(http.StatusServiceUnavailable, readiness)
+		return nil
+	}
+
+	ctx.JSON(http.StatusOK, readiness)
+	return nil
+}
+
+// StartDraining Вызывается при получении сигнала завершения, до остановки сервера.
+func (c *Controller) StartDraining() {
+	c.healthService.StartDraining()
+}
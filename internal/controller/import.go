@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// importReportJSON Отчёт по умолчанию. Остальные форматы совпадают с форматами выгрузки.
+const importReportJSON ImportReportFormat = "json"
+
+// ImportTenders (POST /tenders/import).
+func (c *Controller) ImportTenders(ctx echo.Context, params ImportTendersParams) error {
+	format := models.ImportCSV
+	if params.Format != nil {
+		format = models.ImportFormat(*params.Format)
+	}
+
+	reportFormat := importReportJSON
+	if params.Report != nil {
+		reportFormat = *params.Report
+	}
+	if reportFormat != importReportJSON {
+		if _, ok := models.ExportContentTypes[models.ExportFormat(reportFormat)]; !ok {
+			return InternalError(ctx, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidExportFormat})
+		}
+	}
+
+	dryRun := params.DryRun != nil && *params.DryRun
+	report, err := c.tenderService.ImportTenders(ctx.Request(), ctx.Request().Body, format, params.Username, dryRun)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	if reportFormat == importReportJSON {
+		ctx.JSON(http.StatusOK, report)
+		return nil
+	}
+
+	return writeImportReport(ctx, &report, models.ExportFormat(reportFormat))
+}
+
+// writeImportReport Отдаёт отчёт файлом, по строке на строку импорта.
+func writeImportReport(ctx echo.Context, report *models.ImportReport, format models.ExportFormat) error {
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, models.ExportContentTypes[format])
+	resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", "import-report."+string(format)))
+	resp.WriteHeader(http.StatusOK)
+
+	return util.WriteImportReport(resp, report, format)
+}
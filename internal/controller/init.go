@@ -12,16 +12,32 @@ import (
 )
 
 type Controller struct {
-	zapLogger     *zap.SugaredLogger
-	tenderService *service.TenderService
-	bidService    *service.BidService
+	zapLogger           *zap.SugaredLogger
+	tenderService       *service.TenderService
+	bidService          *service.BidService
+	auditService        *service.AuditService
+	attachmentService   *service.AttachmentService
+	auctionService      *service.AuctionService
+	organizationService *service.OrganizationService
+	reviewService       *service.ReviewService
+	contractService     *service.ContractService
+	healthService       *service.HealthService
 }
 
-func NewController(l *zap.SugaredLogger, ts *service.TenderService, bs *service.BidService) *Controller {
+func NewController(l *zap.SugaredLogger, ts *service.TenderService, bs *service.BidService, as *service.AuditService,
+	ats *service.AttachmentService, acs *service.AuctionService, ors *service.OrganizationService,
+	rs *service.ReviewService, cs *service.ContractService, hs *service.HealthService) *Controller {
 	return &Controller{
-		zapLogger:     l,
-		tenderService: ts,
-		bidService:    bs,
+		zapLogger:           l,
+		tenderService:       ts,
+		bidService:          bs,
+		auditService:        as,
+		attachmentService:   ats,
+		auctionService:      acs,
+		organizationService: ors,
+		reviewService:       rs,
+		contractService:     cs,
+		healthService:       hs,
 	}
 }
 
@@ -41,4 +57,21 @@ func InternalError(ctx echo.Context, err error) error {
 
 	ctx.JSON(http.StatusInternalServerError, ErrorResponse{Reason: err.Error()})
 	return err
-}
\ No newline at end of file
+}
+
+// decodeJSONBody Разбирает тело запроса и сам отвечает клиенту при ошибке.
+func (c *Controller) decodeJSONBody(ctx echo.Context, dst interface{}) error {
+	err := util.DecodeJSONBody(ctx.Request(), dst)
+	if err == nil {
+		return nil
+	}
+
+	c.zapLogger.Error(err)
+	var mr *util.MalformedRequestError
+	if errors.As(err, &mr) {
+		ctx.JSON(mr.Status, ErrorResponse{Reason: mr.Msg})
+		return err
+	}
+	ctx.JSON(http.StatusInternalServerError, ErrorResponse{Reason: err.Error()})
+	return err
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// GetInvitedTenders (GET /tenders/invited).
+func (c *Controller) GetInvitedTenders(ctx echo.Context, params GetInvitedTendersParams) error {
+	var offset, limit int32 = 0, 5
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	tenders, err := c.tenderService.GetInvitedTenders(ctx.Request(), params.Username, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, tenders)
+	return nil
+}
+
+// GetInvitations (GET /tenders/{tenderId}/invitations).
+func (c *Controller) GetInvitations(ctx echo.Context, tenderID TenderId, params GetInvitationsParams) error {
+	var offset, limit int32 = 0, 5
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	invitations, err := c.tenderService.GetInvitations(ctx.Request(), tenderID, params.Username, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, invitations)
+	return nil
+}
+
+// InviteOrganization (PUT /tenders/{tenderId}/invitations/{organizationId}).
+func (c *Controller) InviteOrganization(ctx echo.Context, tenderID TenderId, organizationID OrganizationId, params InviteOrganizationParams) error {
+	invitation, err := c.tenderService.InviteOrganization(ctx.Request(), tenderID, organizationID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, invitation)
+	return nil
+}
+
+// RevokeInvitation (DELETE /tenders/{tenderId}/invitations/{organizationId}).
+func (c *Controller) RevokeInvitation(ctx echo.Context, tenderID TenderId, organizationID OrganizationId, params RevokeInvitationParams) error {
+	invitation, err := c.tenderService.RevokeInvitation(ctx.Request(), tenderID, organizationID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, invitation)
+	return nil
+}
+
+// AcceptInvitation (PUT /tenders/{tenderId}/invitation/accept).
+func (c *Controller) AcceptInvitation(ctx echo.Context, tenderID TenderId, params AcceptInvitationParams) error {
+	invitation, err := c.tenderService.RespondInvitation(ctx.Request(), tenderID, params.Username, models.InvitationStatusAccepted)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, invitation)
+	return nil
+}
+
+// DeclineInvitation (PUT /tenders/{tenderId}/invitation/decline).
+func (c *Controller) DeclineInvitation(ctx echo.Context, tenderID TenderId, params DeclineInvitationParams) error {
+	invitation, err := c.tenderService.RespondInvitation(ctx.Request(), tenderID, params.Username, models.InvitationStatusDeclined)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, invitation)
+	return nil
+}
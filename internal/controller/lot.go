@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// AddLot (POST /tenders/{tenderId}/lots).
+func (c *Controller) AddLot(ctx echo.Context, tenderID TenderId, params AddLotParams) error {
+	var body AddLotJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	lot := models.Lot{
+		Name:        body.Name,
+		ServiceType: models.ServiceType(body.ServiceType),
+		Quantity:    body.Quantity,
+		Budget:      float64(body.Budget),
+	}
+
+	newLot, err := c.tenderService.AddLot(ctx.Request(), tenderID, &lot, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newLot)
+	return nil
+}
+
+// GetLots (GET /tenders/{tenderId}/lots).
+func (c *Controller) GetLots(ctx echo.Context, tenderID TenderId, params GetLotsParams) error {
+	var version int32
+	if params.Version != nil {
+		version = *params.Version
+	}
+
+	lots, err := c.tenderService.GetLots(ctx.Request(), tenderID, params.Username, version)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, lots)
+	return nil
+}
+
+// EditLot (PATCH /tenders/{tenderId}/lots/{lotId}).
+func (c *Controller) EditLot(ctx echo.Context, tenderID TenderId, lotID LotId, params EditLotParams) error {
+	var body EditLotJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	var lot models.Lot
+	if body.Name != nil {
+		lot.Name = *body.Name
+	}
+	if body.ServiceType != nil {
+		lot.ServiceType = models.ServiceType(*body.ServiceType)
+	}
+	if body.Quantity != nil {
+		lot.Quantity = *body.Quantity
+	}
+	if body.Budget != nil {
+		lot.Budget = float64(*body.Budget)
+	}
+
+	editedLot, err := c.tenderService.EditLot(ctx.Request(), tenderID, lotID, &lot, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, editedLot)
+	return nil
+}
+
+// AwardLot (PUT /tenders/{tenderId}/lots/{lotId}/award).
+func (c *Controller) AwardLot(ctx echo.Context, tenderID TenderId, lotID LotId, params AwardLotParams) error {
+	lot, err := c.tenderService.AwardLot(ctx.Request(), tenderID, lotID, params.BidId, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, lot)
+	return nil
+}
+
+// CancelLot (PUT /tenders/{tenderId}/lots/{lotId}/cancel).
+func (c *Controller) CancelLot(ctx echo.Context, tenderID TenderId, lotID LotId, params CancelLotParams) error {
+	lot, err := c.tenderService.CancelLot(ctx.Request(), tenderID, lotID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, lot)
+	return nil
+}
@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// decodeTerms Разбирает условия раунда переговоров из тела запроса.
+func (c *Controller) decodeTerms(ctx echo.Context, bidID BidId) (models.NegotiationRound, error) {
+	var body NegotiationTerms
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	parsedBidID, err := uuid.Parse(bidID)
+	if err != nil {
+		return models.NegotiationRound{}, InternalError(ctx, err)
+	}
+
+	return models.NegotiationRound{
+		BidID: parsedBidID,
+		Price: body.Price,
+		Terms: body.Terms,
+	}, nil
+}
+
+// ProposeTerms (POST /bids/{bidId}/negotiation).
+func (c *Controller) ProposeTerms(ctx echo.Context, bidID BidId, params ProposeTermsParams) error {
+	round, err := c.decodeTerms(ctx, bidID)
+	if err != nil {
+		return err
+	}
+
+	newRound, err := c.bidService.ProposeTerms(ctx.Request(), &round, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newRound)
+	return nil
+}
+
+// GetNegotiation (GET /bids/{bidId}/negotiation).
+func (c *Controller) GetNegotiation(ctx echo.Context, bidID BidId, params GetNegotiationParams) error {
+	rounds, err := c.bidService.GetNegotiation(ctx.Request(), bidID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, rounds)
+	return nil
+}
+
+// AcceptTerms (PUT /bids/{bidId}/negotiation/accept).
+func (c *Controller) AcceptTerms(ctx echo.Context, bidID BidId, params AcceptTermsParams) error {
+	round, err := c.bidService.AcceptTerms(ctx.Request(), bidID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, round)
+	return nil
+}
+
+// CounterTerms (PUT /bids/{bidId}/negotiation/counter).
+func (c *Controller) CounterTerms(ctx echo.Context, bidID BidId, params CounterTermsParams) error {
+	counter, err := c.decodeTerms(ctx, bidID)
+	if err != nil {
+		return err
+	}
+
+	newRound, err := c.bidService.CounterTerms(ctx.Request(), &counter, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newRound)
+	return nil
+}
+
+// RejectTerms (PUT /bids/{bidId}/negotiation/reject).
+func (c *Controller) RejectTerms(ctx echo.Context, bidID BidId, params RejectTermsParams) error {
+	round, err := c.bidService.RejectTerms(ctx.Request(), bidID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, round)
+	return nil
+}
+
+// GetBidHistory (GET /bids/{bidId}/history).
+func (c *Controller) GetBidHistory(ctx echo.Context, bidID BidId, params GetBidHistoryParams) error {
+	versions, err := c.bidService.GetBidHistory(ctx.Request(), bidID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, versions)
+	return nil
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// AddCertificate (POST /organizations/{organizationId}/certificates).
+func (c *Controller) AddCertificate(ctx echo.Context, organizationID OrganizationId, params AddCertificateParams) error {
+	var body AddCertificateJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	parsedOrganizationID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	certificate := models.Certificate{
+		OrganizationID: parsedOrganizationID,
+		Name:           body.Name,
+	}
+	if body.ValidUntil != nil {
+		certificate.ValidUntil = &body.ValidUntil.Time
+	}
+
+	newCertificate, err := c.organizationService.AddCertificate(ctx.Request(), &certificate, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newCertificate)
+	return nil
+}
+
+// GetCertificates (GET /organizations/{organizationId}/certificates).
+func (c *Controller) GetCertificates(ctx echo.Context, organizationID OrganizationId, params GetCertificatesParams) error {
+	certificates, err := c.organizationService.GetCertificates(ctx.Request(), organizationID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, certificates)
+	return nil
+}
+
+// VerifyCertificate (PUT /organizations/{organizationId}/certificates/{certificateId}/verify).
+func (c *Controller) VerifyCertificate(ctx echo.Context, organizationID OrganizationId, certificateID CertificateId, params VerifyCertificateParams) error {
+	certificate, err := c.organizationService.VerifyCertificate(ctx.Request(), organizationID, certificateID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, certificate)
+	return nil
+}
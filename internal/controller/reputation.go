@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+// GetAuthorReputation (GET /reputation/authors/{authorUsername}).
+func (c *Controller) GetAuthorReputation(ctx echo.Context, authorUsername Username, params GetAuthorReputationParams) error {
+	reputation, err := c.bidService.GetAuthorReputation(ctx.Request(), authorUsername, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, reputation)
+	return nil
+}
+
+// GetOrganizationReputation (GET /reputation/organizations/{organizationId}).
+func (c *Controller) GetOrganizationReputation(ctx echo.Context, organizationID OrganizationId, params GetOrganizationReputationParams) error {
+	reputation, err := c.bidService.GetOrganizationReputation(ctx.Request(), organizationID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, reputation)
+	return nil
+}
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// ReplyToReview (PUT /reviews/{reviewId}/reply).
+func (c *Controller) ReplyToReview(ctx echo.Context, reviewID BidReviewId, params ReplyToReviewParams) error {
+	var body ReplyToReviewJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	review, err := c.reviewService.ReplyToReview(ctx.Request(), reviewID, body.Reply, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, review)
+	return nil
+}
+
+// EditReview (PATCH /reviews/{reviewId}/edit).
+func (c *Controller) EditReview(ctx echo.Context, reviewID BidReviewId, params EditReviewParams) error {
+	var body EditReviewJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	edit := models.Review{Completed: body.Completed}
+	if body.Description != nil {
+		edit.Description = *body.Description
+	}
+	if body.Rating != nil {
+		rating := int(*body.Rating)
+		edit.Rating = &rating
+	}
+
+	review, err := c.reviewService.EditReview(ctx.Request(), reviewID, &edit, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, review)
+	return nil
+}
+
+// GetReviewHistory (GET /reviews/{reviewId}/history).
+func (c *Controller) GetReviewHistory(ctx echo.Context, reviewID BidReviewId, params GetReviewHistoryParams) error {
+	revisions, err := c.reviewService.GetReviewHistory(ctx.Request(), reviewID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, revisions)
+	return nil
+}
+
+// HideReview (PUT /reviews/{reviewId}/hide).
+func (c *Controller) HideReview(ctx echo.Context, reviewID BidReviewId, params HideReviewParams) error {
+	var body HideReviewJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	review, err := c.reviewService.HideReview(ctx.Request(), reviewID, body.Reason, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, review)
+	return nil
+}
+
+// RestoreReview (PUT /reviews/{reviewId}/restore).
+func (c *Controller) RestoreReview(ctx echo.Context, reviewID BidReviewId, params RestoreReviewParams) error {
+	review, err := c.reviewService.RestoreReview(ctx.Request(), reviewID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, review)
+	return nil
+}
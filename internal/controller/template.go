@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+func templateCriteria(items []TemplateCriterion) []models.TemplateCriterion {
+	criteria := make([]models.TemplateCriterion, len(items))
+	for i, item := range items {
+		criteria[i] = models.TemplateCriterion{Name: item.Name, Weight: float64(item.Weight)}
+		if item.MaxScore != nil {
+			criteria[i].MaxScore = *item.MaxScore
+		}
+	}
+
+	return criteria
+}
+
+func organizationTypes(items []OrganizationType) []models.OrganizationType {
+	types := make([]models.OrganizationType, len(items))
+	for i, t := range items {
+		types[i] = models.OrganizationType(t)
+	}
+
+	return types
+}
+
+// CreateTemplate (POST /organizations/{organizationId}/templates).
+func (c *Controller) CreateTemplate(ctx echo.Context, organizationID OrganizationId, params CreateTemplateParams) error {
+	var body CreateTemplateJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	parsedOrganizationID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	template := models.TenderTemplate{
+		OrganizationID: parsedOrganizationID,
+		Name:           body.Name,
+		ServiceType:    models.ServiceType(body.ServiceType),
+		Certificate:    body.Certificate,
+	}
+	if body.Description != nil {
+		template.Description = *body.Description
+	}
+	if body.Criteria != nil {
+		template.Criteria = templateCriteria(*body.Criteria)
+	}
+	if body.OrganizationTypes != nil {
+		template.OrganizationTypes = organizationTypes(*body.OrganizationTypes)
+	}
+	if body.MinCompletedContracts != nil {
+		template.MinCompletedContracts = *body.MinCompletedContracts
+	}
+
+	newTemplate, err := c.tenderService.CreateTemplate(ctx.Request(), &template, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, newTemplate)
+	return nil
+}
+
+// GetTemplates (GET /organizations/{organizationId}/templates).
+func (c *Controller) GetTemplates(ctx echo.Context, organizationID OrganizationId, params GetTemplatesParams) error {
+	var offset, limit int32 = 0, 5
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	templates, err := c.tenderService.GetTemplates(ctx.Request(), organizationID, params.Username, offset, limit)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, templates)
+	return nil
+}
+
+// GetTemplate (GET /templates/{templateId}).
+func (c *Controller) GetTemplate(ctx echo.Context, templateID TemplateId, params GetTemplateParams) error {
+	template, err := c.tenderService.GetTemplate(ctx.Request(), templateID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, template)
+	return nil
+}
+
+// EditTemplate (PATCH /templates/{templateId}/edit).
+func (c *Controller) EditTemplate(ctx echo.Context, templateID TemplateId, params EditTemplateParams) error {
+	var body EditTemplateJSONBody
+	if err := c.decodeJSONBody(ctx, &body); err != nil {
+		return err
+	}
+
+	changes := models.TemplateChanges{
+		Name:                  body.Name,
+		Description:           body.Description,
+		MinCompletedContracts: body.MinCompletedContracts,
+		Certificate:           body.Certificate,
+	}
+	if body.ServiceType != nil {
+		serviceType := models.ServiceType(*body.ServiceType)
+		changes.ServiceType = &serviceType
+	}
+	if body.Criteria != nil {
+		changes.Criteria = templateCriteria(*body.Criteria)
+	}
+	if body.OrganizationTypes != nil {
+		changes.OrganizationTypes = organizationTypes(*body.OrganizationTypes)
+	}
+
+	template, err := c.tenderService.EditTemplate(ctx.Request(), templateID, &changes, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, template)
+	return nil
+}
+
+// RollbackTemplate (PUT /templates/{templateId}/rollback/{version}).
+func (c *Controller) RollbackTemplate(ctx echo.Context, templateID TemplateId, version int32, params RollbackTemplateParams) error {
+	template, err := c.tenderService.RollbackTemplate(ctx.Request(), templateID, version, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, template)
+	return nil
+}
+
+// CreateTenderFromTemplate (POST /templates/{templateId}/tender).
+func (c *Controller) CreateTenderFromTemplate(ctx echo.Context, templateID TemplateId, params CreateTenderFromTemplateParams) error {
+	tender, err := c.tenderService.CreateTenderFromTemplate(ctx.Request(), templateID, params.Username)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, tender)
+	return nil
+}
+
+// CloneTender (POST /tenders/{tenderId}/clone).
+func (c *Controller) CloneTender(ctx echo.Context, tenderID TenderId, params CloneTenderParams) error {
+	tender, err := c.tenderService.CloneTender(ctx.Request(), tenderID, params.Username, params.OpeningAt)
+	if err != nil {
+		return InternalError(ctx, err)
+	}
+
+	ctx.JSON(http.StatusOK, tender)
+	return nil
+}
@@ -30,7 +30,12 @@ func (c *Controller) GetTenders(ctx echo.Context, params GetTendersParams) error
 		}
 	}
 
-	tenders, err := c.tenderService.GetTenders(ctx.Request(), offset, limit, serviceTypes)
+	var username string
+	if params.Username != nil {
+		username = *params.Username
+	}
+
+	tenders, err := c.tenderService.GetTenders(ctx.Request(), offset, limit, serviceTypes, username)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Reason: err.Error()})
 		return err
@@ -57,6 +62,11 @@ func (c *Controller) CreateTender(ctx echo.Context) error {
 
 	newTender, err := c.tenderService.CreateTender(ctx.Request(), &tender)
 	if err != nil {
+		// Ошибки параметров тендера отдаются как 400, остальные по-прежнему как 401.
+		var customErr util.MyResponseError
+		if errors.As(err, &customErr) && customErr.Status == http.StatusBadRequest {
+			return InternalError(ctx, err)
+		}
 		ctx.JSON(http.StatusUnauthorized, ErrorResponse{Reason: err.Error()})
 		return err
 	}
@@ -77,8 +87,7 @@ func (c *Controller) GetUserTenders(ctx echo.Context, params GetUserTendersParam
 
 	tenders, err := c.tenderService.GetUserTenders(ctx.Request(), offset, limit, *params.Username)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Reason: err.Error()})
-		return err
+		return InternalError(ctx, err)
 	}
 
 	ctx.JSON(http.StatusOK, tenders)
@@ -89,8 +98,7 @@ func (c *Controller) GetUserTenders(ctx echo.Context, params GetUserTendersParam
 func (c *Controller) GetTenderStatus(ctx echo.Context, tenderID TenderId, params GetTenderStatusParams) error {
 	tender, err := c.tenderService.GetTenderStatus(ctx.Request(), tenderID, *params.Username)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Reason: err.Error()})
-		return err
+		return InternalError(ctx, err)
 	}
 
 	ctx.JSON(http.StatusOK, tender)
@@ -101,8 +109,7 @@ func (c *Controller) GetTenderStatus(ctx echo.Context, tenderID TenderId, params
 func (c *Controller) UpdateTenderStatus(ctx echo.Context, tenderID TenderId, params UpdateTenderStatusParams) error {
 	status, err := c.tenderService.UpdateTenderStatus(ctx.Request(), tenderID, string(params.Status), params.Username)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Reason: err.Error()})
-		return err
+		return InternalError(ctx, err)
 	}
 
 	ctx.JSON(http.StatusOK, status)
@@ -125,8 +132,7 @@ func (c *Controller) EditTender(ctx echo.Context, tenderID TenderId, params Edit
 
 	newTender, err := c.tenderService.EditTender(ctx.Request(), &tender, tenderID, params.Username)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Reason: err.Error()})
-		return err
+		return InternalError(ctx, err)
 	}
 
 	ctx.JSON(http.StatusOK, newTender)
@@ -137,8 +143,7 @@ func (c *Controller) EditTender(ctx echo.Context, tenderID TenderId, params Edit
 func (c *Controller) RollbackTender(ctx echo.Context, tenderID TenderId, version int32, params RollbackTenderParams) error {
 	newTender, err := c.tenderService.RollbackTender(ctx.Request(), tenderID, version, params.Username)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Reason: err.Error()})
-		return err
+		return InternalError(ctx, err)
 	}
 
 	ctx.JSON(http.StatusOK, newTender)
@@ -0,0 +1,94 @@
+package debarment
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/storage"
+)
+
+const (
+	batchSize    = 100
+	expirerActor = "system"
+	expireAction = "EXPIRE debarment"
+)
+
+type Store interface {
+	storage.Debarment
+	storage.Outbox
+	storage.Transactor
+}
+
+type Auditor interface {
+	Record(ctx context.Context, entry *models.AuditEntry) error
+}
+
+// Expirer Периодически отмечает истёкшие отстранения, пишет событие и запись аудита.
+// Отстранение перестаёт действовать в EndsAt независимо от работы Expirer.
+type Expirer struct {
+	storage   Store
+	auditor   Auditor
+	zapLogger *zap.SugaredLogger
+	interval  time.Duration
+}
+
+func NewExpirer(s Store, a Auditor, l *zap.SugaredLogger, cfg *config.DebarmentConfig) *Expirer {
+	return &Expirer{
+		storage:   s,
+		auditor:   a,
+		zapLogger: l,
+		interval:  cfg.ExpireInterval,
+	}
+}
+
+func (e *Expirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.expireDue(ctx); err != nil {
+				e.zapLogger.Errorf("debarment expirer: %v", err)
+			}
+		}
+	}
+}
+
+func (e *Expirer) expireDue(ctx context.Context) error {
+	return e.storage.WithTx(ctx, func(ctx context.Context) error {
+		expired, err := e.storage.ExpireDebarments(ctx, time.Now().UTC(), batchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range expired {
+			var event models.Event
+			event, err = models.NewEvent(models.DebarmentExpired, models.DebarmentAggregate, expired[i].ID, expirerActor, "", expired[i])
+			if err != nil {
+				return err
+			}
+			if err = e.storage.AppendEvent(ctx, &event); err != nil {
+				return err
+			}
+
+			entry := models.AuditEntry{
+				Actor:   expirerActor,
+				Action:  expireAction,
+				Target:  expired[i].ID.String(),
+				Outcome: models.AuditSuccess,
+				Status:  http.StatusOK,
+			}
+			if err = e.auditor.Record(ctx, &entry); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AttachmentEntity string
+
+const (
+	TenderAttachment AttachmentEntity = "Tender"
+	BidAttachment    AttachmentEntity = "Bid"
+)
+
+// Attachment Файл тендера или предложения. Виден в версиях сущности [VersionFrom, VersionTo).
+type Attachment struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	EntityType  AttachmentEntity `db:"entity_type" json:"entityType"`
+	EntityID    uuid.UUID        `db:"entity_id" json:"entityId"`
+	FileName    string           `db:"file_name" json:"fileName"`
+	ContentType string           `db:"content_type" json:"contentType"`
+	Size        int64            `db:"size" json:"size"`
+	Checksum    string           `db:"checksum" json:"checksum"`
+	StorageKey  string           `db:"storage_key" json:"-"`
+	UploadedBy  string           `db:"uploaded_by" json:"uploadedBy"`
+	VersionFrom int              `db:"version_from" json:"versionFrom"`
+	VersionTo   *int             `db:"version_to" json:"versionTo,omitempty"`
+	CreatedAt   *time.Time       `db:"created_at" json:"createdAt"`
+}
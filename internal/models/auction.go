@@ -0,0 +1,65 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AuctionStatus string
+
+const (
+	AuctionStatusScheduled AuctionStatus = "Scheduled"
+	AuctionStatusRunning   AuctionStatus = "Running"
+	AuctionStatusClosed    AuctionStatus = "Closed"
+)
+
+// Auction Редукцион по тендеру: участники снижают цену, пока не истечёт время.
+// Цена, поданная за ExtensionSeconds до окончания, продлевает аукцион на ExtensionSeconds.
+type Auction struct {
+	TenderID         uuid.UUID     `db:"tender_id" json:"tenderId"`
+	StartsAt         time.Time     `db:"starts_at" json:"startsAt"`
+	EndsAt           time.Time     `db:"ends_at" json:"endsAt"`
+	DurationSeconds  int           `db:"duration_seconds" json:"durationSeconds"`
+	ExtensionSeconds int           `db:"extension_seconds" json:"extensionSeconds"`
+	MinStep          float64       `db:"min_step" json:"minStep"`
+	StartPrice       *float64      `db:"start_price" json:"startPrice,omitempty"`
+	BestPrice        *float64      `db:"best_price" json:"bestPrice,omitempty"`
+	WinnerBidID      uuid.NullUUID `db:"winner_bid_id" json:"winnerBidId,omitempty"`
+	ClosedAt         *time.Time    `db:"closed_at" json:"closedAt,omitempty"`
+	CreatedAt        *time.Time    `db:"created_at" json:"createdAt"`
+	Status           AuctionStatus `db:"-" json:"status"`
+}
+
+// StatusAt Аукцион считается закрытым сразу по истечении времени, даже если фоновое закрытие ещё не прошло.
+func (a *Auction) StatusAt(now time.Time) AuctionStatus {
+	switch {
+	case a.ClosedAt != nil || !now.Before(a.EndsAt):
+		return AuctionStatusClosed
+	case now.Before(a.StartsAt):
+		return AuctionStatusScheduled
+	default:
+		return AuctionStatusRunning
+	}
+}
+
+type AuctionPrice struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	TenderID  uuid.UUID  `db:"tender_id" json:"tenderId"`
+	BidID     uuid.UUID  `db:"bid_id" json:"bidId"`
+	Price     float64    `db:"price" json:"price"`
+	CreatedAt *time.Time `db:"created_at" json:"createdAt"`
+}
+
+// AuctionRank Лучшая цена участника. Участникам рейтинг отдаётся без BidID, только с отметкой Own.
+type AuctionRank struct {
+	Rank  int           `db:"rank" json:"rank"`
+	BidID uuid.NullUUID `db:"bid_id" json:"bidId,omitempty"`
+	Price float64       `db:"price" json:"price"`
+	Own   bool          `db:"-" json:"own,omitempty"`
+}
+
+// AuctionState Состояние аукциона вместе с текущим рейтингом.
+type AuctionState struct {
+	Auction
+	Ranking []AuctionRank `json:"ranking"`
+}
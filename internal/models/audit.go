@@ -0,0 +1,75 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/google/uuid"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AuditOutcome string
+
+const (
+	AuditSuccess AuditOutcome = "Success"
+	AuditDenied  AuditOutcome = "Denied"
+	AuditFailed  AuditOutcome = "Failed"
+)
+
+// AuditEntry Запись журнала аудита. Hash считается от полей записи и PrevHash,
+// поэтому изменение любой записи ломает цепочку. Verified - хеш сходится с содержимым записи,
+// Linked - PrevHash совпадает с хешем предыдущей записи в порядке добавления.
+type AuditEntry struct {
+	ID             uuid.UUID     `db:"id" json:"id"`
+	Actor          string        `db:"actor" json:"actor"`
+	OrganizationID uuid.NullUUID `db:"organization_id" json:"organizationId,omitempty"`
+	RequestID      string        `db:"request_id" json:"requestId"`
+	ClientIP       string        `db:"client_ip" json:"clientIp"`
+	Action         string        `db:"action" json:"action"`
+	Target         string        `db:"target" json:"target,omitempty"`
+	Outcome        AuditOutcome  `db:"outcome" json:"outcome"`
+	Status         int           `db:"status" json:"status"`
+	PrevHash       string        `db:"prev_hash" json:"prevHash"`
+	Hash           string        `db:"hash" json:"hash"`
+	CreatedAt      time.Time     `db:"created_at" json:"createdAt"`
+	ChainPrevHash  string        `db:"chain_prev_hash" json:"-"`
+	Verified       bool          `db:"-" json:"verified"`
+	Linked         bool          `db:"-" json:"linked"`
+}
+
+func (a *AuditEntry) ComputeHash() string {
+	org := ""
+	if a.OrganizationID.Valid {
+		org = a.OrganizationID.UUID.String()
+	}
+
+	fields := []string{
+		a.PrevHash,
+		a.ID.String(),
+		a.Actor,
+		org,
+		a.RequestID,
+		a.ClientIP,
+		a.Action,
+		a.Target,
+		string(a.Outcome),
+		strconv.Itoa(a.Status),
+		a.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
+
+func AuditOutcomeFromStatus(status int) AuditOutcome {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return AuditDenied
+	case status >= http.StatusBadRequest:
+		return AuditFailed
+	default:
+		return AuditSuccess
+	}
+}
@@ -0,0 +1,41 @@
+package models
+
+type BatchOp string
+
+const (
+	BatchCreate  BatchOp = "create"
+	BatchPublish BatchOp = "publish"
+	BatchClose   BatchOp = "close"
+	BatchDecide  BatchOp = "decide"
+)
+
+// TenderOperation Операция пакета над тендерами. Для create задаётся Tender, для остальных - TenderID.
+type TenderOperation struct {
+	Op       BatchOp
+	TenderID string
+	Tender   *Tender
+}
+
+// BidOperation Операция пакета над предложениями.
+type BidOperation struct {
+	Op       BatchOp
+	BidID    string
+	Decision BidDecision
+}
+
+// BatchItemResult Итог одной операции пакета. При ошибке Status и Error повторяют ответ одиночного запроса.
+type BatchItemResult struct {
+	Index   int    `json:"index"`
+	Success bool   `json:"success"`
+	Status  int    `json:"status"`
+	Error   string `json:"error,omitempty"`
+	Result  any    `json:"result,omitempty"`
+}
+
+// BatchResult Итог пакета. Committed показывает, сохранены ли успешные операции: в атомарном режиме
+// одна ошибка отменяет весь пакет.
+type BatchResult struct {
+	Atomic    bool              `json:"atomic"`
+	Committed bool              `json:"committed"`
+	Results   []BatchItemResult `json:"results"`
+}
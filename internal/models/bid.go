@@ -9,6 +9,11 @@ type BidStatus string
 
 type BidDecision string
 
+const (
+	DecisionApproved BidDecision = "Approved"
+	DecisionRejected BidDecision = "Rejected"
+)
+
 type AuthorType string
 
 const (
@@ -17,20 +22,31 @@ const (
 )
 
 type Bid struct {
-	ID             uuid.UUID     `db:"id" json:"id"`
-	Name           string        `db:"name" json:"name"`
-	Description    string        `db:"description" json:"description"`
-	Feedback       *string       `db:"feedback" json:"feedback,omitempty"`
-	Status         BidStatus     `db:"status" json:"status"`
-	TenderID       uuid.UUID     `db:"tender_id" json:"tenderId"`
-	OrganizationID uuid.NullUUID `db:"organization_id" json:"organizationId,omitempty"`
-	Decision       BidDecision   `db:"decision" json:"decision,omitempty"`
-	AuthorID       uuid.UUID     `db:"author_id" json:"authorId"`
-	AuthorUsername string        `db:"author_username" json:"authorUsername,omitempty"`
-	AuthorType     AuthorType    `db:"author_type" json:"authorType"`
-	Version        int           `db:"version" json:"version"`
-	CreatedAt      *time.Time    `db:"created_at"`
-	UpdatedAt      *time.Time    `db:"updated_at"`
+	ID                 uuid.UUID        `db:"id" json:"id"`
+	Name               string           `db:"name" json:"name"`
+	Description        string           `db:"description" json:"description"`
+	Feedback           *string          `db:"feedback" json:"feedback,omitempty"`
+	Status             BidStatus        `db:"status" json:"status"`
+	TenderID           uuid.UUID        `db:"tender_id" json:"tenderId"`
+	OrganizationID     uuid.NullUUID    `db:"organization_id" json:"organizationId,omitempty"`
+	Decision           BidDecision      `db:"decision" json:"decision,omitempty"`
+	AuthorID           uuid.UUID        `db:"author_id" json:"authorId"`
+	AuthorUsername     string           `db:"author_username" json:"authorUsername,omitempty"`
+	AuthorType         AuthorType       `db:"author_type" json:"authorType"`
+	Version            int              `db:"version" json:"version"`
+	Price              *float64         `db:"price" json:"price,omitempty"`
+	TechnicalProposal  *string          `db:"technical_proposal" json:"technicalProposal,omitempty"`
+	CommercialProposal *string          `db:"commercial_proposal" json:"commercialProposal,omitempty"`
+	Qualification      BidQualification `db:"qualification" json:"qualification,omitempty"`
+	Debarred           bool             `db:"debarred" json:"debarred,omitempty"`
+	AuthorReputation   *Reputation      `db:"-" json:"authorReputation,omitempty"`
+	Sealed             bool             `db:"sealed" json:"sealed,omitempty"`
+	SealedPayload      []byte           `db:"sealed_payload" json:"-"`
+	TenderVersion      int              `db:"tender_version" json:"tenderVersion,omitempty"`
+	PreClarification   bool             `db:"pre_clarification" json:"preClarification,omitempty"`
+	LotIDs             []uuid.UUID      `db:"-" json:"lotIds,omitempty"`
+	CreatedAt          *time.Time       `db:"created_at"`
+	UpdatedAt          *time.Time       `db:"updated_at"`
 }
 
 type BidHistory struct {
@@ -49,4 +65,4 @@ type BidHistory struct {
 	Version        int           `db:"version"`
 	CreatedAt      *time.Time    `db:"created_at"`
 	UpdatedAt      *time.Time    `db:"updated_at"`
-}
\ No newline at end of file
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ClarificationVisibility string
+
+const (
+	PrivateClarification ClarificationVisibility = "Private"
+	PublicClarification  ClarificationVisibility = "Public"
+)
+
+// Clarification Вопрос участника по опубликованному тендеру и ответ Ответственного.
+// TenderVersion заполняется, если публикация ответа создала новую версию тендера.
+type Clarification struct {
+	ID             uuid.UUID               `db:"id" json:"id"`
+	TenderID       uuid.UUID               `db:"tender_id" json:"tenderId"`
+	AuthorUsername string                  `db:"author_username" json:"authorUsername"`
+	Question       string                  `db:"question" json:"question"`
+	Answer         *string                 `db:"answer" json:"answer,omitempty"`
+	AnsweredBy     *string                 `db:"answered_by" json:"answeredBy,omitempty"`
+	Visibility     ClarificationVisibility `db:"visibility" json:"visibility"`
+	TenderVersion  *int                    `db:"tender_version" json:"tenderVersion,omitempty"`
+	CreatedAt      *time.Time              `db:"created_at" json:"createdAt"`
+	AnsweredAt     *time.Time              `db:"answered_at" json:"answeredAt,omitempty"`
+}
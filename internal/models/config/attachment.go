@@ -0,0 +1,7 @@
+package config
+
+type AttachmentConfig struct {
+	Dir       string   `env:"ATTACHMENT_DIR"`
+	MaxSize   int64    `env:"ATTACHMENT_MAX_SIZE"`
+	MIMETypes []string `env:"ATTACHMENT_MIME_TYPES"`
+}
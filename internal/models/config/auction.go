@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type AuctionConfig struct {
+	CloseInterval  time.Duration `env:"AUCTION_CLOSE_INTERVAL"`
+	StreamInterval time.Duration `env:"AUCTION_STREAM_INTERVAL"`
+}
@@ -0,0 +1,5 @@
+package config
+
+type BatchConfig struct {
+	MaxOperations int `env:"BATCH_MAX_OPERATIONS"`
+}
@@ -0,0 +1,7 @@
+package config
+
+import "time"
+
+type DebarmentConfig struct {
+	ExpireInterval time.Duration `env:"DEBARMENT_EXPIRE_INTERVAL"`
+}
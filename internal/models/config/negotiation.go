@@ -0,0 +1,5 @@
+package config
+
+type NegotiationConfig struct {
+	MaxRounds int `env:"NEGOTIATION_MAX_ROUNDS"`
+}
@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type OutboxConfig struct {
+	Interval  time.Duration `env:"OUTBOX_INTERVAL"`
+	BatchSize int32         `env:"OUTBOX_BATCH_SIZE"`
+}
@@ -0,0 +1,12 @@
+package config
+
+import "slices"
+
+type PlatformConfig struct {
+	Admins []string `env:"PLATFORM_ADMINS"`
+}
+
+// IsAdmin Администраторы площадки подтверждают сертификаты организаций, отстраняют поставщиков и модерируют отзывы.
+func (c *PlatformConfig) IsAdmin(username string) bool {
+	return username != "" && slices.Contains(c.Admins, username)
+}
@@ -0,0 +1,7 @@
+package config
+
+import "time"
+
+type ReviewConfig struct {
+	EditWindow time.Duration `env:"REVIEW_EDIT_WINDOW"`
+}
@@ -0,0 +1,5 @@
+package config
+
+type SealingConfig struct {
+	KeyFile string `env:"SEALED_BID_KEY_FILE"`
+}
@@ -8,4 +8,5 @@ type ServerConfig struct {
 	ReadTimeout     time.Duration `env:"READ_TIMEOUT"`
 	IdleTimeout     time.Duration `env:"IDLE_TIMEOUT"`
 	GracefulTimeout time.Duration `env:"GRACEFUL_TIMEOUT"`
+	DrainTimeout    time.Duration `env:"DRAIN_TIMEOUT"`
 }
\ No newline at end of file
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ContractStatus string
+
+const (
+	ContractStatusActive     ContractStatus = "Active"
+	ContractStatusCompleted  ContractStatus = "Completed"
+	ContractStatusTerminated ContractStatus = "Terminated"
+)
+
+type MilestoneStatus string
+
+const (
+	MilestoneStatusPending    MilestoneStatus = "Pending"
+	MilestoneStatusInProgress MilestoneStatus = "InProgress"
+	MilestoneStatusSubmitted  MilestoneStatus = "Submitted"
+	MilestoneStatusAccepted   MilestoneStatus = "Accepted"
+	MilestoneStatusRejected   MilestoneStatus = "Rejected"
+)
+
+// Contract Контракт по выигравшему предложению между организацией тендера и автором предложения.
+type Contract struct {
+	ID               uuid.UUID      `db:"id" json:"id"`
+	TenderID         uuid.UUID      `db:"tender_id" json:"tenderId"`
+	BidID            uuid.UUID      `db:"bid_id" json:"bidId"`
+	OrganizationID   uuid.UUID      `db:"organization_id" json:"organizationId"`
+	SupplierUsername string         `db:"supplier_username" json:"supplierUsername"`
+	Amount           *float64       `db:"amount" json:"amount,omitempty"`
+	Status           ContractStatus `db:"status" json:"status"`
+	CreatedBy        *string        `db:"created_by" json:"createdBy,omitempty"`
+	ClosedBy         *string        `db:"closed_by" json:"closedBy,omitempty"`
+	ClosedAt         *time.Time     `db:"closed_at" json:"closedAt,omitempty"`
+	ReviewID         uuid.NullUUID  `db:"review_id" json:"reviewId,omitempty"`
+	CreatedAt        *time.Time     `db:"created_at" json:"createdAt"`
+	UpdatedAt        *time.Time     `db:"updated_at" json:"updatedAt,omitempty"`
+}
+
+// Milestone Этап контракта: результат, срок и сумма. Поставщик ведёт этап до сдачи, заказчик принимает или отклоняет.
+type Milestone struct {
+	ID              uuid.UUID       `db:"id" json:"id"`
+	ContractID      uuid.UUID       `db:"contract_id" json:"contractId"`
+	Deliverable     string          `db:"deliverable" json:"deliverable"`
+	Description     *string         `db:"description" json:"description,omitempty"`
+	DueDate         time.Time       `db:"due_date" json:"dueDate"`
+	Amount          float64         `db:"amount" json:"amount"`
+	Status          MilestoneStatus `db:"status" json:"status"`
+	SupplierComment *string         `db:"supplier_comment" json:"supplierComment,omitempty"`
+	DecisionComment *string         `db:"decision_comment" json:"decisionComment,omitempty"`
+	DecidedBy       *string         `db:"decided_by" json:"decidedBy,omitempty"`
+	DecidedAt       *time.Time      `db:"decided_at" json:"decidedAt,omitempty"`
+	CreatedAt       *time.Time      `db:"created_at" json:"createdAt"`
+	UpdatedAt       *time.Time      `db:"updated_at" json:"updatedAt,omitempty"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Debarment Отстранение поставщика от участия в тендерах. Без OrganizationID действует на всей площадке,
+// иначе - только в тендерах этой организации. Перестаёт действовать в EndsAt или при досрочном снятии.
+type Debarment struct {
+	ID                     uuid.UUID     `db:"id" json:"id"`
+	OrganizationID         uuid.NullUUID `db:"organization_id" json:"organizationId,omitempty"`
+	SupplierOrganizationID uuid.NullUUID `db:"supplier_organization_id" json:"supplierOrganizationId,omitempty"`
+	SupplierUsername       *string       `db:"supplier_username" json:"supplierUsername,omitempty"`
+	Reason                 string        `db:"reason" json:"reason"`
+	StartsAt               time.Time     `db:"starts_at" json:"startsAt"`
+	EndsAt                 time.Time     `db:"ends_at" json:"endsAt"`
+	ApprovedBy             *string       `db:"approved_by" json:"approvedBy,omitempty"`
+	LiftedBy               *string       `db:"lifted_by" json:"liftedBy,omitempty"`
+	LiftedAt               *time.Time    `db:"lifted_at" json:"liftedAt,omitempty"`
+	ExpiredAt              *time.Time    `db:"expired_at" json:"expiredAt,omitempty"`
+	CreatedAt              *time.Time    `db:"created_at" json:"createdAt"`
+}
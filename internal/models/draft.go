@@ -0,0 +1,77 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// BidDraft Черновик правки предложения. Сохраняется без новой версии и публикуется одной правкой.
+// Незаданные поля при публикации не меняются. BaseVersion - версия предложения при последнем сохранении,
+// CurrentVersion - текущая: если они разошлись, черновик устарел.
+type BidDraft struct {
+	BidID              uuid.UUID  `db:"bid_id" json:"bidId"`
+	Name               *string    `db:"name" json:"name,omitempty"`
+	Description        *string    `db:"description" json:"description,omitempty"`
+	Price              *float64   `db:"price" json:"price,omitempty"`
+	TechnicalProposal  *string    `db:"technical_proposal" json:"technicalProposal,omitempty"`
+	CommercialProposal *string    `db:"commercial_proposal" json:"commercialProposal,omitempty"`
+	BaseVersion        int        `db:"base_version" json:"baseVersion"`
+	CurrentVersion     int        `db:"current_version" json:"currentVersion"`
+	SavedBy            *string    `db:"saved_by" json:"savedBy,omitempty"`
+	SavedAt            *time.Time `db:"saved_at" json:"savedAt"`
+}
+
+// Empty Сообщает, что черновик ничего не меняет.
+func (d *BidDraft) Empty() bool {
+	return d.Name == nil && d.Description == nil && d.Price == nil && d.TechnicalProposal == nil && d.CommercialProposal == nil
+}
+
+// Changes Черновик в виде правки, которую принимает EditBid.
+func (d *BidDraft) Changes() Bid {
+	bid := Bid{
+		Price:              d.Price,
+		TechnicalProposal:  d.TechnicalProposal,
+		CommercialProposal: d.CommercialProposal,
+	}
+	if d.Name != nil {
+		bid.Name = *d.Name
+	}
+	if d.Description != nil {
+		bid.Description = *d.Description
+	}
+
+	return bid
+}
+
+// TenderDraft Черновик правки тендера. Устроен так же, как BidDraft.
+type TenderDraft struct {
+	TenderID       uuid.UUID    `db:"tender_id" json:"tenderId"`
+	Name           *string      `db:"name" json:"name,omitempty"`
+	Description    *string      `db:"description" json:"description,omitempty"`
+	ServiceType    *ServiceType `db:"service_type" json:"serviceType,omitempty"`
+	BaseVersion    int          `db:"base_version" json:"baseVersion"`
+	CurrentVersion int          `db:"current_version" json:"currentVersion"`
+	SavedBy        *string      `db:"saved_by" json:"savedBy,omitempty"`
+	SavedAt        *time.Time   `db:"saved_at" json:"savedAt"`
+}
+
+// Empty Сообщает, что черновик ничего не меняет.
+func (d *TenderDraft) Empty() bool {
+	return d.Name == nil && d.Description == nil && d.ServiceType == nil
+}
+
+// Changes Черновик в виде правки, которую принимает EditTender.
+func (d *TenderDraft) Changes() Tender {
+	var tender Tender
+	if d.Name != nil {
+		tender.Name = *d.Name
+	}
+	if d.Description != nil {
+		tender.Description = *d.Description
+	}
+	if d.ServiceType != nil {
+		tender.ServiceType = *d.ServiceType
+	}
+
+	return tender
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"strings"
+	"time"
+)
+
+// EligibilityRules Требования тендера к организации, подающей предложение.
+// Незаданные требования не проверяются.
+type EligibilityRules struct {
+	TenderID              uuid.UUID          `db:"tender_id" json:"tenderId"`
+	OrganizationTypes     []OrganizationType `db:"organization_types" json:"organizationTypes"`
+	MinCompletedContracts int                `db:"min_completed_contracts" json:"minCompletedContracts"`
+	Certificate           *string            `db:"certificate" json:"certificate,omitempty"`
+	UpdatedBy             *string            `db:"updated_by" json:"updatedBy,omitempty"`
+	UpdatedAt             *time.Time         `db:"updated_at" json:"updatedAt,omitempty"`
+}
+
+// Empty Сообщает, что ни одно требование не задано.
+func (r *EligibilityRules) Empty() bool {
+	return len(r.OrganizationTypes) == 0 && r.MinCompletedContracts == 0 && r.Certificate == nil
+}
+
+// SupplierProfile Сведения об организации поставщика, по которым проверяются требования.
+type SupplierProfile struct {
+	OrganizationID     uuid.UUID        `db:"organization_id"`
+	Type               OrganizationType `db:"type"`
+	CompletedContracts int              `db:"completed_contracts"`
+	Certificates       []string         `db:"certificates"`
+}
+
+// EligibilityResult Итог проверки: при отказе Reasons объясняет каждое невыполненное требование.
+type EligibilityResult struct {
+	Eligible bool     `json:"eligible"`
+	Reasons  []string `json:"reasons"`
+}
+
+// Evaluate Проверяет профиль поставщика на соответствие требованиям.
+func (r *EligibilityRules) Evaluate(profile *SupplierProfile) EligibilityResult {
+	reasons := []string{}
+
+	if len(r.OrganizationTypes) > 0 && !slices.Contains(r.OrganizationTypes, profile.Type) {
+		types := make([]string, len(r.OrganizationTypes))
+		for i, t := range r.OrganizationTypes {
+			types[i] = string(t)
+		}
+		reasons = append(reasons, fmt.Sprintf("тип организации %s не входит в допустимые: %s", profile.Type, strings.Join(types, ", ")))
+	}
+
+	if profile.CompletedContracts < r.MinCompletedContracts {
+		reasons = append(reasons, fmt.Sprintf("исполнено контрактов: %d, требуется не меньше %d", profile.CompletedContracts, r.MinCompletedContracts))
+	}
+
+	if r.Certificate != nil && !slices.Contains(profile.Certificates, *r.Certificate) {
+		reasons = append(reasons, fmt.Sprintf("нет подтверждённого действующего сертификата %q", *r.Certificate))
+	}
+
+	return EligibilityResult{Eligible: len(reasons) == 0, Reasons: reasons}
+}
+
+// Certificate Сертификат организации. Учитывается в требованиях тендера только после подтверждения
+// администратором площадки и до окончания срока действия.
+type Certificate struct {
+	ID             uuid.UUID  `db:"id" json:"id"`
+	OrganizationID uuid.UUID  `db:"organization_id" json:"organizationId"`
+	Name           string     `db:"name" json:"name"`
+	ValidUntil     *time.Time `db:"valid_until" json:"validUntil,omitempty"`
+	CreatedBy      *string    `db:"created_by" json:"createdBy,omitempty"`
+	VerifiedBy     *string    `db:"verified_by" json:"verifiedBy,omitempty"`
+	VerifiedAt     *time.Time `db:"verified_at" json:"verifiedAt,omitempty"`
+	CreatedAt      *time.Time `db:"created_at" json:"createdAt"`
+}
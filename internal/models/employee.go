@@ -1,12 +1,15 @@
 package models
 
-import "time"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type Employee struct {
-	ID        int       `db:"id"`
-	Username  string    `db:"username"`
-	FirstName string    `db:"first_name"`
-	LastName  string    `db:"last_name"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
-}
\ No newline at end of file
+	ID        uuid.UUID  `db:"id" json:"id"`
+	Username  string     `db:"username" json:"username"`
+	FirstName string     `db:"first_name" json:"firstName,omitempty"`
+	LastName  string     `db:"last_name" json:"lastName,omitempty"`
+	CreatedAt *time.Time `db:"created_at" json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `db:"updated_at" json:"updatedAt,omitempty"`
+}
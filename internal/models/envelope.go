@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type BidQualification string
+
+const (
+	Qualified    BidQualification = "Qualified"
+	Disqualified BidQualification = "Disqualified"
+)
+
+// QualificationRecord Решение по технической части предложения двухконвертного тендера.
+type QualificationRecord struct {
+	BidID         uuid.UUID        `db:"bid_id" json:"bidId"`
+	Qualification BidQualification `db:"qualification" json:"qualification"`
+	Reason        *string          `db:"reason" json:"reason,omitempty"`
+	Username      string           `db:"username" json:"username"`
+	UpdatedAt     *time.Time       `db:"updated_at" json:"updatedAt"`
+}
+
+// TenderStage Режим и текущий этап тендера.
+type TenderStage struct {
+	TwoEnvelope bool         `db:"two_envelope"`
+	Status      TenderStatus `db:"status"`
+}
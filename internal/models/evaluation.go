@@ -0,0 +1,51 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Criterion Критерий оценки предложений тендера. Вес задаёт долю критерия в итоговой оценке.
+type Criterion struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	TenderID  uuid.UUID  `db:"tender_id" json:"tenderId"`
+	Name      string     `db:"name" json:"name"`
+	Weight    float64    `db:"weight" json:"weight"`
+	MaxScore  int        `db:"max_score" json:"maxScore"`
+	CreatedAt *time.Time `db:"created_at" json:"createdAt"`
+}
+
+// BidScore Оценка предложения одним Ответственным по одному критерию.
+type BidScore struct {
+	ID             uuid.UUID  `db:"id" json:"id"`
+	BidID          uuid.UUID  `db:"bid_id" json:"bidId"`
+	CriterionID    uuid.UUID  `db:"criterion_id" json:"criterionId"`
+	ScorerUsername string     `db:"scorer_username" json:"scorerUsername"`
+	Score          int        `db:"score" json:"score"`
+	Comment        *string    `db:"comment" json:"comment,omitempty"`
+	CreatedAt      *time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt      *time.Time `db:"updated_at" json:"updatedAt,omitempty"`
+}
+
+// BidRanking Итоговая взвешенная оценка предложения по шкале от 0 до 100.
+// Оценки разных Ответственных по одному критерию усредняются.
+type BidRanking struct {
+	Rank          int         `db:"rank" json:"rank"`
+	BidID         uuid.UUID   `db:"bid_id" json:"bidId"`
+	BidName       string      `db:"bid_name" json:"bidName"`
+	Decision      BidDecision `db:"decision" json:"decision,omitempty"`
+	WeightedTotal float64     `db:"weighted_total" json:"weightedTotal"`
+	Scorers       int         `db:"scorers" json:"scorers"`
+}
+
+// DecisionRecord Решение по предложению вместе с оценками на момент решения.
+type DecisionRecord struct {
+	ID            uuid.UUID       `db:"id" json:"id"`
+	BidID         uuid.UUID       `db:"bid_id" json:"bidId"`
+	Decision      BidDecision     `db:"decision" json:"decision"`
+	Username      string          `db:"username" json:"username"`
+	Scores        json.RawMessage `db:"scores" json:"scores"`
+	WeightedTotal *float64        `db:"weighted_total" json:"weightedTotal,omitempty"`
+	CreatedAt     *time.Time      `db:"created_at" json:"createdAt"`
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+type EventType string
+
+const (
+	TenderCreated         EventType = "TenderCreated"
+	TenderEdited          EventType = "TenderEdited"
+	TenderPublished       EventType = "TenderPublished"
+	TenderClosed          EventType = "TenderClosed"
+	TenderStatusChanged   EventType = "TenderStatusChanged"
+	TenderRolledBack      EventType = "TenderRolledBack"
+	BidCreated            EventType = "BidCreated"
+	BidEdited             EventType = "BidEdited"
+	BidStatusChanged      EventType = "BidStatusChanged"
+	BidDecisionSubmitted  EventType = "BidDecisionSubmitted"
+	BidFeedbackLeft       EventType = "FeedbackLeft"
+	BidRolledBack         EventType = "BidRolledBack"
+	AttachmentAdded       EventType = "AttachmentAdded"
+	AttachmentRemoved     EventType = "AttachmentRemoved"
+	ClarificationAsked    EventType = "ClarificationAsked"
+	ClarificationAnswered EventType = "ClarificationAnswered"
+	LotAdded              EventType = "LotAdded"
+	LotEdited             EventType = "LotEdited"
+	LotAwarded            EventType = "LotAwarded"
+	LotCancelled          EventType = "LotCancelled"
+	CriteriaSet           EventType = "CriteriaSet"
+	BidScored             EventType = "BidScored"
+	BidsOpened            EventType = "BidsOpened"
+	AuctionScheduled      EventType = "AuctionScheduled"
+	AuctionPriceSubmitted EventType = "AuctionPriceSubmitted"
+	AuctionExtended       EventType = "AuctionExtended"
+	AuctionClosed         EventType = "AuctionClosed"
+	BidQualified          EventType = "BidQualified"
+	OrganizationInvited   EventType = "OrganizationInvited"
+	InvitationRevoked     EventType = "InvitationRevoked"
+	InvitationAccepted    EventType = "InvitationAccepted"
+	InvitationDeclined    EventType = "InvitationDeclined"
+	EligibilitySet        EventType = "EligibilitySet"
+	CertificateAdded      EventType = "CertificateAdded"
+	CertificateVerified   EventType = "CertificateVerified"
+	SupplierDebarred      EventType = "SupplierDebarred"
+	DebarmentLifted       EventType = "DebarmentLifted"
+	DebarmentExpired      EventType = "DebarmentExpired"
+	ReviewReplied         EventType = "ReviewReplied"
+	ReviewEdited          EventType = "ReviewEdited"
+	ReviewHidden          EventType = "ReviewHidden"
+	ReviewRestored        EventType = "ReviewRestored"
+	ContractCreated       EventType = "ContractCreated"
+	ContractClosed        EventType = "ContractClosed"
+	MilestoneAdded        EventType = "MilestoneAdded"
+	MilestoneUpdated      EventType = "MilestoneUpdated"
+	MilestoneDecided      EventType = "MilestoneDecided"
+	TermsProposed         EventType = "TermsProposed"
+	TermsAccepted         EventType = "TermsAccepted"
+	TermsRejected         EventType = "TermsRejected"
+	TemplateCreated       EventType = "TemplateCreated"
+	TemplateEdited        EventType = "TemplateEdited"
+	TemplateRolledBack    EventType = "TemplateRolledBack"
+	UserCreated           EventType = "UserCreated"
+	OrganizationCreated   EventType = "OrganizationCreated"
+	ResponsibleAdded      EventType = "ResponsibleAdded"
+)
+
+type AggregateType string
+
+const (
+	TenderAggregate       AggregateType = "Tender"
+	BidAggregate          AggregateType = "Bid"
+	OrganizationAggregate AggregateType = "Organization"
+	DebarmentAggregate    AggregateType = "Debarment"
+	ReviewAggregate       AggregateType = "Review"
+	ContractAggregate     AggregateType = "Contract"
+	TemplateAggregate     AggregateType = "Template"
+	UserAggregate         AggregateType = "User"
+)
+
+// Event Доменное событие, которое записывается в outbox в транзакции изменения.
+type Event struct {
+	ID            uuid.UUID       `db:"id" json:"id"`
+	Type          EventType       `db:"type" json:"type"`
+	AggregateType AggregateType   `db:"aggregate_type" json:"aggregateType"`
+	AggregateID   uuid.UUID       `db:"aggregate_id" json:"aggregateId"`
+	Actor         string          `db:"actor" json:"actor"`
+	Reason        string          `db:"reason" json:"reason,omitempty"`
+	Payload       json.RawMessage `db:"payload" json:"payload"`
+	CreatedAt     *time.Time      `db:"created_at" json:"createdAt"`
+	PublishedAt   *time.Time      `db:"published_at" json:"publishedAt,omitempty"`
+}
+
+func NewEvent(eventType EventType, aggregateType AggregateType, aggregateID uuid.UUID, actor, reason string, payload any) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Actor:         actor,
+		Reason:        reason,
+		Payload:       data,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package models
+
+type ExportFormat string
+
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportXLSX ExportFormat = "xlsx"
+)
+
+var ExportContentTypes = map[ExportFormat]string{
+	ExportCSV:  "text/csv; charset=utf-8",
+	ExportXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// Заголовки выгрузок. Порядок колонок совпадает с порядком значений в ExportRow.
+var (
+	TenderExportHeader = []string{"id", "name", "description", "serviceType", "status", "version", "organizationId",
+		"creatorUsername", "sealed", "openingAt", "twoEnvelope", "private", "createdAt"}
+	BidExportHeader = []string{"id", "name", "description", "status", "decision", "authorType", "authorId", "version",
+		"price", "qualification", "debarred", "sealed", "createdAt", "updatedAt"}
+	ReviewExportHeader = []string{"id", "bidId", "authorUsername", "description", "rating", "completed", "createdAt",
+		"reply", "replyAuthorUsername", "repliedAt"}
+)
+
+// ExportRow Строка выгрузки тендера. Пустые указатели выгружаются пустыми ячейками.
+func (t *Tender) ExportRow() []any {
+	return []any{t.ID, t.Name, t.Description, string(t.ServiceType), string(t.Status), t.Version, t.OrganizationID,
+		t.CreatorUsername, t.Sealed, t.OpeningAt, t.TwoEnvelope, t.Private, t.CreatedAt}
+}
+
+// ExportRow Строка выгрузки предложения. Цена скрыта так же, как в списке предложений.
+func (b *Bid) ExportRow() []any {
+	return []any{b.ID, b.Name, b.Description, string(b.Status), string(b.Decision), string(b.AuthorType), b.AuthorID,
+		b.Version, b.Price, string(b.Qualification), b.Debarred, b.Sealed, b.CreatedAt, b.UpdatedAt}
+}
+
+func (r *Review) ExportRow() []any {
+	return []any{r.ID, r.BidID, r.AuthorUsername, r.Description, r.Rating, r.Completed, r.CreatedAt,
+		r.Reply, r.ReplyAuthorUsername, r.RepliedAt}
+}
@@ -0,0 +1,29 @@
+package models
+
+// Health Состояние приложения для проверок доступности.
+type Health struct {
+	Status string        `json:"status"`
+	Schema SchemaVersion `json:"schema"`
+}
+
+// Readiness Готовность принимать запросы. Ready ложно, пока недоступна база, не применены миграции
+// или сервер завершает работу.
+type Readiness struct {
+	Ready    bool           `json:"ready"`
+	Draining bool           `json:"draining"`
+	Database string         `json:"database"`
+	Schema   *SchemaVersion `json:"schema,omitempty"`
+	Pool     PoolStats      `json:"pool"`
+}
+
+// PoolStats Состояние пула соединений с базой.
+type PoolStats struct {
+	TotalConns           int32  `json:"totalConns"`
+	AcquiredConns        int32  `json:"acquiredConns"`
+	IdleConns            int32  `json:"idleConns"`
+	MaxConns             int32  `json:"maxConns"`
+	AcquireCount         int64  `json:"acquireCount"`
+	EmptyAcquireCount    int64  `json:"emptyAcquireCount"`
+	CanceledAcquireCount int64  `json:"canceledAcquireCount"`
+	AcquireDuration      string `json:"acquireDuration"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "github.com/google/uuid"
+
+type ImportFormat string
+
+const (
+	ImportCSV    ImportFormat = "csv"
+	ImportNDJSON ImportFormat = "ndjson"
+)
+
+// ImportRowResult Итог строки импорта. Line - номер строки файла, для CSV с учётом заголовка.
+type ImportRowResult struct {
+	Line     int        `json:"line"`
+	Success  bool       `json:"success"`
+	Status   int        `json:"status"`
+	Error    string     `json:"error,omitempty"`
+	TenderID *uuid.UUID `json:"tenderId,omitempty"`
+}
+
+// ImportReport Итог импорта. В режиме DryRun строки только проверяются и ничего не создаётся.
+type ImportReport struct {
+	DryRun    bool              `json:"dryRun"`
+	Total     int               `json:"total"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Rows      []ImportRowResult `json:"rows"`
+}
+
+var ImportReportHeader = []string{"line", "success", "status", "error", "tenderId"}
+
+func (r *ImportRowResult) ExportRow() []any {
+	var tenderID any
+	if r.TenderID != nil {
+		tenderID = *r.TenderID
+	}
+
+	return []any{r.Line, r.Success, r.Status, r.Error, tenderID}
+}
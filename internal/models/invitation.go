@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "Pending"
+	InvitationStatusAccepted InvitationStatus = "Accepted"
+	InvitationStatusDeclined InvitationStatus = "Declined"
+)
+
+// Invitation Приглашение организации к участию в закрытом тендере.
+type Invitation struct {
+	TenderID       uuid.UUID        `db:"tender_id" json:"tenderId"`
+	OrganizationID uuid.UUID        `db:"organization_id" json:"organizationId"`
+	Status         InvitationStatus `db:"status" json:"status"`
+	InvitedBy      *string          `db:"invited_by" json:"invitedBy,omitempty"`
+	RespondedBy    *string          `db:"responded_by" json:"respondedBy,omitempty"`
+	CreatedAt      *time.Time       `db:"created_at" json:"createdAt"`
+	RespondedAt    *time.Time       `db:"responded_at" json:"respondedAt,omitempty"`
+}
+
+// InvitedTender Закрытый тендер, в который приглашена организация пользователя.
+type InvitedTender struct {
+	Tender
+	InvitationStatus InvitationStatus `db:"invitation_status" json:"invitationStatus"`
+}
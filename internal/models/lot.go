@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type LotStatus string
+
+const (
+	LotStatusOpen      LotStatus = "Open"
+	LotStatusAwarded   LotStatus = "Awarded"
+	LotStatusCancelled LotStatus = "Cancelled"
+)
+
+// Lot Часть тендера со своим видом услуги, объёмом и бюджетом. Решается независимо от остальных лотов.
+type Lot struct {
+	ID           uuid.UUID     `db:"id" json:"id"`
+	TenderID     uuid.UUID     `db:"tender_id" json:"tenderId"`
+	Name         string        `db:"name" json:"name"`
+	ServiceType  ServiceType   `db:"service_type" json:"serviceType"`
+	Quantity     int           `db:"quantity" json:"quantity"`
+	Budget       float64       `db:"budget" json:"budget"`
+	Status       LotStatus     `db:"status" json:"status"`
+	WinningBidID uuid.NullUUID `db:"winning_bid_id" json:"winningBidId,omitempty"`
+	CreatedAt    *time.Time    `db:"created_at" json:"createdAt"`
+	UpdatedAt    *time.Time    `db:"updated_at" json:"updatedAt,omitempty"`
+}
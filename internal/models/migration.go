@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Migration Состояние встроенной миграции в базе.
+type Migration struct {
+	Version   int64      `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"appliedAt,omitempty"`
+}
+
+// SchemaVersion Версия схемы в базе и последняя версия среди встроенных миграций.
+type SchemaVersion struct {
+	Current int64 `json:"current"`
+	Latest  int64 `json:"latest"`
+}
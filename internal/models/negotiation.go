@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type NegotiationParty string
+
+const (
+	NegotiationPartyCustomer NegotiationParty = "Customer"
+	NegotiationPartySupplier NegotiationParty = "Supplier"
+)
+
+type NegotiationStatus string
+
+const (
+	NegotiationStatusOpen      NegotiationStatus = "Open"
+	NegotiationStatusAccepted  NegotiationStatus = "Accepted"
+	NegotiationStatusCountered NegotiationStatus = "Countered"
+	NegotiationStatusRejected  NegotiationStatus = "Rejected"
+)
+
+// NegotiationRound Предложенные одной из сторон условия по предложению. BidVersion - версия предложения,
+// к которой относятся условия, ResultingVersion - версия, созданная принятием раунда.
+type NegotiationRound struct {
+	ID               uuid.UUID         `db:"id" json:"id"`
+	BidID            uuid.UUID         `db:"bid_id" json:"bidId"`
+	Round            int               `db:"round" json:"round"`
+	Party            NegotiationParty  `db:"party" json:"party"`
+	ProposedBy       *string           `db:"proposed_by" json:"proposedBy,omitempty"`
+	Price            *float64          `db:"price" json:"price,omitempty"`
+	Terms            string            `db:"terms" json:"terms"`
+	Status           NegotiationStatus `db:"status" json:"status"`
+	BidVersion       int               `db:"bid_version" json:"bidVersion"`
+	ResultingVersion *int              `db:"resulting_version" json:"resultingVersion,omitempty"`
+	RespondedBy      *string           `db:"responded_by" json:"respondedBy,omitempty"`
+	RespondedAt      *time.Time        `db:"responded_at" json:"respondedAt,omitempty"`
+	CreatedAt        *time.Time        `db:"created_at" json:"createdAt"`
+}
+
+// BidVersion Версия предложения из истории. NegotiationRound - раунд переговоров, принятием которого создана версия.
+type BidVersion struct {
+	Bid
+	NegotiationRound *int `db:"negotiation_round" json:"negotiationRound,omitempty"`
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type OrganizationType string
 
@@ -10,17 +13,23 @@ const (
 	JSC OrganizationType = "JSC"
 )
 
+var OrganizationTypeMap = map[OrganizationType]struct{}{
+	IE:  {},
+	LLC: {},
+	JSC: {},
+}
+
 type Organization struct {
-	ID        int              `db:"id"`
-	Name      string           `db:"name"`
-	Desc      string           `db:"description"`
-	Type      OrganizationType `db:"type"`
-	CreatedAt time.Time        `db:"created_at"`
-	UpdatedAt time.Time        `db:"updated_at"`
+	ID        uuid.UUID        `db:"id" json:"id"`
+	Name      string           `db:"name" json:"name"`
+	Desc      string           `db:"description" json:"description,omitempty"`
+	Type      OrganizationType `db:"type" json:"type"`
+	CreatedAt *time.Time       `db:"created_at" json:"createdAt,omitempty"`
+	UpdatedAt *time.Time       `db:"updated_at" json:"updatedAt,omitempty"`
 }
 
 type OrganizationResponsible struct {
-	ID             int `db:"id"`
-	OrganizationID int `db:"organization_id"`
-	UserID         int `db:"user_id"`
-}
\ No newline at end of file
+	ID             uuid.UUID `db:"id" json:"id"`
+	OrganizationID uuid.UUID `db:"organization_id" json:"organizationId"`
+	UserID         uuid.UUID `db:"user_id" json:"userId"`
+}
@@ -10,5 +10,52 @@ type Review struct {
 	BidID          uuid.UUID  `db:"bid_id" json:"bidId"`
 	AuthorUsername string     `db:"author_username" json:"authorUsername,omitempty"`
 	Description    string     `db:"description" json:"description"`
+	Rating         *int       `db:"rating" json:"rating,omitempty"`
+	Completed      *bool      `db:"completed" json:"completed,omitempty"`
 	CreatedAt      *time.Time `db:"created_at"`
-}
\ No newline at end of file
+
+	Reply               *string    `db:"reply" json:"reply,omitempty"`
+	ReplyAuthorUsername *string    `db:"reply_author_username" json:"replyAuthorUsername,omitempty"`
+	RepliedAt           *time.Time `db:"replied_at" json:"repliedAt,omitempty"`
+	UpdatedAt           *time.Time `db:"updated_at" json:"updatedAt,omitempty"`
+	HiddenBy            *string    `db:"hidden_by" json:"hiddenBy,omitempty"`
+	HiddenReason        *string    `db:"hidden_reason" json:"hiddenReason,omitempty"`
+	HiddenAt            *time.Time `db:"hidden_at" json:"hiddenAt,omitempty"`
+}
+
+// ReviewRevision Редакция отзыва до очередной правки.
+type ReviewRevision struct {
+	ID          uuid.UUID  `db:"id" json:"id"`
+	ReviewID    uuid.UUID  `db:"review_id" json:"reviewId"`
+	Description string     `db:"description" json:"description"`
+	Rating      *int       `db:"rating" json:"rating,omitempty"`
+	Completed   *bool      `db:"completed" json:"completed,omitempty"`
+	EditedBy    *string    `db:"edited_by" json:"editedBy,omitempty"`
+	CreatedAt   *time.Time `db:"created_at" json:"createdAt"`
+}
+
+// Reputation Репутация автора предложений или организации по отзывам и итогам тендеров.
+// Доли считаются только по предложениям с решением и отзывам с указанным итогом исполнения.
+type Reputation struct {
+	AuthorID           uuid.UUID `db:"author_id" json:"-"`
+	ReviewCount        int       `db:"review_count" json:"reviewCount"`
+	AverageRating      *float64  `db:"average_rating" json:"averageRating,omitempty"`
+	DecidedBids        int       `db:"decided_bids" json:"decidedBids"`
+	WonBids            int       `db:"won_bids" json:"wonBids"`
+	WinRate            *float64  `db:"-" json:"winRate,omitempty"`
+	CompletionReported int       `db:"completion_reported" json:"-"`
+	CompletedCount     int       `db:"completed_count" json:"completedCount"`
+	CompletionRate     *float64  `db:"-" json:"completionRate,omitempty"`
+}
+
+// ComputeRates Заполняет доли побед и исполненных контрактов.
+func (r *Reputation) ComputeRates() {
+	if r.DecidedBids > 0 {
+		winRate := float64(r.WonBids) / float64(r.DecidedBids)
+		r.WinRate = &winRate
+	}
+	if r.CompletionReported > 0 {
+		completionRate := float64(r.CompletedCount) / float64(r.CompletionReported)
+		r.CompletionRate = &completionRate
+	}
+}
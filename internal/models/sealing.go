@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SealedBidContent Содержимое запечатанного предложения, которое шифруется до вскрытия.
+type SealedBidContent struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Price       *float64 `json:"price,omitempty"`
+}
+
+// BidOpening Вскрытие запечатанных предложений тендера: кто и когда вскрыл и сколько предложений.
+type BidOpening struct {
+	TenderID  uuid.UUID  `db:"tender_id" json:"tenderId"`
+	OpenedBy  string     `db:"opened_by" json:"openedBy"`
+	OpenedAt  *time.Time `db:"opened_at" json:"openedAt"`
+	BidsCount int        `db:"bids_count" json:"bidsCount"`
+}
+
+// TenderSealing Режим запечатанных предложений тендера. OpenedAt заполнено после вскрытия.
+type TenderSealing struct {
+	Sealed    bool       `db:"sealed"`
+	OpeningAt *time.Time `db:"opening_at"`
+	OpenedAt  *time.Time `db:"opened_at"`
+}
+
+// BiddingClosed Приём запечатанных предложений завершён после вскрытия или с наступлением времени вскрытия.
+func (s TenderSealing) BiddingClosed(now time.Time) bool {
+	return s.OpenedAt != nil || (s.OpeningAt != nil && !now.Before(*s.OpeningAt))
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TemplateCriterion Критерий оценки в шаблоне. При создании тендера превращается в Criterion.
+type TemplateCriterion struct {
+	Name     string  `json:"name"`
+	Weight   float64 `json:"weight"`
+	MaxScore int     `json:"maxScore"`
+}
+
+// TenderTemplate Шаблон тендера организации. Версионируется так же, как тендер: каждая правка
+// увеличивает версию и сохраняется в истории, к которой можно откатиться.
+type TenderTemplate struct {
+	ID                    uuid.UUID           `db:"id" json:"id"`
+	OrganizationID        uuid.UUID           `db:"organization_id" json:"organizationId"`
+	Name                  string              `db:"name" json:"name"`
+	Description           string              `db:"description" json:"description"`
+	ServiceType           ServiceType         `db:"service_type" json:"serviceType"`
+	Criteria              []TemplateCriterion `db:"criteria" json:"criteria"`
+	OrganizationTypes     []OrganizationType  `db:"organization_types" json:"organizationTypes"`
+	MinCompletedContracts int                 `db:"min_completed_contracts" json:"minCompletedContracts"`
+	Certificate           *string             `db:"certificate" json:"certificate,omitempty"`
+	Version               int                 `db:"version" json:"version"`
+	CreatorUsername       *string             `db:"creator_username" json:"creatorUsername,omitempty"`
+	CreatedAt             *time.Time          `db:"created_at" json:"createdAt"`
+	UpdatedAt             *time.Time          `db:"updated_at" json:"updatedAt,omitempty"`
+}
+
+// TemplateChanges Правка шаблона. Поле со значением nil не меняется.
+type TemplateChanges struct {
+	Name                  *string
+	Description           *string
+	ServiceType           *ServiceType
+	Criteria              []TemplateCriterion
+	OrganizationTypes     []OrganizationType
+	MinCompletedContracts *int
+	Certificate           *string
+}
+
+// Apply Переносит заданные поля правки в шаблон. Пустой сертификат снимает требование.
+func (t *TenderTemplate) Apply(changes *TemplateChanges) {
+	if changes.Name != nil {
+		t.Name = *changes.Name
+	}
+	if changes.Description != nil {
+		t.Description = *changes.Description
+	}
+	if changes.ServiceType != nil {
+		t.ServiceType = *changes.ServiceType
+	}
+	if changes.Criteria != nil {
+		t.Criteria = changes.Criteria
+	}
+	if changes.OrganizationTypes != nil {
+		t.OrganizationTypes = changes.OrganizationTypes
+	}
+	if changes.MinCompletedContracts != nil {
+		t.MinCompletedContracts = *changes.MinCompletedContracts
+	}
+	if changes.Certificate != nil {
+		t.Certificate = changes.Certificate
+		if *changes.Certificate == "" {
+			t.Certificate = nil
+		}
+	}
+}
+
+// TenderCriteria Критерии шаблона в виде критериев тендера.
+func (t *TenderTemplate) TenderCriteria() []Criterion {
+	criteria := make([]Criterion, len(t.Criteria))
+	for i, c := range t.Criteria {
+		criteria[i] = Criterion{Name: c.Name, Weight: c.Weight, MaxScore: c.MaxScore}
+	}
+
+	return criteria
+}
+
+// EligibilityRules Требования шаблона к поставщикам для тендера tenderID.
+func (t *TenderTemplate) EligibilityRules(tenderID uuid.UUID) EligibilityRules {
+	return EligibilityRules{
+		TenderID:              tenderID,
+		OrganizationTypes:     t.OrganizationTypes,
+		MinCompletedContracts: t.MinCompletedContracts,
+		Certificate:           t.Certificate,
+	}
+}
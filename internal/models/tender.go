@@ -11,8 +11,19 @@ const (
 	Created   TenderStatus = "Created"
 	Published TenderStatus = "Published"
 	Closed    TenderStatus = "Closed"
+
+	TechnicalEvaluation  TenderStatus = "TechnicalEvaluation"
+	CommercialEvaluation TenderStatus = "CommercialEvaluation"
 )
 
+var TenderStatusMap = map[TenderStatus]struct{}{
+	Created:              {},
+	Published:            {},
+	Closed:               {},
+	TechnicalEvaluation:  {},
+	CommercialEvaluation: {},
+}
+
 type ServiceType string
 
 const (
@@ -36,6 +47,11 @@ type Tender struct {
 	Version         int          `db:"version" json:"version"`
 	OrganizationID  uuid.UUID    `db:"organization_id" json:"organizationId,omitempty"`
 	CreatorUsername string       `db:"creator_username" json:"creatorUsername"`
+	Sealed          bool         `db:"sealed" json:"sealed,omitempty"`
+	OpeningAt       *time.Time   `db:"opening_at" json:"openingAt,omitempty"`
+	TwoEnvelope     bool         `db:"two_envelope" json:"twoEnvelope,omitempty"`
+	Private         bool         `db:"private" json:"private,omitempty"`
+	SourceTenderID  *uuid.UUID   `db:"source_tender_id" json:"sourceTenderId,omitempty"`
 	CreatedAt       *time.Time   `db:"created_at"`
 	UpdatedAt       *time.Time   `db:"updated_at,omitempty"`
 }
@@ -52,4 +68,4 @@ type TenderHistory struct {
 	CreatorUsername string       `db:"creator_username" json:"creatorUsername"`
 	CreatedAt       *time.Time   `db:"created_at"`
 	UpdatedAt       *time.Time   `db:"updated_at,omitempty"`
-}
\ No newline at end of file
+}
@@ -0,0 +1,35 @@
+package outbox
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"zadanie-6105/internal/models"
+)
+
+// Publisher Отправляет события потребителям (аналитика, уведомления).
+// Ошибка означает, что пачка не доставлена и будет отправлена повторно.
+type Publisher interface {
+	Publish(ctx context.Context, events []models.Event) error
+}
+
+// LogPublisher Пишет события в лог. Используется, пока не подключен брокер.
+type LogPublisher struct {
+	zapLogger *zap.SugaredLogger
+}
+
+func NewLogPublisher(l *zap.SugaredLogger) *LogPublisher {
+	return &LogPublisher{zapLogger: l}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, events []models.Event) error {
+	for _, e := range events {
+		p.zapLogger.Infow("event published",
+			"id", e.ID,
+			"type", e.Type,
+			"aggregateType", e.AggregateType,
+			"aggregateId", e.AggregateID,
+			"actor", e.Actor,
+		)
+	}
+	return nil
+}
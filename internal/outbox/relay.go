@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/storage"
+)
+
+type Store interface {
+	storage.Outbox
+	storage.Transactor
+}
+
+// Relay Периодически забирает неопубликованные события из outbox и передаёт их Publisher.
+type Relay struct {
+	storage   Store
+	publisher Publisher
+	zapLogger *zap.SugaredLogger
+	interval  time.Duration
+	batchSize int32
+}
+
+func NewRelay(s Store, p Publisher, l *zap.SugaredLogger, cfg *config.OutboxConfig) *Relay {
+	return &Relay{
+		storage:   s,
+		publisher: p,
+		zapLogger: l,
+		interval:  cfg.Interval,
+		batchSize: cfg.BatchSize,
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.flush(ctx); err != nil {
+				r.zapLogger.Errorf("outbox relay: %v", err)
+			}
+		}
+	}
+}
+
+// flush Публикует одну пачку. События помечаются отправленными в той же транзакции,
+// в которой были заблокированы, поэтому при ошибке Publisher они останутся в очереди.
+func (r *Relay) flush(ctx context.Context) error {
+	return r.storage.WithTx(ctx, func(ctx context.Context) error {
+		events, err := r.storage.GetUnpublishedEvents(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		if err = r.publisher.Publish(ctx, events); err != nil {
+			return err
+		}
+
+		ids := make([]uuid.UUID, 0, len(events))
+		for _, e := range events {
+			ids = append(ids, e.ID)
+		}
+
+		return r.storage.MarkEventsPublished(ctx, ids)
+	})
+}
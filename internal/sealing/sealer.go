@@ -0,0 +1,125 @@
+package sealing
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"zadanie-6105/internal/models/config"
+)
+
+const (
+	keySize = 32
+	keyPerm = 0o600
+	dirPerm = 0o750
+)
+
+// Sealer Шифрует содержимое запечатанных предложений AES-256-GCM.
+// Ключ хранится в файле, общем для всех реплик, и создаётся заранее командой sealing-key:
+// предложение, запечатанное одним ключом, другим не вскрыть.
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+func NewSealer(cfg *config.SealingConfig) (*Sealer, error) {
+	const op = "sealing.NewSealer"
+
+	key, err := loadKey(cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Sealer{aead: aead}, nil
+}
+
+// Seal Возвращает nonce и шифротекст одним срезом. additionalData привязывает
+// шифротекст к контексту (например, к тендеру) и должно совпадать при Open.
+func (s *Sealer) Seal(plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(plaintext)+s.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("sealing.Seal: %w", err)
+	}
+
+	return s.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func (s *Sealer) Open(sealed, additionalData []byte) ([]byte, error) {
+	const op = "sealing.Open"
+
+	if len(sealed) < s.aead.NonceSize() {
+		return nil, fmt.Errorf("%s: %w", op, errors.New("sealed payload is too short"))
+	}
+
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+	plaintext, err := s.aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return plaintext, nil
+}
+
+func loadKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("key file %s does not exist, create it with the sealing-key command", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != keySize {
+		return nil, fmt.Errorf("key file must contain %d hex-encoded bytes", keySize)
+	}
+
+	return key, nil
+}
+
+// GenerateKey Новый ключ в том виде, в каком он хранится в файле.
+func GenerateKey() (string, error) {
+	key := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", fmt.Errorf("sealing.GenerateKey: %w", err)
+	}
+
+	return hex.EncodeToString(key), nil
+}
+
+// WriteKeyFile Записывает ключ в новый файл. Существующий файл не перезаписывается:
+// потеря ключа делает уже запечатанные предложения невскрываемыми.
+func WriteKeyFile(path, key string) error {
+	const op = "sealing.WriteKeyFile"
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, keyPerm)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer f.Close()
+
+	if _, err = f.WriteString(key); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package sealing
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"zadanie-6105/internal/models/config"
+)
+
+// newTestSealer Sealer с новым ключом во временном файле.
+func newTestSealer(t *testing.T) *Sealer {
+	t.Helper()
+
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "key")
+	if err = WriteKeyFile(path, key); err != nil {
+		t.Fatal(err)
+	}
+
+	sealer, err := NewSealer(&config.SealingConfig{KeyFile: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sealer
+}
+
+func TestSealOpen(t *testing.T) {
+	sealer := newTestSealer(t)
+	plaintext := []byte(`{"name":"bid","price":100}`)
+	tenderID := []byte("tender-1")
+
+	sealed, err := sealer.Seal(plaintext, tenderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(sealed, plaintext) {
+		t.Fatal("sealed payload contains the plaintext")
+	}
+
+	opened, err := sealer.Open(sealed, tenderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(opened, plaintext) {
+		t.Fatalf("opened = %q, want %q", opened, plaintext)
+	}
+}
+
+// TestOpenWrongTender Шифротекст привязан к тендеру и не вскрывается под другим.
+func TestOpenWrongTender(t *testing.T) {
+	sealer := newTestSealer(t)
+
+	sealed, err := sealer.Seal([]byte("bid"), []byte("tender-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = sealer.Open(sealed, []byte("tender-2")); err == nil {
+		t.Fatal("opened under another tender")
+	}
+}
+
+func TestOpenTampered(t *testing.T) {
+	sealer := newTestSealer(t)
+	tenderID := []byte("tender-1")
+
+	sealed, err := sealer.Seal([]byte("bid"), tenderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, i := range []int{0, len(sealed) / 2, len(sealed) - 1} {
+		tampered := bytes.Clone(sealed)
+		tampered[i] ^= 1
+		if _, err = sealer.Open(tampered, tenderID); err == nil {
+			t.Fatalf("opened with byte %d flipped", i)
+		}
+	}
+
+	if _, err = sealer.Open(sealed[:len(sealed)-1], tenderID); err == nil {
+		t.Fatal("opened truncated payload")
+	}
+}
+
+// TestOpenOtherKey Предложение, запечатанное одним ключом, другим не вскрыть.
+func TestOpenOtherKey(t *testing.T) {
+	tenderID := []byte("tender-1")
+
+	sealed, err := newTestSealer(t).Seal([]byte("bid"), tenderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = newTestSealer(t).Open(sealed, tenderID); err == nil {
+		t.Fatal("opened with another key")
+	}
+}
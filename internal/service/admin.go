@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+const (
+	// adminActor Автор событий, созданных командами администрирования.
+	adminActor = "admin"
+
+	maxUsernameLength         = 50
+	maxOrganizationNameLength = 100
+)
+
+// AdminService Заведение пользователей, организаций и Ответственных. В API этих операций нет,
+// ими пользуются команды администрирования.
+type AdminService struct {
+	storage storage.Storage
+}
+
+func NewAdminService(s storage.Storage) *AdminService {
+	return &AdminService{storage: s}
+}
+
+func (as *AdminService) CreateUser(r *http.Request, employee *models.Employee) (models.Employee, error) {
+	employee.Username = strings.TrimSpace(employee.Username)
+	if employee.Username == "" || utf8.RuneCountInString(employee.Username) > maxUsernameLength ||
+		utf8.RuneCountInString(employee.FirstName) > maxUsernameLength || utf8.RuneCountInString(employee.LastName) > maxUsernameLength {
+		return models.Employee{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidUser}
+	}
+
+	var newEmployee models.Employee
+	err := as.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		var err error
+		newEmployee, err = as.storage.CreateEmployee(ctx, employee)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, as.storage, models.UserCreated, models.UserAggregate, newEmployee.ID, adminActor, "", newEmployee)
+	})
+	if err != nil {
+		return models.Employee{}, err
+	}
+
+	return newEmployee, nil
+}
+
+func (as *AdminService) CreateOrganization(r *http.Request, organization *models.Organization) (models.Organization, error) {
+	organization.Name = strings.TrimSpace(organization.Name)
+	if organization.Name == "" || utf8.RuneCountInString(organization.Name) > maxOrganizationNameLength {
+		return models.Organization{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidOrganization}
+	}
+	if _, ok := models.OrganizationTypeMap[organization.Type]; !ok {
+		return models.Organization{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidOrganization}
+	}
+
+	var newOrganization models.Organization
+	err := as.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		var err error
+		newOrganization, err = as.storage.CreateOrganization(ctx, organization)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, as.storage, models.OrganizationCreated, models.OrganizationAggregate, newOrganization.ID, adminActor, "", newOrganization)
+	})
+	if err != nil {
+		return models.Organization{}, err
+	}
+
+	return newOrganization, nil
+}
+
+// AddResponsible Назначает пользователя Ответственным организации.
+func (as *AdminService) AddResponsible(r *http.Request, orgID, username string) (models.OrganizationResponsible, error) {
+	err := as.storage.CheckOrganizationExists(r.Context(), orgID)
+	if err != nil {
+		return models.OrganizationResponsible{}, err
+	}
+
+	err = as.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.OrganizationResponsible{}, err
+	}
+
+	var responsible models.OrganizationResponsible
+	err = as.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		responsible, err = as.storage.AddOrganizationResponsible(ctx, orgID, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, as.storage, models.ResponsibleAdded, models.OrganizationAggregate, responsible.OrganizationID, adminActor, "", responsible)
+	})
+	if err != nil {
+		return models.OrganizationResponsible{}, err
+	}
+
+	return responsible, nil
+}
+
+func (as *AdminService) MigrateUp(r *http.Request) ([]models.Migration, error) {
+	return as.storage.MigrateUp(r.Context())
+}
+
+func (as *AdminService) MigrateDown(r *http.Request) (models.Migration, error) {
+	return as.storage.MigrateDown(r.Context())
+}
+
+func (as *AdminService) GetMigrations(r *http.Request) ([]models.Migration, error) {
+	return as.storage.GetMigrations(r.Context())
+}
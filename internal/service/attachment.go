@@ -0,0 +1,256 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+const sniffLen = 512
+
+type AttachmentService struct {
+	storage   storage.Storage
+	blobs     storage.BlobStore
+	maxSize   int64
+	mimeTypes map[string]struct{}
+}
+
+func NewAttachmentService(s storage.Storage, b storage.BlobStore, cfg *config.AttachmentConfig) *AttachmentService {
+	mimeTypes := make(map[string]struct{}, len(cfg.MIMETypes))
+	for _, t := range cfg.MIMETypes {
+		mimeTypes[t] = struct{}{}
+	}
+
+	return &AttachmentService{
+		storage:   s,
+		blobs:     b,
+		maxSize:   cfg.MaxSize,
+		mimeTypes: mimeTypes,
+	}
+}
+
+// checkCanModify Вложения тендера меняет Ответственный, вложения предложения - его Автор и только пока
+// само предложение можно править.
+func (as *AttachmentService) checkCanModify(ctx context.Context, entityType models.AttachmentEntity, entityID, username string) error {
+	if err := as.storage.CheckUserExists(ctx, username); err != nil {
+		return err
+	}
+
+	if entityType == models.TenderAttachment {
+		if err := as.storage.CheckTenderExists(ctx, entityID); err != nil {
+			return err
+		}
+		return as.storage.ValidateUserResponsible(ctx, entityID, username)
+	}
+
+	return checkBidEditable(ctx, as.storage, entityID, username)
+}
+
+// checkCanView Вложения видны тем же, кому видна сама сущность: опубликованный тендер - всем, кому он виден,
+// иначе Ответственному; предложение - Автору и Ответственному за тендер, но Ответственному только после вскрытия
+// и, в двухконвертном тендере, после раскрытия коммерческой части.
+func (as *AttachmentService) checkCanView(ctx context.Context, entityType models.AttachmentEntity, entityID, username string) error {
+	if err := as.storage.CheckUserExists(ctx, username); err != nil {
+		return err
+	}
+
+	if entityType == models.TenderAttachment {
+		if err := as.storage.CheckTenderExists(ctx, entityID); err != nil {
+			return err
+		}
+		if err := as.storage.CheckTenderPublished(ctx, entityID, username); err == nil {
+			return nil
+		}
+		return as.storage.ValidateUserResponsible(ctx, entityID, username)
+	}
+
+	if err := as.storage.CheckBidExists(ctx, entityID); err != nil {
+		return err
+	}
+	if err := as.storage.CheckUserBidAuthor(ctx, entityID, username); err == nil {
+		return nil
+	}
+	if err := as.storage.ValidateUserResponsibleBidID(ctx, entityID, username); err != nil {
+		return err
+	}
+	if err := as.storage.CheckBidUnsealed(ctx, entityID); err != nil {
+		return err
+	}
+	return as.storage.CheckBidCommercialVisible(ctx, entityID)
+}
+
+// detectContentType Определяет тип по содержимому; для общих контейнеров (zip, octet-stream)
+// доверяет заявленному клиентом типу, если он разрешён.
+func (as *AttachmentService) detectContentType(head []byte, declared string) string {
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if sniffed == "application/octet-stream" || sniffed == "application/zip" {
+		if declaredType, _, err := mime.ParseMediaType(declared); err == nil {
+			if _, ok := as.mimeTypes[declaredType]; ok {
+				return declaredType
+			}
+		}
+	}
+
+	return sniffed
+}
+
+func (as *AttachmentService) Upload(r *http.Request, entityType models.AttachmentEntity, entityID, username string, file *multipart.FileHeader) (models.Attachment, error) {
+	var emptyAttachment models.Attachment
+	if err := as.checkCanModify(r.Context(), entityType, entityID, username); err != nil {
+		return emptyAttachment, err
+	}
+
+	if file.Size > as.maxSize {
+		return emptyAttachment, util.MyResponseError{Status: http.StatusRequestEntityTooLarge, Msg: util.AttachmentTooLarge}
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return emptyAttachment, err
+	}
+	defer src.Close()
+
+	buffered := bufio.NewReaderSize(src, sniffLen)
+	head, err := buffered.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return emptyAttachment, err
+	}
+
+	contentType := as.detectContentType(head, file.Header.Get("Content-Type"))
+	if _, ok := as.mimeTypes[contentType]; !ok {
+		return emptyAttachment, util.MyResponseError{Status: http.StatusUnsupportedMediaType, Msg: util.AttachmentBadType}
+	}
+
+	hash := sha256.New()
+	counter := &countingReader{r: io.LimitReader(buffered, as.maxSize+1)}
+	key := uuid.NewString()
+	if err = as.blobs.Put(r.Context(), key, io.TeeReader(counter, hash)); err != nil {
+		return emptyAttachment, err
+	}
+	if counter.n > as.maxSize {
+		_ = as.blobs.Delete(r.Context(), key)
+		return emptyAttachment, util.MyResponseError{Status: http.StatusRequestEntityTooLarge, Msg: util.AttachmentTooLarge}
+	}
+
+	attachment := models.Attachment{
+		EntityType:  entityType,
+		EntityID:    uuid.MustParse(entityID),
+		FileName:    filepath.Base(file.Filename),
+		ContentType: contentType,
+		Size:        counter.n,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+		StorageKey:  key,
+		UploadedBy:  username,
+	}
+
+	var newAttachment models.Attachment
+	err = as.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		attachment.VersionFrom, err = as.bumpVersion(ctx, entityType, entityID)
+		if err != nil {
+			return err
+		}
+
+		newAttachment, err = as.storage.CreateAttachment(ctx, &attachment)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, as.storage, models.AttachmentAdded, models.AggregateType(entityType), attachment.EntityID, username, "", newAttachment)
+	})
+	if err != nil {
+		_ = as.blobs.Delete(r.Context(), key)
+		return emptyAttachment, err
+	}
+
+	return newAttachment, nil
+}
+
+func (as *AttachmentService) GetAttachments(r *http.Request, entityType models.AttachmentEntity, entityID, username string, version int32) ([]models.Attachment, error) {
+	if err := as.checkCanView(r.Context(), entityType, entityID, username); err != nil {
+		return nil, err
+	}
+
+	return as.storage.GetAttachments(r.Context(), entityType, entityID, version)
+}
+
+// Download Возвращает метаданные и содержимое вложения. Закрыть reader должен вызывающий.
+func (as *AttachmentService) Download(r *http.Request, entityType models.AttachmentEntity, entityID, attachmentID, username string) (models.Attachment, io.ReadCloser, error) {
+	if err := as.checkCanView(r.Context(), entityType, entityID, username); err != nil {
+		return models.Attachment{}, nil, err
+	}
+
+	attachment, err := as.storage.GetAttachment(r.Context(), entityType, entityID, attachmentID)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+
+	content, err := as.blobs.Get(r.Context(), attachment.StorageKey)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+// Delete Скрывает вложение в новой версии сущности. Содержимое остаётся для прошлых версий.
+func (as *AttachmentService) Delete(r *http.Request, entityType models.AttachmentEntity, entityID, attachmentID, username string) (models.Attachment, error) {
+	var emptyAttachment models.Attachment
+	if err := as.checkCanModify(r.Context(), entityType, entityID, username); err != nil {
+		return emptyAttachment, err
+	}
+
+	attachment, err := as.storage.GetAttachment(r.Context(), entityType, entityID, attachmentID)
+	if err != nil {
+		return emptyAttachment, err
+	}
+
+	err = as.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		var version int
+		version, err = as.bumpVersion(ctx, entityType, entityID)
+		if err != nil {
+			return err
+		}
+
+		if err = as.storage.CloseAttachment(ctx, attachmentID, version); err != nil {
+			return err
+		}
+		attachment.VersionTo = &version
+
+		return appendEvent(ctx, as.storage, models.AttachmentRemoved, models.AggregateType(entityType), attachment.EntityID, username, "", attachment)
+	})
+	if err != nil {
+		return emptyAttachment, err
+	}
+
+	return attachment, nil
+}
+
+func (as *AttachmentService) bumpVersion(ctx context.Context, entityType models.AttachmentEntity, entityID string) (int, error) {
+	if entityType == models.TenderAttachment {
+		return as.storage.BumpTenderVersion(ctx, entityID)
+	}
+	return as.storage.BumpBidVersion(ctx, entityID)
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
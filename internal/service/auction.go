@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"math"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+type AuctionService struct {
+	storage        storage.Storage
+	streamInterval time.Duration
+}
+
+func NewAuctionService(s storage.Storage, cfg *config.AuctionConfig) *AuctionService {
+	return &AuctionService{storage: s, streamInterval: cfg.StreamInterval}
+}
+
+// ScheduleAuction Только Ответственный за тендер назначает аукцион. Параметры можно менять, пока аукцион не начался.
+func (as *AuctionService) ScheduleAuction(r *http.Request, auction *models.Auction, username string) (models.Auction, error) {
+	tenderID := auction.TenderID.String()
+	err := as.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return models.Auction{}, err
+	}
+
+	err = as.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Auction{}, err
+	}
+
+	err = as.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return models.Auction{}, err
+	}
+
+	err = as.storage.CheckTenderNotClosed(r.Context(), tenderID)
+	if err != nil {
+		return models.Auction{}, err
+	}
+
+	err = as.storage.CheckTenderAuctionAllowed(r.Context(), tenderID)
+	if err != nil {
+		return models.Auction{}, err
+	}
+
+	now := time.Now().UTC()
+	if !auction.StartsAt.After(now) || auction.DurationSeconds <= 0 || auction.ExtensionSeconds < 0 ||
+		auction.MinStep <= 0 || (auction.StartPrice != nil && *auction.StartPrice <= 0) {
+		return models.Auction{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidAuction}
+	}
+	auction.StartsAt = auction.StartsAt.UTC()
+
+	var newAuction models.Auction
+	err = as.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newAuction, err = as.storage.ScheduleAuction(ctx, auction, now)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, as.storage, models.AuctionScheduled, models.TenderAggregate, newAuction.TenderID, username, "", newAuction)
+	})
+	if err != nil {
+		return models.Auction{}, err
+	}
+
+	newAuction.Status = newAuction.StatusAt(now)
+	return newAuction, nil
+}
+
+// GetAuction Состояние аукциона видят Ответственные за тендер и участники.
+// Участники видят рейтинг анонимно: без идентификаторов чужих предложений, только отметку своего.
+func (as *AuctionService) GetAuction(r *http.Request, tenderID, username string) (models.AuctionState, error) {
+	err := as.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return models.AuctionState{}, err
+	}
+
+	err = as.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.AuctionState{}, err
+	}
+
+	var ownBids []uuid.UUID
+	responsible := as.storage.ValidateUserResponsible(r.Context(), tenderID, username) == nil
+	if !responsible {
+		ownBids, err = as.storage.GetUserTenderBidIDs(r.Context(), tenderID, username)
+		if err != nil {
+			return models.AuctionState{}, err
+		}
+		if len(ownBids) == 0 {
+			return models.AuctionState{}, util.MyResponseError{Status: http.StatusForbidden, Msg: util.NotAParticipant}
+		}
+	}
+
+	return as.getAuctionState(r.Context(), tenderID, responsible, ownBids)
+}
+
+func (as *AuctionService) getAuctionState(ctx context.Context, tenderID string, responsible bool, ownBids []uuid.UUID) (models.AuctionState, error) {
+	auction, err := as.storage.GetAuction(ctx, tenderID)
+	if err != nil {
+		return models.AuctionState{}, err
+	}
+	auction.Status = auction.StatusAt(time.Now())
+
+	ranking, err := as.storage.GetAuctionRanking(ctx, tenderID)
+	if err != nil {
+		return models.AuctionState{}, err
+	}
+
+	if !responsible {
+		own := make(map[uuid.UUID]struct{}, len(ownBids))
+		for _, id := range ownBids {
+			own[id] = struct{}{}
+		}
+
+		for i := range ranking {
+			_, ranking[i].Own = own[ranking[i].BidID.UUID]
+			ranking[i].BidID = uuid.NullUUID{}
+		}
+		if _, ok := own[auction.WinnerBidID.UUID]; !ok {
+			auction.WinnerBidID = uuid.NullUUID{}
+		}
+	}
+
+	return models.AuctionState{Auction: auction, Ranking: ranking}, nil
+}
+
+// WatchAuction Передаёт send состояние аукциона при каждом изменении, пока аукцион не закроется
+// или клиент не отключится. Опрашивает БД, поэтому видит цены, поданные через любую реплику.
+func (as *AuctionService) WatchAuction(r *http.Request, tenderID, username string, send func(models.AuctionState) error) error {
+	state, err := as.GetAuction(r, tenderID, username)
+	if err != nil {
+		return err
+	}
+
+	var ownBids []uuid.UUID
+	responsible := as.storage.ValidateUserResponsible(r.Context(), tenderID, username) == nil
+	if !responsible {
+		ownBids, err = as.storage.GetUserTenderBidIDs(r.Context(), tenderID, username)
+		if err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(as.streamInterval)
+	defer ticker.Stop()
+
+	var last models.AuctionState
+	for {
+		if !sameAuctionState(&last, &state) {
+			if err = send(state); err != nil {
+				return err
+			}
+			last = state
+		}
+
+		if state.Status == models.AuctionStatusClosed && state.ClosedAt != nil {
+			return nil
+		}
+
+		select {
+		case <-r.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		state, err = as.getAuctionState(r.Context(), tenderID, responsible, ownBids)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func sameAuctionState(a, b *models.AuctionState) bool {
+	if a.Status != b.Status || !a.EndsAt.Equal(b.EndsAt) || (a.ClosedAt == nil) != (b.ClosedAt == nil) ||
+		len(a.Ranking) != len(b.Ranking) {
+		return false
+	}
+
+	for i := range a.Ranking {
+		if a.Ranking[i] != b.Ranking[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SubmitPrice Только Автор предложения подаёт цену. Цена должна быть ниже текущей лучшей хотя бы на шаг аукциона.
+// Подачи цен по одному аукциону выполняются строго по очереди под блокировкой строки аукциона.
+func (as *AuctionService) SubmitPrice(r *http.Request, bidID string, price float64, username string) (models.AuctionPrice, error) {
+	err := as.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return models.AuctionPrice{}, err
+	}
+
+	err = as.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.AuctionPrice{}, err
+	}
+
+	err = as.storage.CheckUserBidAuthor(r.Context(), bidID, username)
+	if err != nil {
+		return models.AuctionPrice{}, err
+	}
+
+	tenderID, err := as.storage.GetBidTenderID(r.Context(), bidID)
+	if err != nil {
+		return models.AuctionPrice{}, err
+	}
+
+	var newPrice models.AuctionPrice
+	err = as.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		err = as.storage.LockAuction(ctx, tenderID)
+		if err != nil {
+			return err
+		}
+
+		var auction models.Auction
+		auction, err = as.storage.GetAuction(ctx, tenderID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if auction.StatusAt(now) != models.AuctionStatusRunning {
+			return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.AuctionNotRunning}
+		}
+
+		if !priceAllowed(&auction, price) {
+			return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.PriceTooHigh}
+		}
+
+		newPrice, err = as.storage.CreateAuctionPrice(ctx, tenderID, bidID, price, now)
+		if err != nil {
+			return err
+		}
+
+		err = appendEvent(ctx, as.storage, models.AuctionPriceSubmitted, models.BidAggregate, newPrice.BidID, username, "", newPrice)
+		if err != nil {
+			return err
+		}
+
+		// Защита от снайпинга: цена в последние секунды продлевает аукцион.
+		extension := time.Duration(auction.ExtensionSeconds) * time.Second
+		if extension > 0 && auction.EndsAt.Sub(now) < extension {
+			auction.EndsAt = now.Add(extension)
+			err = as.storage.ExtendAuction(ctx, tenderID, auction.EndsAt)
+			if err != nil {
+				return err
+			}
+
+			return appendEvent(ctx, as.storage, models.AuctionExtended, models.TenderAggregate, auction.TenderID, username, "", auction)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.AuctionPrice{}, err
+	}
+
+	return newPrice, nil
+}
+
+// priceAllowed Сравнивает в копейках, чтобы шаг не ломался на округлении float.
+func priceAllowed(auction *models.Auction, price float64) bool {
+	cents := func(v float64) int64 { return int64(math.Round(v * 100)) }
+
+	if price <= 0 {
+		return false
+	}
+	if auction.BestPrice != nil {
+		return cents(price) <= cents(*auction.BestPrice)-cents(auction.MinStep)
+	}
+	if auction.StartPrice != nil {
+		return cents(price) <= cents(*auction.StartPrice)
+	}
+	return true
+}
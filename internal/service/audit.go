@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+type AuditService struct {
+	storage storage.Storage
+}
+
+func NewAuditService(s storage.Storage) *AuditService {
+	return &AuditService{storage: s}
+}
+
+// Record Добавляет запись в конец цепочки аудита. Организация определяется по актору.
+func (as *AuditService) Record(ctx context.Context, entry *models.AuditEntry) error {
+	return as.storage.WithTx(ctx, func(ctx context.Context) error {
+		if err := as.storage.LockAuditLog(ctx); err != nil {
+			return err
+		}
+
+		prevHash, err := as.storage.GetLastAuditHash(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Предложения создаются по authorId, поэтому актор может прийти идентификатором.
+		if _, parseErr := uuid.Parse(entry.Actor); parseErr == nil {
+			username, err := as.storage.GetUsernameByID(ctx, entry.Actor)
+			if err != nil {
+				return err
+			}
+			if username != "" {
+				entry.Actor = username
+			}
+		}
+
+		orgID, err := as.storage.GetUserOrganizationID(ctx, entry.Actor)
+		if err != nil {
+			return err
+		}
+
+		entry.ID = uuid.New()
+		entry.OrganizationID = orgID
+		entry.PrevHash = prevHash
+		entry.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+		entry.Hash = entry.ComputeHash()
+
+		return as.storage.AppendAuditEntry(ctx, entry)
+	})
+}
+
+// GetAuditLog Журнал организации доступен только её Ответственным.
+func (as *AuditService) GetAuditLog(r *http.Request, username string, offset, limit int32) ([]models.AuditEntry, error) {
+	err := as.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := as.storage.GetUserOrganizationID(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+	if !orgID.Valid {
+		return nil, util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
+	}
+
+	entries, err := as.storage.GetAuditLog(r.Context(), orgID.UUID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Удаление, перестановка или переписывание записей с пересчётом хешей рвут связь
+	// со следующей записью, даже если хеш каждой записи сходится сам по себе.
+	for i := range entries {
+		entries[i].Verified = entries[i].Hash == entries[i].ComputeHash()
+		entries[i].Linked = entries[i].PrevHash == entries[i].ChainPrevHash
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+// newBatch Заготовка итога пакета из n операций.
+func newBatch(n int, atomic bool, maxOperations int) (models.BatchResult, error) {
+	if n == 0 || n > maxOperations {
+		return models.BatchResult{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidBatch}
+	}
+
+	return models.BatchResult{Atomic: atomic, Results: make([]models.BatchItemResult, n)}, nil
+}
+
+// batchItemResult Переводит итог операции в ответ так же, как контроллер переводит ошибки одиночных запросов.
+func batchItemResult(index int, result any, err error) models.BatchItemResult {
+	if err == nil {
+		return models.BatchItemResult{Index: index, Success: true, Status: http.StatusOK, Result: result}
+	}
+
+	var customErr util.MyResponseError
+	if errors.As(err, &customErr) {
+		return models.BatchItemResult{Index: index, Status: customErr.Status, Error: customErr.Msg}
+	}
+
+	return models.BatchItemResult{Index: index, Status: http.StatusInternalServerError, Error: err.Error()}
+}
+
+// runBatch Выполняет операции по порядку. Без atomic каждая операция фиксируется сама, как одиночный запрос.
+// С atomic все операции идут в одной транзакции: первая ошибка отменяет уже выполненные, остальные не выполняются.
+// Одиночные методы сервиса берут контекст из запроса, поэтому в атомарном режиме им передаётся запрос
+// с контекстом транзакции, а их собственные WithTx переиспользуют её.
+func runBatch(r *http.Request, tx storage.Transactor, batch *models.BatchResult, exec func(r *http.Request, i int) (any, error)) error {
+	if !batch.Atomic {
+		for i := range batch.Results {
+			result, err := exec(r, i)
+			batch.Results[i] = batchItemResult(i, result, err)
+		}
+		batch.Committed = true
+		return nil
+	}
+
+	failed := -1
+	err := tx.WithTx(r.Context(), func(ctx context.Context) error {
+		txRequest := r.WithContext(ctx)
+		for i := range batch.Results {
+			result, err := exec(txRequest, i)
+			batch.Results[i] = batchItemResult(i, result, err)
+			if err != nil {
+				failed = i
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		batch.Committed = true
+		return nil
+	}
+	if failed < 0 {
+		return err
+	}
+
+	for i := range batch.Results {
+		if i != failed {
+			batch.Results[i] = models.BatchItemResult{Index: i, Status: http.StatusFailedDependency, Error: util.BatchAborted}
+		}
+	}
+
+	return nil
+}
+
+// ExecuteTenderBatch Публикация, закрытие и создание тендеров пакетом. Каждая операция выполняется
+// одиночным методом сервиса со всеми его проверками. Создаваемые тендеры принадлежат вызывающему.
+func (ts *TenderService) ExecuteTenderBatch(r *http.Request, ops []models.TenderOperation, atomic bool, username string) (models.BatchResult, error) {
+	batch, err := newBatch(len(ops), atomic, ts.batch.MaxOperations)
+	if err != nil {
+		return models.BatchResult{}, err
+	}
+
+	err = runBatch(r, ts.storage, &batch, func(r *http.Request, i int) (any, error) {
+		op := ops[i]
+		switch op.Op {
+		case models.BatchCreate:
+			if op.Tender == nil {
+				break
+			}
+			tender := *op.Tender
+			tender.CreatorUsername = username
+			return ts.CreateTender(r, &tender)
+		case models.BatchPublish:
+			return ts.UpdateTenderStatus(r, op.TenderID, string(models.Published), username)
+		case models.BatchClose:
+			return ts.UpdateTenderStatus(r, op.TenderID, string(models.Closed), username)
+		}
+
+		return nil, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidBatchOp}
+	})
+	if err != nil {
+		return models.BatchResult{}, err
+	}
+
+	return batch, nil
+}
+
+// CloseExpiredTenders Закрывает опубликованные тендеры, созданные раньше before, от имени их авторов
+// с проверками UpdateTenderStatus. Тендер, который закрыть нельзя, остаётся с ошибкой в итоге.
+func (ts *TenderService) CloseExpiredTenders(r *http.Request, before time.Time) (models.BatchResult, []models.Tender, error) {
+	tenders, err := ts.storage.GetExpiredTenders(r.Context(), before)
+	if err != nil {
+		return models.BatchResult{}, nil, err
+	}
+
+	batch := models.BatchResult{Results: make([]models.BatchItemResult, len(tenders))}
+	err = runBatch(r, ts.storage, &batch, func(r *http.Request, i int) (any, error) {
+		return ts.UpdateTenderStatus(r, tenders[i].ID.String(), string(models.Closed), tenders[i].CreatorUsername)
+	})
+	if err != nil {
+		return models.BatchResult{}, nil, err
+	}
+
+	return batch, tenders, nil
+}
+
+// ExecuteBidBatch Решения по предложениям пакетом с проверками SubmitBidDecision.
+func (bs *BidService) ExecuteBidBatch(r *http.Request, ops []models.BidOperation, atomic bool, username string) (models.BatchResult, error) {
+	batch, err := newBatch(len(ops), atomic, bs.batch.MaxOperations)
+	if err != nil {
+		return models.BatchResult{}, err
+	}
+
+	err = runBatch(r, bs.storage, &batch, func(r *http.Request, i int) (any, error) {
+		op := ops[i]
+		if op.Op == models.BatchDecide && (op.Decision == models.DecisionApproved || op.Decision == models.DecisionRejected) {
+			return bs.SubmitBidDecision(r, op.BidID, string(op.Decision), username)
+		}
+
+		return nil, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidBatchOp}
+	})
+	if err != nil {
+		return models.BatchResult{}, err
+	}
+
+	return batch, nil
+}
@@ -1,18 +1,28 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"github.com/google/uuid"
 	"net/http"
+	"time"
 	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/sealing"
 	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
 )
 
 type BidService struct {
-	storage storage.Storage
+	storage     storage.Storage
+	sealer      *sealing.Sealer
+	negotiation *config.NegotiationConfig
+	batch       *config.BatchConfig
 }
 
-func NewBidService(s storage.Storage) *BidService {
-	return &BidService{storage: s}
+func NewBidService(s storage.Storage, sealer *sealing.Sealer, negotiation *config.NegotiationConfig, batch *config.BatchConfig) *BidService {
+	return &BidService{storage: s, sealer: sealer, negotiation: negotiation, batch: batch}
 }
 
 func (bs *BidService) CreateBid(r *http.Request, bid *models.Bid) (models.Bid, error) {
@@ -31,7 +41,62 @@ func (bs *BidService) CreateBid(r *http.Request, bid *models.Bid) (models.Bid, e
 		return emptyBid, err
 	}
 
-	return bs.storage.CreateBid(r.Context(), bid)
+	err = bs.storage.CheckTenderAcceptsBids(r.Context(), bid.TenderID.String())
+	if err != nil {
+		return emptyBid, err
+	}
+
+	err = bs.storage.CheckBidderInvited(r.Context(), bid.TenderID.String(), bid.AuthorID.String())
+	if err != nil {
+		return emptyBid, err
+	}
+
+	err = bs.storage.CheckBidderNotDebarred(r.Context(), bid.TenderID.String(), bid.AuthorID.String())
+	if err != nil {
+		return emptyBid, err
+	}
+
+	err = bs.checkBidderEligible(r.Context(), bid.TenderID.String(), bid.AuthorID.String())
+	if err != nil {
+		return emptyBid, err
+	}
+
+	err = bs.sealBid(r.Context(), bid)
+	if err != nil {
+		return emptyBid, err
+	}
+
+	// Предложение может покрывать один или несколько открытых лотов тендера.
+	if len(bid.LotIDs) > 0 {
+		bid.LotIDs = uniqueLotIDs(bid.LotIDs)
+		err = bs.storage.CheckLotsOpen(r.Context(), bid.TenderID.String(), bid.LotIDs)
+		if err != nil {
+			return emptyBid, err
+		}
+	}
+
+	var newBid models.Bid
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newBid, err = bs.storage.CreateBid(ctx, bid)
+		if err != nil {
+			return err
+		}
+
+		if len(bid.LotIDs) > 0 {
+			err = bs.storage.AddBidLots(ctx, newBid.ID, bid.LotIDs)
+			if err != nil {
+				return err
+			}
+			newBid.LotIDs = bid.LotIDs
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidCreated, models.BidAggregate, newBid.ID, newBid.AuthorUsername, "", newBid)
+	})
+	if err != nil {
+		return emptyBid, err
+	}
+
+	return newBid, nil
 }
 
 func (bs *BidService) GetUserBids(r *http.Request, offset, limit int32, username string) ([]models.Bid, error) {
@@ -43,24 +108,38 @@ func (bs *BidService) GetUserBids(r *http.Request, offset, limit int32, username
 	return bs.storage.GetUserBids(r.Context(), offset, limit, username)
 }
 
+func (bs *BidService) checkTenderResponsible(r *http.Request, tenderID, username string) error {
+	err := bs.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return err
+	}
+
+	return bs.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+}
+
 // GetBidsForTender Увидеть может только Ответственный.
 func (bs *BidService) GetBidsForTender(r *http.Request, tenderID string, offset, limit int32, username string) ([]models.Bid, error) {
-	err := bs.storage.CheckTenderExists(r.Context(), tenderID)
+	err := bs.checkTenderResponsible(r, tenderID, username)
 	if err != nil {
 		return nil, err
 	}
 
-	err = bs.storage.CheckUserExists(r.Context(), username)
+	bids, err := bs.storage.GetBidsForTender(r.Context(), tenderID, offset, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	err = bs.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	err = bs.fillAuthorReputations(r.Context(), bids)
 	if err != nil {
 		return nil, err
 	}
 
-	return bs.storage.GetBidsForTender(r.Context(), tenderID, offset, limit)
+	return bids, nil
 }
 
 func (bs *BidService) GetBidStatus(r *http.Request, bidID, username string) (string, error) {
@@ -99,29 +178,92 @@ func (bs *BidService) UpdateBidStatus(r *http.Request, bidID, status, username s
 		return models.Bid{}, errors.Join(errAuthor, errResponsible)
 	}
 
-	return bs.storage.UpdateBidStatus(r.Context(), bidID, status, username)
+	var updatedBid models.Bid
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		updatedBid, err = bs.storage.UpdateBidStatus(ctx, bidID, status, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidStatusChanged, models.BidAggregate, updatedBid.ID, username, "", updatedBid)
+	})
+	if err != nil {
+		return emptyBid, err
+	}
+
+	if errAuthor != nil {
+		err = bs.maskCommercial(r.Context(), &updatedBid)
+		if err != nil {
+			return emptyBid, err
+		}
+	}
+
+	return updatedBid, nil
+}
+
+// checkBidEditable Только Автор может изменить предложение, пока оно не запечатано и тендер принимает предложения.
+// Предложения запечатанного тендера не меняются и после вскрытия: Ответственный уже видел все цены.
+// Те же правила действуют для вложений предложения.
+func checkBidEditable(ctx context.Context, s storage.Storage, bidID, username string) error {
+	err := s.CheckBidExists(ctx, bidID)
+	if err != nil {
+		return err
+	}
+
+	err = s.CheckUserExists(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	err = s.CheckUserBidAuthor(ctx, bidID, username)
+	if err != nil {
+		return err
+	}
+
+	err = s.CheckBidUnsealed(ctx, bidID)
+	if err != nil {
+		return err
+	}
+
+	tenderID, err := s.GetBidTenderID(ctx, bidID)
+	if err != nil {
+		return err
+	}
+
+	sealing, err := s.GetTenderSealing(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+
+	if sealing.Sealed && sealing.BiddingClosed(time.Now()) {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.BiddingClosed}
+	}
+
+	return s.CheckTenderAcceptsBids(ctx, tenderID)
 }
 
 // EditBid Только Автор может изменить Bid.
 func (bs *BidService) EditBid(r *http.Request, bid *models.Bid, bidID, username string) (models.Bid, error) {
 	var emptyBid models.Bid
-	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	err := checkBidEditable(r.Context(), bs.storage, bidID, username)
 	if err != nil {
 		return emptyBid, err
 	}
 
-	err = bs.storage.CheckUserExists(r.Context(), username)
-	if err != nil {
-		return emptyBid, err
-	}
+	var updatedBid models.Bid
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		updatedBid, err = bs.storage.EditBid(ctx, bid, bidID, username)
+		if err != nil {
+			return err
+		}
 
-	// Только Автор может изменить.
-	err = bs.storage.CheckUserBidAuthor(r.Context(), bidID, username)
+		return appendEvent(ctx, bs.storage, models.BidEdited, models.BidAggregate, updatedBid.ID, username, "", updatedBid)
+	})
 	if err != nil {
 		return emptyBid, err
 	}
 
-	return bs.storage.EditBid(r.Context(), bid, bidID, username)
+	return updatedBid, nil
 }
 
 // SubmitBidDecision Только Ответственный за тендер может отправить решение.
@@ -142,17 +284,52 @@ func (bs *BidService) SubmitBidDecision(r *http.Request, bidID, decision, userna
 		return emptyBid, err
 	}
 
-	return bs.storage.SubmitBidDecision(r.Context(), bidID, decision, username)
+	err = bs.storage.CheckBidUnsealed(r.Context(), bidID)
+	if err != nil {
+		return emptyBid, err
+	}
+
+	// В двухконвертном тендере решение принимается только по раскрытой коммерческой части.
+	err = bs.storage.CheckBidCommercialVisible(r.Context(), bidID)
+	if err != nil {
+		return emptyBid, err
+	}
+
+	var updatedBid models.Bid
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		updatedBid, err = bs.storage.SubmitBidDecision(ctx, bidID, decision, username)
+		if err != nil {
+			return err
+		}
+
+		// Решение сохраняется вместе с оценками, на которых оно основано.
+		_, err = bs.storage.CreateDecisionRecord(ctx, bidID, decision, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidDecisionSubmitted, models.BidAggregate, updatedBid.ID, username, "", updatedBid)
+	})
+	if err != nil {
+		return emptyBid, err
+	}
+
+	return updatedBid, nil
 }
 
 // SubmitBidFeedback Только Ответственный за тендер может отправить Отзыв.
-func (bs *BidService) SubmitBidFeedback(r *http.Request, bidID, bidFeedback, username string) (models.Bid, error) {
+// Оценка и признак исполнения необязательны и учитываются в репутации автора предложения.
+func (bs *BidService) SubmitBidFeedback(r *http.Request, bidID, bidFeedback, username string, rating *int, completed *bool) (models.Bid, error) {
 	var emptyBid models.Bid
 	err := bs.storage.CheckBidExists(r.Context(), bidID)
 	if err != nil {
 		return emptyBid, err
 	}
 
+	if rating != nil && (*rating < 1 || *rating > 5) {
+		return emptyBid, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidRating}
+	}
+
 	err = bs.storage.CheckUserExists(r.Context(), username)
 	if err != nil {
 		return emptyBid, err
@@ -163,51 +340,134 @@ func (bs *BidService) SubmitBidFeedback(r *http.Request, bidID, bidFeedback, use
 		return emptyBid, err
 	}
 
-	return bs.storage.SubmitBidFeedback(r.Context(), bidID, bidFeedback, username)
+	var updatedBid models.Bid
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		review := models.Review{
+			BidID:          uuid.MustParse(bidID),
+			AuthorUsername: username,
+			Description:    bidFeedback,
+			Rating:         rating,
+			Completed:      completed,
+		}
+		updatedBid, err = bs.storage.SubmitBidFeedback(ctx, &review)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidFeedbackLeft, models.BidAggregate, updatedBid.ID, username, bidFeedback, updatedBid)
+	})
+	if err != nil {
+		return emptyBid, err
+	}
+
+	err = bs.maskCommercial(r.Context(), &updatedBid)
+	if err != nil {
+		return emptyBid, err
+	}
+
+	return updatedBid, nil
+}
+
+// checkAuthorReviewsAccess Только Ответственный за тендер может посмотреть прошлые отзывы на предложения автора, который создал предложение для его тендера.
+func (bs *BidService) checkAuthorReviewsAccess(r *http.Request, tenderID, authorUsername, requesterUsername string) error {
+	err := bs.checkTenderResponsible(r, tenderID, requesterUsername)
+	if err != nil {
+		return err
+	}
+
+	authorBidIDs, err := bs.storage.GetUserTenderBidIDs(r.Context(), tenderID, authorUsername)
+	if err != nil {
+		return err
+	}
+	if len(authorBidIDs) == 0 {
+		return util.MyResponseError{Status: http.StatusNotFound, Msg: util.AuthorHasNoBid}
+	}
+
+	return nil
 }
 
-// GetBidReviews Только Ответственный за тендер может посмотреть прошлые отзывы на предложения автора, который создал предложение для его тендера.
 func (bs *BidService) GetBidReviews(r *http.Request, tenderID, authorUsername, requesterUsername string, offset, limit int32) ([]models.Review, error) {
-	err := bs.storage.CheckTenderExists(r.Context(), tenderID)
+	err := bs.checkAuthorReviewsAccess(r, tenderID, authorUsername, requesterUsername)
 	if err != nil {
 		return nil, err
 	}
 
-	err = bs.storage.CheckUserExists(r.Context(), requesterUsername)
+	return bs.storage.GetBidReviews(r.Context(), tenderID, authorUsername, offset, limit)
+}
+
+// checkBidReviewsAccess Отзывы на предложение видят его автор и Ответственные за тендер.
+func (bs *BidService) checkBidReviewsAccess(r *http.Request, bidID, username string) error {
+	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	tenderID, err := bs.storage.GetBidTenderID(r.Context(), bidID)
+	if err != nil {
+		return err
+	}
+
+	errAuthor := bs.storage.CheckUserBidAuthor(r.Context(), bidID, username)
+
+	errResponsible := bs.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+
+	if errAuthor != nil && errResponsible != nil {
+		return errors.Join(errAuthor, errResponsible)
 	}
 
-	err = bs.storage.ValidateUserResponsible(r.Context(), tenderID, requesterUsername)
+	return nil
+}
+
+func (bs *BidService) GetReviewsForBid(r *http.Request, bidID, username string, offset, limit int32) ([]models.Review, error) {
+	err := bs.checkBidReviewsAccess(r, bidID, username)
 	if err != nil {
 		return nil, err
 	}
 
-	return bs.storage.GetBidReviews(r.Context(), authorUsername, offset, limit)
+	return bs.storage.GetReviewsForBid(r.Context(), bidID, offset, limit)
 }
 
-// RollbackBid Только Автор Предложения может совершить откат.
+// RollbackBid Только Автор Предложения может совершить откат и только пока его можно править:
+// откат восстанавливает цену и оба конверта, и после квалификации заменил бы оценённое предложение.
 func (bs *BidService) RollbackBid(r *http.Request, bidID string, version int32, username string) (models.Bid, error) {
 	var emptyBid models.Bid
-	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	err := checkBidEditable(r.Context(), bs.storage, bidID, username)
 	if err != nil {
 		return emptyBid, err
 	}
 
-	err = bs.storage.CheckUserExists(r.Context(), username)
+	err = bs.storage.CheckBidVersionExists(r.Context(), bidID, version)
 	if err != nil {
 		return emptyBid, err
 	}
 
-	err = bs.storage.CheckUserBidAuthor(r.Context(), bidID, username)
+	err = bs.storage.CheckBidVersionUnsealed(r.Context(), bidID, version)
 	if err != nil {
 		return emptyBid, err
 	}
 
-	err = bs.storage.CheckBidVersionExists(r.Context(), bidID, version)
+	var updatedBid models.Bid
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		updatedBid, err = bs.storage.RollbackBid(ctx, bidID, version, username)
+		if err != nil {
+			return err
+		}
+
+		err = bs.storage.RestoreAttachments(ctx, models.BidAttachment, bidID, version, updatedBid.Version)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidRolledBack, models.BidAggregate, updatedBid.ID, username, fmt.Sprintf("rollback to version %d", version), updatedBid)
+	})
 	if err != nil {
 		return emptyBid, err
 	}
 
-	return bs.storage.RollbackBid(r.Context(), bidID, version, username)
+	return updatedBid, nil
 }
\ No newline at end of file
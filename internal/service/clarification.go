@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// AskClarification Любой сотрудник может задать вопрос по опубликованному тендеру.
+func (ts *TenderService) AskClarification(r *http.Request, tenderID, question, username string) (models.Clarification, error) {
+	var emptyClarification models.Clarification
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return emptyClarification, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return emptyClarification, err
+	}
+
+	err = ts.storage.CheckTenderPublished(r.Context(), tenderID, username)
+	if err != nil {
+		var respErr util.MyResponseError
+		if errors.As(err, &respErr) {
+			return emptyClarification, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.TenderNotPublished}
+		}
+		return emptyClarification, err
+	}
+
+	clarification := models.Clarification{
+		TenderID:       uuid.MustParse(tenderID),
+		AuthorUsername: username,
+		Question:       question,
+	}
+
+	var newClarification models.Clarification
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newClarification, err = ts.storage.CreateClarification(ctx, &clarification)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.ClarificationAsked, models.TenderAggregate, newClarification.TenderID, username, "", newClarification)
+	})
+	if err != nil {
+		return emptyClarification, err
+	}
+
+	return newClarification, nil
+}
+
+// GetClarifications Ответственный видит все вопросы, остальные - свои и опубликованные ответы, если тендер им виден.
+func (ts *TenderService) GetClarifications(r *http.Request, tenderID, username string, offset, limit int32) ([]models.Clarification, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	isResponsible := ts.storage.ValidateUserResponsible(r.Context(), tenderID, username) == nil
+
+	if !isResponsible {
+		err = ts.storage.CheckTenderPublished(r.Context(), tenderID, username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ts.storage.GetClarifications(r.Context(), tenderID, username, isResponsible, offset, limit)
+}
+
+// AnswerClarification Только Ответственный за тендер может ответить. Публичный ответ может
+// создать новую версию тендера, тогда ранее поданные предложения помечаются как поданные до разъяснения.
+func (ts *TenderService) AnswerClarification(r *http.Request, tenderID, clarificationID, answer, username string, public, bumpVersion bool) (models.Clarification, error) {
+	var emptyClarification models.Clarification
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return emptyClarification, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return emptyClarification, err
+	}
+
+	err = ts.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return emptyClarification, err
+	}
+
+	err = ts.storage.CheckClarificationExists(r.Context(), tenderID, clarificationID)
+	if err != nil {
+		return emptyClarification, err
+	}
+
+	clarification := models.Clarification{
+		ID:         uuid.MustParse(clarificationID),
+		Answer:     &answer,
+		AnsweredBy: &username,
+		Visibility: models.PrivateClarification,
+	}
+	if public {
+		clarification.Visibility = models.PublicClarification
+	}
+
+	var answered models.Clarification
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		if public && bumpVersion {
+			var version int
+			version, err = ts.storage.BumpTenderVersion(ctx, tenderID)
+			if err != nil {
+				return err
+			}
+			clarification.TenderVersion = &version
+		}
+
+		answered, err = ts.storage.AnswerClarification(ctx, &clarification)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.ClarificationAnswered, models.TenderAggregate, answered.TenderID, username, "", answered)
+	})
+	if err != nil {
+		return emptyClarification, err
+	}
+
+	return answered, nil
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode/utf8"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+// maxDeliverableLength Предел длины результата этапа, как в схеме базы.
+const maxDeliverableLength = 100
+
+// supplierMilestoneTransitions Переходы этапа, которые отмечает поставщик. Принятие и отклонение - за заказчиком.
+var supplierMilestoneTransitions = map[models.MilestoneStatus][]models.MilestoneStatus{
+	models.MilestoneStatusPending:    {models.MilestoneStatusInProgress, models.MilestoneStatusSubmitted},
+	models.MilestoneStatusInProgress: {models.MilestoneStatusSubmitted},
+	models.MilestoneStatusRejected:   {models.MilestoneStatusInProgress, models.MilestoneStatusSubmitted},
+}
+
+type ContractService struct {
+	storage storage.Storage
+}
+
+func NewContractService(s storage.Storage) *ContractService {
+	return &ContractService{storage: s}
+}
+
+// getContractAs Возвращает контракт, если пользователь - его сторона. Заказчик - Ответственный организации тендера,
+// поставщик - автор предложения. customer показывает, на чьей стороне пользователь.
+func (cs *ContractService) getContractAs(ctx context.Context, contractID, username string) (contract models.Contract, customer bool, err error) {
+	contract, err = cs.storage.GetContract(ctx, contractID)
+	if err != nil {
+		return models.Contract{}, false, err
+	}
+
+	err = cs.storage.CheckUserExists(ctx, username)
+	if err != nil {
+		return models.Contract{}, false, err
+	}
+
+	if contract.SupplierUsername == username {
+		return contract, false, nil
+	}
+
+	err = cs.storage.ValidateUserResponsibleOrgID(ctx, contract.OrganizationID.String(), username)
+	if err != nil {
+		return models.Contract{}, false, err
+	}
+
+	return contract, true, nil
+}
+
+// getActiveContractAs То же, что getContractAs, но только для действующего контракта и нужной стороны.
+func (cs *ContractService) getActiveContractAs(ctx context.Context, contractID, username string, customer bool) (models.Contract, error) {
+	contract, isCustomer, err := cs.getContractAs(ctx, contractID, username)
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	if isCustomer != customer {
+		return models.Contract{}, util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
+	}
+
+	if contract.Status != models.ContractStatusActive {
+		return models.Contract{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.ContractNotActive}
+	}
+
+	return contract, nil
+}
+
+// CreateContract Ответственный за тендер заключает контракт по выигравшему предложению.
+func (cs *ContractService) CreateContract(r *http.Request, bidID, username string) (models.Contract, error) {
+	err := cs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	err = cs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	tenderID, err := cs.storage.GetBidTenderID(r.Context(), bidID)
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	err = cs.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	err = cs.storage.CheckBidWon(r.Context(), bidID)
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	var contract models.Contract
+	err = cs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		contract, err = cs.storage.CreateContract(ctx, bidID, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, cs.storage, models.ContractCreated, models.ContractAggregate, contract.ID, username, "", contract)
+	})
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	return contract, nil
+}
+
+func (cs *ContractService) GetContract(r *http.Request, contractID, username string) (models.Contract, error) {
+	contract, _, err := cs.getContractAs(r.Context(), contractID, username)
+	return contract, err
+}
+
+func (cs *ContractService) GetUserContracts(r *http.Request, username string, offset, limit int32) ([]models.Contract, error) {
+	err := cs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	return cs.storage.GetUserContracts(r.Context(), username, offset, limit)
+}
+
+// AddMilestone Этапы добавляет заказчик. Сумма этапов не может превышать сумму контракта, если она известна.
+func (cs *ContractService) AddMilestone(r *http.Request, milestone *models.Milestone, username string) (models.Milestone, error) {
+	contractID := milestone.ContractID.String()
+	contract, err := cs.getActiveContractAs(r.Context(), contractID, username, true)
+	if err != nil {
+		return models.Milestone{}, err
+	}
+
+	milestone.Deliverable = strings.TrimSpace(milestone.Deliverable)
+	if milestone.Deliverable == "" || utf8.RuneCountInString(milestone.Deliverable) > maxDeliverableLength ||
+		milestone.Amount < 0 || milestone.DueDate.IsZero() {
+		return models.Milestone{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidMilestone}
+	}
+
+	if contract.Amount != nil {
+		amount, err := cs.storage.GetMilestonesAmount(r.Context(), contractID)
+		if err != nil {
+			return models.Milestone{}, err
+		}
+		if amount+milestone.Amount > *contract.Amount {
+			return models.Milestone{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.MilestonesExceedAmount}
+		}
+	}
+
+	var newMilestone models.Milestone
+	err = cs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newMilestone, err = cs.storage.AddMilestone(ctx, milestone)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, cs.storage, models.MilestoneAdded, models.ContractAggregate, contract.ID, username, "", newMilestone)
+	})
+	if err != nil {
+		return models.Milestone{}, err
+	}
+
+	return newMilestone, nil
+}
+
+func (cs *ContractService) GetMilestones(r *http.Request, contractID, username string) ([]models.Milestone, error) {
+	_, _, err := cs.getContractAs(r.Context(), contractID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return cs.storage.GetMilestones(r.Context(), contractID)
+}
+
+// UpdateMilestoneStatus Поставщик отмечает начало работ и сдачу этапа. Отклонённый этап можно сдать повторно.
+func (cs *ContractService) UpdateMilestoneStatus(r *http.Request, contractID, milestoneID string, status models.MilestoneStatus, comment *string, username string) (models.Milestone, error) {
+	contract, err := cs.getActiveContractAs(r.Context(), contractID, username, false)
+	if err != nil {
+		return models.Milestone{}, err
+	}
+
+	milestone, err := cs.storage.GetMilestone(r.Context(), contractID, milestoneID)
+	if err != nil {
+		return models.Milestone{}, err
+	}
+
+	if !slices.Contains(supplierMilestoneTransitions[milestone.Status], status) {
+		return models.Milestone{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.InvalidMilestoneStatus}
+	}
+
+	var updatedMilestone models.Milestone
+	err = cs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		updatedMilestone, err = cs.storage.UpdateMilestoneStatus(ctx, milestoneID, milestone.Status, status, comment)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, cs.storage, models.MilestoneUpdated, models.ContractAggregate, contract.ID, username, "", updatedMilestone)
+	})
+	if err != nil {
+		return models.Milestone{}, err
+	}
+
+	return updatedMilestone, nil
+}
+
+// DecideMilestone Заказчик принимает или отклоняет сданный этап.
+func (cs *ContractService) DecideMilestone(r *http.Request, contractID, milestoneID string, decision models.MilestoneStatus, comment *string, username string) (models.Milestone, error) {
+	contract, err := cs.getActiveContractAs(r.Context(), contractID, username, true)
+	if err != nil {
+		return models.Milestone{}, err
+	}
+
+	milestone, err := cs.storage.GetMilestone(r.Context(), contractID, milestoneID)
+	if err != nil {
+		return models.Milestone{}, err
+	}
+
+	if decision != models.MilestoneStatusAccepted && decision != models.MilestoneStatusRejected {
+		return models.Milestone{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidMilestoneStatus}
+	}
+
+	if milestone.Status != models.MilestoneStatusSubmitted {
+		return models.Milestone{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.InvalidMilestoneStatus}
+	}
+
+	var decidedMilestone models.Milestone
+	err = cs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		decidedMilestone, err = cs.storage.DecideMilestone(ctx, milestoneID, decision, comment, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, cs.storage, models.MilestoneDecided, models.ContractAggregate, contract.ID, username, "", decidedMilestone)
+	})
+	if err != nil {
+		return models.Milestone{}, err
+	}
+
+	return decidedMilestone, nil
+}
+
+// CloseContract Заказчик завершает контракт после приёмки всех этапов, которых должен быть хотя бы один,
+// или расторгает его.
+// Закрытие оставляет отзыв на предложение с итогом исполнения, который учитывается в репутации поставщика.
+func (cs *ContractService) CloseContract(r *http.Request, contractID string, status models.ContractStatus, feedback string, rating *int, username string) (models.Contract, error) {
+	contract, err := cs.getActiveContractAs(r.Context(), contractID, username, true)
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	feedback = strings.TrimSpace(feedback)
+	if feedback == "" || (status != models.ContractStatusCompleted && status != models.ContractStatusTerminated) {
+		return models.Contract{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidContractClose}
+	}
+
+	if rating != nil && (*rating < 1 || *rating > 5) {
+		return models.Contract{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidRating}
+	}
+
+	if status == models.ContractStatusCompleted {
+		err = cs.storage.CheckMilestonesAccepted(r.Context(), contractID)
+		if err != nil {
+			return models.Contract{}, err
+		}
+	}
+
+	completed := status == models.ContractStatusCompleted
+	review := models.Review{
+		BidID:          contract.BidID,
+		AuthorUsername: username,
+		Description:    feedback,
+		Rating:         rating,
+		Completed:      &completed,
+	}
+
+	var closedContract models.Contract
+	err = cs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		var newReview models.Review
+		newReview, err = cs.storage.CreateReview(ctx, &review)
+		if err != nil {
+			return err
+		}
+
+		closedContract, err = cs.storage.CloseContract(ctx, contractID, status, newReview.ID, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, cs.storage, models.ContractClosed, models.ContractAggregate, closedContract.ID, username, feedback, closedContract)
+	})
+	if err != nil {
+		return models.Contract{}, err
+	}
+
+	return closedContract, nil
+}
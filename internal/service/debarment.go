@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"strings"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// checkDebarmentAccess Отстранениями организации управляют её Ответственные,
+// отстранениями на площадке (пустой orgID) - администраторы площадки.
+func (orgs *OrganizationService) checkDebarmentAccess(ctx context.Context, orgID, username string) (uuid.NullUUID, error) {
+	err := orgs.storage.CheckUserExists(ctx, username)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	if orgID == "" {
+		if !orgs.platform.IsAdmin(username) {
+			return uuid.NullUUID{}, util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
+		}
+		return uuid.NullUUID{}, nil
+	}
+
+	err = orgs.storage.CheckOrganizationExists(ctx, orgID)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	err = orgs.storage.ValidateUserResponsibleOrgID(ctx, orgID, username)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	parsedOrgID, err := uuid.Parse(orgID)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	return uuid.NullUUID{UUID: parsedOrgID, Valid: true}, nil
+}
+
+// DebarSupplier Отстраняет организацию поставщика и/или сотрудника на период. Утвердившим
+// записывается пользователь, выдавший отстранение.
+func (orgs *OrganizationService) DebarSupplier(r *http.Request, orgID string, debarment *models.Debarment, username string) (models.Debarment, error) {
+	var emptyDebarment models.Debarment
+	owner, err := orgs.checkDebarmentAccess(r.Context(), orgID, username)
+	if err != nil {
+		return emptyDebarment, err
+	}
+
+	now := time.Now().UTC()
+	if debarment.StartsAt.IsZero() {
+		debarment.StartsAt = now
+	}
+	debarment.Reason = strings.TrimSpace(debarment.Reason)
+	if debarment.Reason == "" || !debarment.EndsAt.After(debarment.StartsAt) || !debarment.EndsAt.After(now) ||
+		(!debarment.SupplierOrganizationID.Valid && debarment.SupplierUsername == nil) {
+		return emptyDebarment, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidDebarment}
+	}
+
+	// Организация не может отстранить саму себя.
+	if owner.Valid && debarment.SupplierOrganizationID == owner {
+		return emptyDebarment, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidDebarment}
+	}
+
+	if debarment.SupplierOrganizationID.Valid {
+		err = orgs.storage.CheckOrganizationExists(r.Context(), debarment.SupplierOrganizationID.UUID.String())
+		if err != nil {
+			return emptyDebarment, err
+		}
+	}
+
+	if debarment.SupplierUsername != nil {
+		err = orgs.storage.CheckUserExists(r.Context(), *debarment.SupplierUsername)
+		if err != nil {
+			return emptyDebarment, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidDebarment}
+		}
+	}
+
+	debarment.OrganizationID = owner
+	debarment.ApprovedBy = &username
+
+	var newDebarment models.Debarment
+	err = orgs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newDebarment, err = orgs.storage.CreateDebarment(ctx, debarment)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, orgs.storage, models.SupplierDebarred, models.DebarmentAggregate, newDebarment.ID, username, newDebarment.Reason, newDebarment)
+	})
+	if err != nil {
+		return emptyDebarment, err
+	}
+
+	return newDebarment, nil
+}
+
+func (orgs *OrganizationService) GetDebarments(r *http.Request, orgID, username string, activeOnly bool, offset, limit int32) ([]models.Debarment, error) {
+	owner, err := orgs.checkDebarmentAccess(r.Context(), orgID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs.storage.GetDebarments(r.Context(), owner, activeOnly, offset, limit)
+}
+
+// LiftDebarment Досрочно снимает отстранение. Запись сохраняется для истории.
+func (orgs *OrganizationService) LiftDebarment(r *http.Request, orgID, debarmentID, username string) (models.Debarment, error) {
+	owner, err := orgs.checkDebarmentAccess(r.Context(), orgID, username)
+	if err != nil {
+		return models.Debarment{}, err
+	}
+
+	var debarment models.Debarment
+	err = orgs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		debarment, err = orgs.storage.LiftDebarment(ctx, owner, debarmentID, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, orgs.storage, models.DebarmentLifted, models.DebarmentAggregate, debarment.ID, username, "", debarment)
+	})
+	if err != nil {
+		return models.Debarment{}, err
+	}
+
+	return debarment, nil
+}
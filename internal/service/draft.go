@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// checkBidDraftAccess Черновик предложения виден и меняется только Автором.
+func (bs *BidService) checkBidDraftAccess(ctx context.Context, bidID, username string) error {
+	err := bs.storage.CheckBidExists(ctx, bidID)
+	if err != nil {
+		return err
+	}
+
+	err = bs.storage.CheckUserExists(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	return bs.storage.CheckUserBidAuthor(ctx, bidID, username)
+}
+
+// SaveBidDraft Сохраняет черновик правки без новой версии предложения. Запечатанное предложение
+// не редактируется, поэтому и черновик для него не хранится.
+func (bs *BidService) SaveBidDraft(r *http.Request, draft *models.BidDraft, username string) (models.BidDraft, error) {
+	bidID := draft.BidID.String()
+	err := bs.checkBidDraftAccess(r.Context(), bidID, username)
+	if err != nil {
+		return models.BidDraft{}, err
+	}
+
+	err = bs.storage.CheckBidUnsealed(r.Context(), bidID)
+	if err != nil {
+		return models.BidDraft{}, err
+	}
+
+	if draft.Empty() {
+		return models.BidDraft{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.EmptyDraft}
+	}
+
+	draft.SavedBy = &username
+	return bs.storage.SaveBidDraft(r.Context(), draft)
+}
+
+func (bs *BidService) GetBidDraft(r *http.Request, bidID, username string) (models.BidDraft, error) {
+	err := bs.checkBidDraftAccess(r.Context(), bidID, username)
+	if err != nil {
+		return models.BidDraft{}, err
+	}
+
+	return bs.storage.GetBidDraft(r.Context(), bidID)
+}
+
+func (bs *BidService) DiscardBidDraft(r *http.Request, bidID, username string) (models.BidDraft, error) {
+	err := bs.checkBidDraftAccess(r.Context(), bidID, username)
+	if err != nil {
+		return models.BidDraft{}, err
+	}
+
+	return bs.storage.DeleteBidDraft(r.Context(), bidID)
+}
+
+// PublishBidDraft Применяет черновик одной правкой с теми же проверками, что и EditBid. Если после
+// сохранения черновика у предложения появилась новая версия, черновик нужно сохранить заново.
+func (bs *BidService) PublishBidDraft(r *http.Request, bidID, username string) (models.Bid, error) {
+	err := checkBidEditable(r.Context(), bs.storage, bidID, username)
+	if err != nil {
+		return models.Bid{}, err
+	}
+
+	draft, err := bs.storage.GetBidDraft(r.Context(), bidID)
+	if err != nil {
+		return models.Bid{}, err
+	}
+
+	if draft.BaseVersion != draft.CurrentVersion {
+		return models.Bid{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.DraftOutdated}
+	}
+
+	var updatedBid models.Bid
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		changes := draft.Changes()
+		updatedBid, err = bs.storage.EditBid(ctx, &changes, bidID, username)
+		if err != nil {
+			return err
+		}
+
+		_, err = bs.storage.DeleteBidDraft(ctx, bidID)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidEdited, models.BidAggregate, updatedBid.ID, username, "draft published", updatedBid)
+	})
+	if err != nil {
+		return models.Bid{}, err
+	}
+
+	return updatedBid, nil
+}
+
+// checkTenderDraftAccess Черновик тендера виден и меняется только Ответственными за тендер.
+func (ts *TenderService) checkTenderDraftAccess(ctx context.Context, tenderID, username string) error {
+	err := ts.storage.CheckTenderExists(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+
+	err = ts.storage.CheckUserExists(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	return ts.storage.ValidateUserResponsible(ctx, tenderID, username)
+}
+
+// SaveTenderDraft Сохраняет черновик правки без новой версии тендера.
+func (ts *TenderService) SaveTenderDraft(r *http.Request, draft *models.TenderDraft, username string) (models.TenderDraft, error) {
+	err := ts.checkTenderDraftAccess(r.Context(), draft.TenderID.String(), username)
+	if err != nil {
+		return models.TenderDraft{}, err
+	}
+
+	if draft.Empty() {
+		return models.TenderDraft{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.EmptyDraft}
+	}
+	if draft.ServiceType != nil {
+		if _, ok := models.ServiceTypeMap[*draft.ServiceType]; !ok {
+			return models.TenderDraft{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidDraft}
+		}
+	}
+
+	draft.SavedBy = &username
+	return ts.storage.SaveTenderDraft(r.Context(), draft)
+}
+
+func (ts *TenderService) GetTenderDraft(r *http.Request, tenderID, username string) (models.TenderDraft, error) {
+	err := ts.checkTenderDraftAccess(r.Context(), tenderID, username)
+	if err != nil {
+		return models.TenderDraft{}, err
+	}
+
+	return ts.storage.GetTenderDraft(r.Context(), tenderID)
+}
+
+func (ts *TenderService) DiscardTenderDraft(r *http.Request, tenderID, username string) (models.TenderDraft, error) {
+	err := ts.checkTenderDraftAccess(r.Context(), tenderID, username)
+	if err != nil {
+		return models.TenderDraft{}, err
+	}
+
+	return ts.storage.DeleteTenderDraft(r.Context(), tenderID)
+}
+
+// PublishTenderDraft Применяет черновик одной правкой, как EditTender.
+func (ts *TenderService) PublishTenderDraft(r *http.Request, tenderID, username string) (models.Tender, error) {
+	err := ts.checkTenderDraftAccess(r.Context(), tenderID, username)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	draft, err := ts.storage.GetTenderDraft(r.Context(), tenderID)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	if draft.BaseVersion != draft.CurrentVersion {
+		return models.Tender{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.DraftOutdated}
+	}
+
+	var newTender models.Tender
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		changes := draft.Changes()
+		newTender, err = ts.storage.EditTender(ctx, &changes, tenderID, username)
+		if err != nil {
+			return err
+		}
+
+		_, err = ts.storage.DeleteTenderDraft(ctx, tenderID)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.TenderEdited, models.TenderAggregate, newTender.ID, username, "draft published", newTender)
+	})
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	return newTender, nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"strings"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+// evaluateEligibility Проверяет организацию по требованиям тендера. Пользователь без организации
+// проходит только тендер без требований.
+func evaluateEligibility(ctx context.Context, s storage.Eligibility, tenderID string, orgID uuid.NullUUID) (models.EligibilityResult, error) {
+	rules, err := s.GetEligibilityRules(ctx, tenderID)
+	if err != nil {
+		return models.EligibilityResult{}, err
+	}
+
+	if !orgID.Valid {
+		if rules.Empty() {
+			return models.EligibilityResult{Eligible: true, Reasons: []string{}}, nil
+		}
+		return models.EligibilityResult{Reasons: []string{util.NoOrganization}}, nil
+	}
+
+	profile, err := s.GetSupplierProfile(ctx, orgID.UUID)
+	if err != nil {
+		return models.EligibilityResult{}, err
+	}
+
+	return rules.Evaluate(&profile), nil
+}
+
+// checkBidderEligible Отказ объясняет каждое невыполненное требование.
+func (bs *BidService) checkBidderEligible(ctx context.Context, tenderID, authorID string) error {
+	orgID, err := bs.storage.GetEmployeeOrganizationID(ctx, authorID)
+	if err != nil {
+		return err
+	}
+
+	result, err := evaluateEligibility(ctx, bs.storage, tenderID, orgID)
+	if err != nil {
+		return err
+	}
+
+	if !result.Eligible {
+		return util.MyResponseError{Status: http.StatusForbidden, Msg: util.NotEligible + ": " + strings.Join(result.Reasons, "; ")}
+	}
+
+	return nil
+}
+
+func validateEligibility(rules *models.EligibilityRules) error {
+	if rules.MinCompletedContracts < 0 || (rules.Certificate != nil && strings.TrimSpace(*rules.Certificate) == "") {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidEligibility}
+	}
+	for _, t := range rules.OrganizationTypes {
+		if t != models.IE && t != models.LLC && t != models.JSC {
+			return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidEligibility}
+		}
+	}
+
+	return nil
+}
+
+// SetEligibilityRules Только Ответственный за тендер задаёт требования. Они применяются к новым предложениям.
+func (ts *TenderService) SetEligibilityRules(r *http.Request, rules *models.EligibilityRules, username string) (models.EligibilityRules, error) {
+	var emptyRules models.EligibilityRules
+	tenderID := rules.TenderID.String()
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return emptyRules, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return emptyRules, err
+	}
+
+	err = ts.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return emptyRules, err
+	}
+
+	err = validateEligibility(rules)
+	if err != nil {
+		return emptyRules, err
+	}
+
+	var newRules models.EligibilityRules
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		rules.UpdatedBy = &username
+		newRules, err = ts.storage.SetEligibilityRules(ctx, rules)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.EligibilitySet, models.TenderAggregate, newRules.TenderID, username, "", newRules)
+	})
+	if err != nil {
+		return emptyRules, err
+	}
+
+	return newRules, nil
+}
+
+// GetEligibilityRules Требования видны всем, кому виден тендер.
+func (ts *TenderService) GetEligibilityRules(r *http.Request, tenderID, username string) (models.EligibilityRules, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return models.EligibilityRules{}, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.EligibilityRules{}, err
+	}
+
+	if ts.storage.CheckTenderPublished(r.Context(), tenderID, username) != nil {
+		err = ts.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+		if err != nil {
+			return models.EligibilityRules{}, err
+		}
+	}
+
+	return ts.storage.GetEligibilityRules(r.Context(), tenderID)
+}
+
+// CheckEligibility Пробная проверка: поставщик заранее узнаёт, примут ли предложение его организации.
+func (ts *TenderService) CheckEligibility(r *http.Request, tenderID, username string) (models.EligibilityResult, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return models.EligibilityResult{}, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.EligibilityResult{}, err
+	}
+
+	err = ts.storage.CheckTenderPublished(r.Context(), tenderID, username)
+	if err != nil {
+		return models.EligibilityResult{}, err
+	}
+
+	orgID, err := ts.storage.GetUserOrganizationID(r.Context(), username)
+	if err != nil {
+		return models.EligibilityResult{}, err
+	}
+
+	return evaluateEligibility(r.Context(), ts.storage, tenderID, orgID)
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// checkStageTransition Двухконвертный тендер проходит этапы строго по порядку:
+// Published -> TechnicalEvaluation -> CommercialEvaluation -> Closed.
+func (ts *TenderService) checkStageTransition(ctx context.Context, tenderID string, status models.TenderStatus) error {
+	stage, err := ts.storage.GetTenderStage(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+
+	invalid := util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidStatusChange}
+
+	if !stage.TwoEnvelope {
+		if status == models.TechnicalEvaluation || status == models.CommercialEvaluation {
+			return invalid
+		}
+		return nil
+	}
+
+	switch status {
+	case models.TechnicalEvaluation:
+		if stage.Status != models.Published {
+			return invalid
+		}
+	case models.CommercialEvaluation:
+		if stage.Status != models.TechnicalEvaluation {
+			return invalid
+		}
+		return ts.storage.CheckBidsQualified(ctx, tenderID)
+	case models.Closed:
+		if stage.Status != models.CommercialEvaluation {
+			return invalid
+		}
+	default:
+		// С этапов оценки и из закрытого тендера назад не возвращаются.
+		if stage.Status == models.TechnicalEvaluation || stage.Status == models.CommercialEvaluation || stage.Status == models.Closed {
+			return invalid
+		}
+	}
+
+	return nil
+}
+
+// checkRollbackStatus Откат, меняющий статус тендера, проходит те же проверки, что и смена статуса.
+// Лоты откат не восстанавливает, поэтому решения по ним должны остаться в силе.
+func (ts *TenderService) checkRollbackStatus(ctx context.Context, tenderID string, version int32) error {
+	err := ts.storage.CheckLotDecisionsKept(ctx, tenderID, version)
+	if err != nil {
+		return err
+	}
+
+	status, err := ts.storage.GetTenderVersionStatus(ctx, tenderID, version)
+	if err != nil {
+		return err
+	}
+
+	stage, err := ts.storage.GetTenderStage(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+
+	if status == stage.Status {
+		return nil
+	}
+
+	err = ts.checkStageTransition(ctx, tenderID, status)
+	if err != nil {
+		return err
+	}
+
+	if status == models.Closed {
+		return ts.storage.CheckTenderLotsResolved(ctx, tenderID)
+	}
+
+	return nil
+}
+
+// maskCommercial Скрывает цену и коммерческую часть, пока они не раскрыты Ответственным.
+func (bs *BidService) maskCommercial(ctx context.Context, bid *models.Bid) error {
+	err := bs.storage.CheckBidCommercialVisible(ctx, bid.ID.String())
+	if err == nil {
+		return nil
+	}
+
+	var respErr util.MyResponseError
+	if errors.As(err, &respErr) && respErr.Status == http.StatusConflict {
+		bid.Price, bid.CommercialProposal = nil, nil
+		return nil
+	}
+
+	return err
+}
+
+// QualifyBid Только Ответственный за тендер допускает или отклоняет предложение по технической части,
+// и только на этапе технической оценки.
+func (bs *BidService) QualifyBid(r *http.Request, bidID string, record *models.QualificationRecord, username string) (models.QualificationRecord, error) {
+	var emptyRecord models.QualificationRecord
+	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return emptyRecord, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return emptyRecord, err
+	}
+
+	err = bs.storage.ValidateUserResponsibleBidID(r.Context(), bidID, username)
+	if err != nil {
+		return emptyRecord, err
+	}
+
+	tenderID, err := bs.storage.GetBidTenderID(r.Context(), bidID)
+	if err != nil {
+		return emptyRecord, err
+	}
+
+	stage, err := bs.storage.GetTenderStage(r.Context(), tenderID)
+	if err != nil {
+		return emptyRecord, err
+	}
+
+	if !stage.TwoEnvelope || stage.Status != models.TechnicalEvaluation {
+		return emptyRecord, util.MyResponseError{Status: http.StatusConflict, Msg: util.QualificationNotOpen}
+	}
+
+	var newRecord models.QualificationRecord
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		record.Username = username
+		newRecord, err = bs.storage.QualifyBid(ctx, record)
+		if err != nil {
+			return err
+		}
+
+		var reason string
+		if newRecord.Reason != nil {
+			reason = *newRecord.Reason
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidQualified, models.BidAggregate, newRecord.BidID, username, reason, newRecord)
+	})
+	if err != nil {
+		return emptyRecord, err
+	}
+
+	return newRecord, nil
+}
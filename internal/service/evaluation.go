@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// validateCriteria Проверяет набор критериев и подставляет максимальную оценку по умолчанию.
+func validateCriteria(criteria []models.Criterion) error {
+	names := make(map[string]struct{}, len(criteria))
+	for i := range criteria {
+		if criteria[i].MaxScore == 0 {
+			criteria[i].MaxScore = 10
+		}
+		if _, ok := names[criteria[i].Name]; ok || criteria[i].Weight <= 0 || criteria[i].MaxScore < 0 {
+			return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidCriteria}
+		}
+		names[criteria[i].Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateScores Каждый критерий оценивается в запросе один раз: повторная строка попала бы
+// в один INSERT ... ON CONFLICT дважды.
+func validateScores(scores []models.BidScore) error {
+	if len(scores) == 0 {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidScore}
+	}
+
+	criteria := make(map[uuid.UUID]struct{}, len(scores))
+	for i := range scores {
+		if _, ok := criteria[scores[i].CriterionID]; ok {
+			return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidScore}
+		}
+		criteria[scores[i].CriterionID] = struct{}{}
+	}
+
+	return nil
+}
+
+// SetCriteria Только Ответственный за тендер задаёт критерии оценки, пока по ним нет ни одной оценки.
+func (ts *TenderService) SetCriteria(r *http.Request, tenderID string, criteria []models.Criterion, username string) ([]models.Criterion, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.CheckTenderNotClosed(r.Context(), tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateCriteria(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	var newCriteria []models.Criterion
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		err = ts.storage.CheckTenderNotScored(ctx, tenderID)
+		if err != nil {
+			return err
+		}
+
+		newCriteria, err = ts.storage.ReplaceCriteria(ctx, tenderID, criteria)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.CriteriaSet, models.TenderAggregate, uuid.MustParse(tenderID), username, "", newCriteria)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newCriteria, nil
+}
+
+// GetCriteria Критерии видны всем, кому виден тендер, чтобы участники знали, как их будут оценивать.
+func (ts *TenderService) GetCriteria(r *http.Request, tenderID, username string) ([]models.Criterion, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	if ts.storage.CheckTenderPublished(r.Context(), tenderID, username) != nil {
+		err = ts.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ts.storage.GetCriteria(r.Context(), tenderID)
+}
+
+// GetTenderRanking Только Ответственный за тендер может посмотреть рейтинг предложений.
+func (ts *TenderService) GetTenderRanking(r *http.Request, tenderID, username string, offset, limit int32) ([]models.BidRanking, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.storage.GetTenderRanking(r.Context(), tenderID, offset, limit)
+}
+
+// ScoreBid Ответственный за тендер выставляет оценки предложению по критериям тендера.
+// Повторная оценка по тому же критерию заменяет прежнюю.
+func (bs *BidService) ScoreBid(r *http.Request, bidID string, scores []models.BidScore, username string) ([]models.BidScore, error) {
+	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.ValidateUserResponsibleBidID(r.Context(), bidID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.CheckBidUnsealed(r.Context(), bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateScores(scores)
+	if err != nil {
+		return nil, err
+	}
+
+	var newScores []models.BidScore
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newScores, err = bs.storage.UpsertBidScores(ctx, bidID, username, scores)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidScored, models.BidAggregate, uuid.MustParse(bidID), username, "", newScores)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newScores, nil
+}
+
+// GetBidScores Только Ответственный за тендер видит оценки предложения.
+func (bs *BidService) GetBidScores(r *http.Request, bidID, username string) ([]models.BidScore, error) {
+	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.ValidateUserResponsibleBidID(r.Context(), bidID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return bs.storage.GetBidScores(r.Context(), bidID)
+}
+
+// GetDecisionRecords Только Ответственный за тендер видит историю решений с оценками на момент решения.
+func (bs *BidService) GetDecisionRecords(r *http.Request, bidID, username string, offset, limit int32) ([]models.DecisionRecord, error) {
+	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.ValidateUserResponsibleBidID(r.Context(), bidID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return bs.storage.GetDecisionRecords(r.Context(), bidID, offset, limit)
+}
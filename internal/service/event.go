@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/storage"
+)
+
+// appendEvent Записывает доменное событие в outbox. Вызывается внутри WithTx, чтобы событие
+// фиксировалось вместе с изменением.
+func appendEvent(ctx context.Context, s storage.Outbox, eventType models.EventType, aggregateType models.AggregateType,
+	aggregateID uuid.UUID, actor, reason string, payload any) error {
+	event, err := models.NewEvent(eventType, aggregateType, aggregateID, actor, reason, payload)
+	if err != nil {
+		return err
+	}
+
+	return s.AppendEvent(ctx, &event)
+}
+
+// tenderStatusEvent Подбирает тип события по новому статусу тендера.
+func tenderStatusEvent(status string) models.EventType {
+	switch models.TenderStatus(status) {
+	case models.Published:
+		return models.TenderPublished
+	case models.Closed:
+		return models.TenderClosed
+	default:
+		return models.TenderStatusChanged
+	}
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// OpenExport Создаёт файл выгрузки. Сервис вызывает его после проверок доступа, поэтому
+// ошибки проверок отдаются обычным ответом, а не обрывают начатый файл.
+type OpenExport func() (util.TableWriter, error)
+
+// exportTable Пишет заголовок и строки по мере чтения из базы.
+func exportTable[T any, PT interface {
+	*T
+	ExportRow() []any
+}](open OpenExport, header []string, stream func(fn func(*T) error) error) error {
+	tw, err := open()
+	if err != nil {
+		return err
+	}
+
+	headerRow := make([]any, len(header))
+	for i, h := range header {
+		headerRow[i] = h
+	}
+	if err = tw.WriteRow(headerRow); err != nil {
+		return err
+	}
+
+	err = stream(func(item *T) error {
+		return tw.WriteRow(PT(item).ExportRow())
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// ExportUserTenders Выгрузка всех тендеров пользователя, как GetUserTenders без пагинации.
+func (ts *TenderService) ExportUserTenders(r *http.Request, username string, open OpenExport) error {
+	err := ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return err
+	}
+
+	return exportTable(open, models.TenderExportHeader, func(fn func(*models.Tender) error) error {
+		return ts.storage.ExportUserTenders(r.Context(), username, fn)
+	})
+}
+
+// ExportBidsForTender Выгрузка предложений тендера для Ответственного. Репутация авторов в выгрузку не входит.
+func (bs *BidService) ExportBidsForTender(r *http.Request, tenderID, username string, open OpenExport) error {
+	err := bs.checkTenderResponsible(r, tenderID, username)
+	if err != nil {
+		return err
+	}
+
+	return exportTable(open, models.BidExportHeader, func(fn func(*models.Bid) error) error {
+		return bs.storage.ExportBidsForTender(r.Context(), tenderID, fn)
+	})
+}
+
+// ExportBidReviews Выгрузка отзывов на предложения автора с проверками GetBidReviews.
+func (bs *BidService) ExportBidReviews(r *http.Request, tenderID, authorUsername, requesterUsername string, open OpenExport) error {
+	err := bs.checkAuthorReviewsAccess(r, tenderID, authorUsername, requesterUsername)
+	if err != nil {
+		return err
+	}
+
+	return exportTable(open, models.ReviewExportHeader, func(fn func(*models.Review) error) error {
+		return bs.storage.ExportBidReviews(r.Context(), tenderID, authorUsername, fn)
+	})
+}
+
+// ExportReviewsForBid Выгрузка отзывов на предложение с проверками GetReviewsForBid.
+func (bs *BidService) ExportReviewsForBid(r *http.Request, bidID, username string, open OpenExport) error {
+	err := bs.checkBidReviewsAccess(r, bidID, username)
+	if err != nil {
+		return err
+	}
+
+	return exportTable(open, models.ReviewExportHeader, func(fn func(*models.Review) error) error {
+		return bs.storage.ExportReviewsForBid(r.Context(), bidID, fn)
+	})
+}
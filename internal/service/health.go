@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/storage"
+)
+
+// readinessTimeout Проверка готовности не должна висеть дольше, чем ждёт балансировщик.
+const readinessTimeout = 2 * time.Second
+
+type HealthService struct {
+	storage  storage.Storage
+	draining atomic.Bool
+}
+
+func NewHealthService(s storage.Storage) *HealthService {
+	return &HealthService{storage: s}
+}
+
+// GetHealth Сообщает версию схемы в базе и последнюю встроенную в приложение.
+func (hs *HealthService) GetHealth(r *http.Request) (models.Health, error) {
+	schema, err := hs.storage.GetSchemaVersion(r.Context())
+	if err != nil {
+		return models.Health{}, err
+	}
+
+	return models.Health{Status: "ok", Schema: schema}, nil
+}
+
+// StartDraining Переводит сервер в состояние завершения: проверка готовности больше не проходит,
+// и балансировщик перестаёт направлять запросы, пока сервер дорабатывает текущие.
+func (hs *HealthService) StartDraining() {
+	hs.draining.Store(true)
+}
+
+// GetReadiness Проверяет базу и версию схемы. Ошибки проверок попадают в ответ, а не возвращаются.
+func (hs *HealthService) GetReadiness(r *http.Request) models.Readiness {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	readiness := models.Readiness{
+		Draining: hs.draining.Load(),
+		Database: "ok",
+		Pool:     hs.storage.GetPoolStats(),
+	}
+
+	err := hs.storage.Ping(ctx)
+	if err == nil {
+		var schema models.SchemaVersion
+		schema, err = hs.storage.GetSchemaVersion(ctx)
+		readiness.Schema = &schema
+	}
+	if err != nil {
+		readiness.Database = err.Error()
+		readiness.Schema = nil
+	}
+
+	readiness.Ready = err == nil && !readiness.Draining && readiness.Schema.Current >= readiness.Schema.Latest
+	return readiness
+}
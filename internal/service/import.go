@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// importRowResult Итог строки импорта по ошибке в том же виде, что и ответ одиночного запроса.
+func importRowResult(line int, err error) models.ImportRowResult {
+	row := models.ImportRowResult{Line: line, Status: http.StatusInternalServerError, Error: err.Error()}
+
+	var customErr util.MyResponseError
+	if errors.As(err, &customErr) {
+		row.Status = customErr.Status
+		row.Error = customErr.Msg
+	}
+
+	return row
+}
+
+// ImportTenders Создаёт тендеры из файла CSV или NDJSON от имени пользователя. Каждая строка проходит
+// проверки CreateTender и создаётся отдельно: ошибка строки попадает в отчёт и не мешает остальным.
+// В режиме dryRun строки только проверяются.
+func (ts *TenderService) ImportTenders(r *http.Request, src io.Reader, format models.ImportFormat, username string, dryRun bool) (models.ImportReport, error) {
+	err := ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.ImportReport{}, err
+	}
+
+	report := models.ImportReport{DryRun: dryRun, Rows: []models.ImportRowResult{}}
+	err = util.ReadTenders(src, format, func(line int, tender *models.Tender, err error) error {
+		report.Total++
+		if err != nil {
+			err = util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidImportRow + ": " + err.Error()}
+		} else {
+			tender.CreatorUsername = username
+			err = ts.importTender(r.Context(), tender, dryRun)
+		}
+
+		if err != nil {
+			report.Failed++
+			report.Rows = append(report.Rows, importRowResult(line, err))
+			return nil
+		}
+
+		row := models.ImportRowResult{Line: line, Success: true, Status: http.StatusOK}
+		if !dryRun {
+			row.TenderID = &tender.ID
+		}
+		report.Succeeded++
+		report.Rows = append(report.Rows, row)
+		return nil
+	})
+	if err != nil {
+		return models.ImportReport{}, err
+	}
+
+	return report, nil
+}
+
+// importTender Проверяет и, если это не dryRun, создаёт тендер. ID созданного тендера записывается в tender.
+func (ts *TenderService) importTender(ctx context.Context, tender *models.Tender, dryRun bool) error {
+	err := ts.checkNewTender(ctx, tender)
+	if err != nil || dryRun {
+		return err
+	}
+
+	return ts.storage.WithTx(ctx, func(ctx context.Context) error {
+		newTender, err := ts.createTenderWith(ctx, tender, nil, models.EligibilityRules{}, "import")
+		if err != nil {
+			return err
+		}
+
+		tender.ID = newTender.ID
+		return nil
+	})
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// checkInvitationChange Приглашениями закрытого тендера управляет только Ответственный.
+func (ts *TenderService) checkInvitationChange(ctx context.Context, tenderID, username string) error {
+	err := ts.storage.CheckTenderExists(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+
+	err = ts.storage.CheckUserExists(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	err = ts.storage.ValidateUserResponsible(ctx, tenderID, username)
+	if err != nil {
+		return err
+	}
+
+	return ts.storage.CheckTenderPrivate(ctx, tenderID)
+}
+
+func (ts *TenderService) InviteOrganization(r *http.Request, tenderID, orgID, username string) (models.Invitation, error) {
+	err := ts.checkInvitationChange(r.Context(), tenderID, username)
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	err = ts.storage.CheckOrganizationExists(r.Context(), orgID)
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	var invitation models.Invitation
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		invitation, err = ts.storage.InviteOrganization(ctx, tenderID, orgID, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.OrganizationInvited, models.TenderAggregate, invitation.TenderID, username, "", invitation)
+	})
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	return invitation, nil
+}
+
+// RevokeInvitation Отозванная организация теряет доступ к тендеру; поданные предложения остаются.
+func (ts *TenderService) RevokeInvitation(r *http.Request, tenderID, orgID, username string) (models.Invitation, error) {
+	err := ts.checkInvitationChange(r.Context(), tenderID, username)
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	var invitation models.Invitation
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		invitation, err = ts.storage.RevokeInvitation(ctx, tenderID, orgID)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.InvitationRevoked, models.TenderAggregate, invitation.TenderID, username, "", invitation)
+	})
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	return invitation, nil
+}
+
+func (ts *TenderService) GetInvitations(r *http.Request, tenderID, username string, offset, limit int32) ([]models.Invitation, error) {
+	err := ts.checkInvitationChange(r.Context(), tenderID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.storage.GetInvitations(r.Context(), tenderID, offset, limit)
+}
+
+// RespondInvitation Ответственный приглашённой организации принимает приглашение или отказывается от него.
+func (ts *TenderService) RespondInvitation(r *http.Request, tenderID, username string, status models.InvitationStatus) (models.Invitation, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	eventType := models.InvitationAccepted
+	if status == models.InvitationStatusDeclined {
+		eventType = models.InvitationDeclined
+	}
+
+	var invitation models.Invitation
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		invitation, err = ts.storage.RespondInvitation(ctx, tenderID, username, status)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, eventType, models.TenderAggregate, invitation.TenderID, username, "", invitation)
+	})
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	return invitation, nil
+}
+
+func (ts *TenderService) GetInvitedTenders(r *http.Request, username string, offset, limit int32) ([]models.InvitedTender, error) {
+	err := ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.storage.GetInvitedTenders(r.Context(), username, offset, limit)
+}
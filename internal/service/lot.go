@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// uniqueLotIDs Убирает повторы, сохраняя порядок: лот, указанный в предложении дважды, - это один лот.
+func uniqueLotIDs(lotIDs []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(lotIDs))
+	unique := lotIDs[:0]
+	for _, id := range lotIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return unique
+}
+
+// checkLotChange Лоты меняет только Ответственный за тендер, пока тендер не закрыт.
+func (ts *TenderService) checkLotChange(ctx context.Context, tenderID, username string) error {
+	err := ts.storage.CheckTenderExists(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+
+	err = ts.storage.CheckUserExists(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	err = ts.storage.ValidateUserResponsible(ctx, tenderID, username)
+	if err != nil {
+		return err
+	}
+
+	return ts.storage.CheckTenderNotClosed(ctx, tenderID)
+}
+
+// changeLot Изменения лотов создают новую версию тендера, чтобы история лотов шла вместе с ней.
+func (ts *TenderService) changeLot(ctx context.Context, tenderID, username string, eventType models.EventType, fn func(ctx context.Context) (models.Lot, error)) (models.Lot, error) {
+	var lot models.Lot
+	err := ts.storage.WithTx(ctx, func(ctx context.Context) error {
+		_, err := ts.storage.BumpTenderVersion(ctx, tenderID)
+		if err != nil {
+			return err
+		}
+
+		lot, err = fn(ctx)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, eventType, models.TenderAggregate, lot.TenderID, username, "", lot)
+	})
+	if err != nil {
+		return models.Lot{}, err
+	}
+
+	return lot, nil
+}
+
+// AddLot Только Ответственный за тендер может добавить лот.
+func (ts *TenderService) AddLot(r *http.Request, tenderID string, lot *models.Lot, username string) (models.Lot, error) {
+	if err := ts.checkLotChange(r.Context(), tenderID, username); err != nil {
+		return models.Lot{}, err
+	}
+
+	lot.TenderID = uuid.MustParse(tenderID)
+	return ts.changeLot(r.Context(), tenderID, username, models.LotAdded, func(ctx context.Context) (models.Lot, error) {
+		return ts.storage.CreateLot(ctx, lot)
+	})
+}
+
+// EditLot Только Ответственный за тендер может изменить открытый лот.
+func (ts *TenderService) EditLot(r *http.Request, tenderID, lotID string, lot *models.Lot, username string) (models.Lot, error) {
+	if err := ts.checkLotChange(r.Context(), tenderID, username); err != nil {
+		return models.Lot{}, err
+	}
+
+	if err := ts.storage.CheckLotExists(r.Context(), tenderID, lotID); err != nil {
+		return models.Lot{}, err
+	}
+
+	return ts.changeLot(r.Context(), tenderID, username, models.LotEdited, func(ctx context.Context) (models.Lot, error) {
+		return ts.storage.EditLot(ctx, lot, tenderID, lotID)
+	})
+}
+
+// GetLots Лоты видны тем же, кому виден тендер.
+func (ts *TenderService) GetLots(r *http.Request, tenderID, username string, version int32) ([]models.Lot, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	if ts.storage.CheckTenderPublished(r.Context(), tenderID, username) != nil {
+		err = ts.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if version > 0 {
+		err = ts.storage.CheckTenderVersionExists(r.Context(), tenderID, version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ts.storage.GetLots(r.Context(), tenderID, version)
+}
+
+// AwardLot Только Ответственный за тендер может присудить лот опубликованному предложению, поданному на этот лот.
+func (ts *TenderService) AwardLot(r *http.Request, tenderID, lotID, bidID, username string) (models.Lot, error) {
+	if err := ts.checkLotChange(r.Context(), tenderID, username); err != nil {
+		return models.Lot{}, err
+	}
+
+	if err := ts.storage.CheckLotExists(r.Context(), tenderID, lotID); err != nil {
+		return models.Lot{}, err
+	}
+
+	if err := ts.storage.CheckBidExists(r.Context(), bidID); err != nil {
+		return models.Lot{}, err
+	}
+
+	if err := ts.storage.CheckBidInLot(r.Context(), bidID, lotID); err != nil {
+		return models.Lot{}, err
+	}
+
+	if err := ts.storage.CheckBidUnsealed(r.Context(), bidID); err != nil {
+		return models.Lot{}, err
+	}
+
+	if err := ts.storage.CheckBidPublished(r.Context(), bidID); err != nil {
+		return models.Lot{}, err
+	}
+
+	// В двухконвертном тендере лот присуждается только допущенному предложению с раскрытой ценой.
+	if err := ts.storage.CheckBidCommercialVisible(r.Context(), bidID); err != nil {
+		return models.Lot{}, err
+	}
+
+	winner := uuid.NullUUID{UUID: uuid.MustParse(bidID), Valid: true}
+	return ts.changeLot(r.Context(), tenderID, username, models.LotAwarded, func(ctx context.Context) (models.Lot, error) {
+		return ts.storage.ResolveLot(ctx, lotID, models.LotStatusAwarded, winner)
+	})
+}
+
+// CancelLot Только Ответственный за тендер может отменить лот без победителя.
+func (ts *TenderService) CancelLot(r *http.Request, tenderID, lotID, username string) (models.Lot, error) {
+	if err := ts.checkLotChange(r.Context(), tenderID, username); err != nil {
+		return models.Lot{}, err
+	}
+
+	if err := ts.storage.CheckLotExists(r.Context(), tenderID, lotID); err != nil {
+		return models.Lot{}, err
+	}
+
+	return ts.changeLot(r.Context(), tenderID, username, models.LotCancelled, func(ctx context.Context) (models.Lot, error) {
+		return ts.storage.ResolveLot(ctx, lotID, models.LotStatusCancelled, uuid.NullUUID{})
+	})
+}
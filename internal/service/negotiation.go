@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// negotiationParty Автор предложения ведёт переговоры как поставщик, Ответственный за тендер - как заказчик.
+func (bs *BidService) negotiationParty(ctx context.Context, bidID, username string) (models.NegotiationParty, error) {
+	errAuthor := bs.storage.CheckUserBidAuthor(ctx, bidID, username)
+	if errAuthor == nil {
+		return models.NegotiationPartySupplier, nil
+	}
+
+	errResponsible := bs.storage.ValidateUserResponsibleBidID(ctx, bidID, username)
+	if errResponsible == nil {
+		return models.NegotiationPartyCustomer, nil
+	}
+
+	return "", errors.Join(errAuthor, errResponsible)
+}
+
+// checkNegotiable Запечатанное предложение до вскрытия и скрытая коммерческая часть не обсуждаются.
+func (bs *BidService) checkNegotiable(ctx context.Context, bidID string) error {
+	err := bs.storage.CheckBidNegotiable(ctx, bidID)
+	if err != nil {
+		return err
+	}
+
+	err = bs.storage.CheckBidUnsealed(ctx, bidID)
+	if err != nil {
+		return err
+	}
+
+	return bs.storage.CheckBidCommercialVisible(ctx, bidID)
+}
+
+func validateTerms(round *models.NegotiationRound) error {
+	round.Terms = strings.TrimSpace(round.Terms)
+	if round.Terms == "" || (round.Price != nil && *round.Price <= 0) {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidNegotiation}
+	}
+
+	return nil
+}
+
+// checkRoundsLeft Встречные условия тоже расходуют раунды.
+func (bs *BidService) checkRoundsLeft(rounds []models.NegotiationRound) error {
+	if len(rounds) >= bs.negotiation.MaxRounds {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.NegotiationExhausted}
+	}
+
+	return nil
+}
+
+// openRoundFor Открытый раунд, на который может ответить пользователь, и его сторона в переговорах.
+func (bs *BidService) openRoundFor(ctx context.Context, bidID, username string) ([]models.NegotiationRound, models.NegotiationRound, models.NegotiationParty, error) {
+	err := bs.storage.CheckBidExists(ctx, bidID)
+	if err != nil {
+		return nil, models.NegotiationRound{}, "", err
+	}
+
+	err = bs.storage.CheckUserExists(ctx, username)
+	if err != nil {
+		return nil, models.NegotiationRound{}, "", err
+	}
+
+	party, err := bs.negotiationParty(ctx, bidID, username)
+	if err != nil {
+		return nil, models.NegotiationRound{}, "", err
+	}
+
+	err = bs.checkNegotiable(ctx, bidID)
+	if err != nil {
+		return nil, models.NegotiationRound{}, "", err
+	}
+
+	rounds, err := bs.storage.GetNegotiationRounds(ctx, bidID)
+	if err != nil {
+		return nil, models.NegotiationRound{}, "", err
+	}
+
+	if len(rounds) == 0 || rounds[len(rounds)-1].Status != models.NegotiationStatusOpen {
+		return nil, models.NegotiationRound{}, "", util.MyResponseError{Status: http.StatusConflict, Msg: util.NoOpenRound}
+	}
+
+	round := rounds[len(rounds)-1]
+	if round.Party == party {
+		return nil, models.NegotiationRound{}, "", util.MyResponseError{Status: http.StatusConflict, Msg: util.OwnRound}
+	}
+
+	return rounds, round, party, nil
+}
+
+// ProposeTerms Ответственный за тендер открывает раунд переговоров с новыми условиями.
+func (bs *BidService) ProposeTerms(r *http.Request, round *models.NegotiationRound, username string) (models.NegotiationRound, error) {
+	bidID := round.BidID.String()
+	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	err = bs.storage.ValidateUserResponsibleBidID(r.Context(), bidID, username)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	err = validateTerms(round)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	err = bs.checkNegotiable(r.Context(), bidID)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	rounds, err := bs.storage.GetNegotiationRounds(r.Context(), bidID)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	if len(rounds) > 0 && rounds[len(rounds)-1].Status == models.NegotiationStatusOpen {
+		return models.NegotiationRound{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.NegotiationRoundOpen}
+	}
+
+	err = bs.checkRoundsLeft(rounds)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	round.Party = models.NegotiationPartyCustomer
+	round.ProposedBy = &username
+
+	var newRound models.NegotiationRound
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newRound, err = bs.storage.CreateNegotiationRound(ctx, round)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.TermsProposed, models.BidAggregate, newRound.BidID, username, round.Terms, newRound)
+	})
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	return newRound, nil
+}
+
+// AcceptTerms Другая сторона принимает условия открытого раунда, они сохраняются новой версией предложения.
+func (bs *BidService) AcceptTerms(r *http.Request, bidID, username string) (models.NegotiationRound, error) {
+	_, round, _, err := bs.openRoundFor(r.Context(), bidID, username)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	var acceptedRound models.NegotiationRound
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		var updatedBid models.Bid
+		updatedBid, err = bs.storage.ApplyNegotiatedTerms(ctx, bidID, round.Price)
+		if err != nil {
+			return err
+		}
+
+		acceptedRound, err = bs.storage.CloseNegotiationRound(ctx, round.ID.String(), models.NegotiationStatusAccepted, &updatedBid.Version, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.TermsAccepted, models.BidAggregate, updatedBid.ID, username, "", acceptedRound)
+	})
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	return acceptedRound, nil
+}
+
+// CounterTerms Другая сторона отвечает встречными условиями следующим раундом.
+func (bs *BidService) CounterTerms(r *http.Request, counter *models.NegotiationRound, username string) (models.NegotiationRound, error) {
+	rounds, round, party, err := bs.openRoundFor(r.Context(), counter.BidID.String(), username)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	err = validateTerms(counter)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	err = bs.checkRoundsLeft(rounds)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	counter.Party = party
+	counter.ProposedBy = &username
+
+	var newRound models.NegotiationRound
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		_, err = bs.storage.CloseNegotiationRound(ctx, round.ID.String(), models.NegotiationStatusCountered, nil, username)
+		if err != nil {
+			return err
+		}
+
+		newRound, err = bs.storage.CreateNegotiationRound(ctx, counter)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.TermsProposed, models.BidAggregate, newRound.BidID, username, counter.Terms, newRound)
+	})
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	return newRound, nil
+}
+
+// RejectTerms Другая сторона отклоняет условия. Предложение остаётся без изменений.
+func (bs *BidService) RejectTerms(r *http.Request, bidID, username string) (models.NegotiationRound, error) {
+	_, round, _, err := bs.openRoundFor(r.Context(), bidID, username)
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	var rejectedRound models.NegotiationRound
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		rejectedRound, err = bs.storage.CloseNegotiationRound(ctx, round.ID.String(), models.NegotiationStatusRejected, nil, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, bs.storage, models.TermsRejected, models.BidAggregate, rejectedRound.BidID, username, "", rejectedRound)
+	})
+	if err != nil {
+		return models.NegotiationRound{}, err
+	}
+
+	return rejectedRound, nil
+}
+
+// GetNegotiation Раунды переговоров видят автор предложения и Ответственные за тендер.
+func (bs *BidService) GetNegotiation(r *http.Request, bidID, username string) ([]models.NegotiationRound, error) {
+	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = bs.negotiationParty(r.Context(), bidID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return bs.storage.GetNegotiationRounds(r.Context(), bidID)
+}
+
+// GetBidHistory Версии предложения с отметкой раунда переговоров, который их создал.
+// Ответственному за тендер коммерческая часть видна только после раскрытия.
+func (bs *BidService) GetBidHistory(r *http.Request, bidID, username string) ([]models.BidVersion, error) {
+	err := bs.storage.CheckBidExists(r.Context(), bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := bs.negotiationParty(r.Context(), bidID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := bs.storage.GetBidHistory(r.Context(), bidID)
+	if err != nil {
+		return nil, err
+	}
+
+	if party == models.NegotiationPartyCustomer {
+		for i := range versions {
+			err = bs.maskCommercial(r.Context(), &versions[i].Bid)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return versions, nil
+}
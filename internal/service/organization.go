@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+type OrganizationService struct {
+	storage  storage.Storage
+	platform *config.PlatformConfig
+}
+
+func NewOrganizationService(s storage.Storage, cfg *config.PlatformConfig) *OrganizationService {
+	return &OrganizationService{storage: s, platform: cfg}
+}
+
+// AddCertificate Ответственный организации загружает сведения о сертификате. До подтверждения
+// администратором площадки сертификат в требованиях тендеров не учитывается.
+func (orgs *OrganizationService) AddCertificate(r *http.Request, certificate *models.Certificate, username string) (models.Certificate, error) {
+	orgID := certificate.OrganizationID.String()
+	err := orgs.storage.CheckOrganizationExists(r.Context(), orgID)
+	if err != nil {
+		return models.Certificate{}, err
+	}
+
+	err = orgs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Certificate{}, err
+	}
+
+	err = orgs.storage.ValidateUserResponsibleOrgID(r.Context(), orgID, username)
+	if err != nil {
+		return models.Certificate{}, err
+	}
+
+	certificate.Name = strings.TrimSpace(certificate.Name)
+	if certificate.Name == "" {
+		return models.Certificate{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidCertificate}
+	}
+
+	certificate.CreatedBy = &username
+
+	var newCertificate models.Certificate
+	err = orgs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newCertificate, err = orgs.storage.AddCertificate(ctx, certificate)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, orgs.storage, models.CertificateAdded, models.OrganizationAggregate, newCertificate.OrganizationID, username, "", newCertificate)
+	})
+	if err != nil {
+		return models.Certificate{}, err
+	}
+
+	return newCertificate, nil
+}
+
+// GetCertificates Сертификаты видят Ответственные организации и администраторы площадки.
+func (orgs *OrganizationService) GetCertificates(r *http.Request, orgID, username string) ([]models.Certificate, error) {
+	err := orgs.storage.CheckOrganizationExists(r.Context(), orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = orgs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !orgs.platform.IsAdmin(username) {
+		err = orgs.storage.ValidateUserResponsibleOrgID(r.Context(), orgID, username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return orgs.storage.GetCertificates(r.Context(), orgID)
+}
+
+// VerifyCertificate Только администратор площадки подтверждает сертификат.
+func (orgs *OrganizationService) VerifyCertificate(r *http.Request, orgID, certificateID, username string) (models.Certificate, error) {
+	err := orgs.storage.CheckOrganizationExists(r.Context(), orgID)
+	if err != nil {
+		return models.Certificate{}, err
+	}
+
+	err = orgs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Certificate{}, err
+	}
+
+	if !orgs.platform.IsAdmin(username) {
+		return models.Certificate{}, util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
+	}
+
+	var certificate models.Certificate
+	err = orgs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		certificate, err = orgs.storage.VerifyCertificate(ctx, orgID, certificateID, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, orgs.storage, models.CertificateVerified, models.OrganizationAggregate, certificate.OrganizationID, username, "", certificate)
+	})
+	if err != nil {
+		return models.Certificate{}, err
+	}
+
+	return certificate, nil
+}
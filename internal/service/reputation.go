@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"zadanie-6105/internal/models"
+)
+
+// GetAuthorReputation Репутация доступна любому существующему пользователю.
+func (bs *BidService) GetAuthorReputation(r *http.Request, authorUsername, username string) (models.Reputation, error) {
+	err := bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Reputation{}, err
+	}
+
+	return bs.storage.GetAuthorReputation(r.Context(), authorUsername)
+}
+
+func (bs *BidService) GetOrganizationReputation(r *http.Request, orgID, username string) (models.Reputation, error) {
+	err := bs.storage.CheckOrganizationExists(r.Context(), orgID)
+	if err != nil {
+		return models.Reputation{}, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Reputation{}, err
+	}
+
+	return bs.storage.GetOrganizationReputation(r.Context(), orgID)
+}
+
+// fillAuthorReputations Подставляет репутацию авторов в список предложений одним запросом.
+func (bs *BidService) fillAuthorReputations(ctx context.Context, bids []models.Bid) error {
+	if len(bids) == 0 {
+		return nil
+	}
+
+	authorIDs := make([]uuid.UUID, 0, len(bids))
+	for _, bid := range bids {
+		authorIDs = append(authorIDs, bid.AuthorID)
+	}
+
+	reputations, err := bs.storage.GetAuthorReputations(ctx, authorIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range bids {
+		reputation := reputations[bids[i].AuthorID]
+		bids[i].AuthorReputation = &reputation
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+type ReviewService struct {
+	storage  storage.Storage
+	platform *config.PlatformConfig
+	cfg      *config.ReviewConfig
+}
+
+func NewReviewService(s storage.Storage, platform *config.PlatformConfig, cfg *config.ReviewConfig) *ReviewService {
+	return &ReviewService{storage: s, platform: platform, cfg: cfg}
+}
+
+// getActiveReview Скрытый отзыв нельзя ни изменить, ни ответить на него.
+func (rs *ReviewService) getActiveReview(ctx context.Context, reviewID string) (models.Review, error) {
+	review, err := rs.storage.GetReview(ctx, reviewID)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	if review.HiddenAt != nil {
+		return models.Review{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.ReviewHidden}
+	}
+
+	return review, nil
+}
+
+// ReplyToReview Автор предложения может один раз публично ответить на отзыв.
+func (rs *ReviewService) ReplyToReview(r *http.Request, reviewID, reply, username string) (models.Review, error) {
+	review, err := rs.getActiveReview(r.Context(), reviewID)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	err = rs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	err = rs.storage.CheckUserBidAuthor(r.Context(), review.BidID.String(), username)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	reply = strings.TrimSpace(reply)
+	if reply == "" {
+		return models.Review{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidReview}
+	}
+
+	var repliedReview models.Review
+	err = rs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		repliedReview, err = rs.storage.ReplyToReview(ctx, reviewID, reply, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, rs.storage, models.ReviewReplied, models.ReviewAggregate, repliedReview.ID, username, "", repliedReview)
+	})
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	return repliedReview, nil
+}
+
+// EditReview Автор отзыва может править его в течение срока редактирования. Прежние редакции сохраняются.
+func (rs *ReviewService) EditReview(r *http.Request, reviewID string, edit *models.Review, username string) (models.Review, error) {
+	review, err := rs.getActiveReview(r.Context(), reviewID)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	err = rs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	if review.AuthorUsername != username {
+		return models.Review{}, util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
+	}
+
+	edit.Description = strings.TrimSpace(edit.Description)
+	if edit.Description == "" && edit.Rating == nil && edit.Completed == nil {
+		return models.Review{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidReview}
+	}
+
+	if edit.Rating != nil && (*edit.Rating < 1 || *edit.Rating > 5) {
+		return models.Review{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidRating}
+	}
+
+	err = rs.storage.CheckReviewEditable(r.Context(), reviewID, rs.cfg.EditWindow)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	var editedReview models.Review
+	err = rs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		editedReview, err = rs.storage.EditReview(ctx, reviewID, edit, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, rs.storage, models.ReviewEdited, models.ReviewAggregate, editedReview.ID, username, "", editedReview)
+	})
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	return editedReview, nil
+}
+
+// GetReviewHistory Историю правок видят автор отзыва, автор предложения и модераторы площадки.
+func (rs *ReviewService) GetReviewHistory(r *http.Request, reviewID, username string) ([]models.ReviewRevision, error) {
+	review, err := rs.storage.GetReview(r.Context(), reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.AuthorUsername != username && !rs.platform.IsAdmin(username) {
+		err = rs.storage.CheckUserBidAuthor(r.Context(), review.BidID.String(), username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs.storage.GetReviewRevisions(r.Context(), reviewID)
+}
+
+// HideReview Модератор площадки скрывает оскорбительный отзыв с указанием причины.
+// Скрытый отзыв не показывается в списках и не учитывается в репутации.
+func (rs *ReviewService) HideReview(r *http.Request, reviewID, reason, username string) (models.Review, error) {
+	_, err := rs.getActiveReview(r.Context(), reviewID)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	err = rs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	if !rs.platform.IsAdmin(username) {
+		return models.Review{}, util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return models.Review{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidReview}
+	}
+
+	var hiddenReview models.Review
+	err = rs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		hiddenReview, err = rs.storage.HideReview(ctx, reviewID, reason, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, rs.storage, models.ReviewHidden, models.ReviewAggregate, hiddenReview.ID, username, reason, hiddenReview)
+	})
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	return hiddenReview, nil
+}
+
+// RestoreReview Модератор возвращает скрытый отзыв.
+func (rs *ReviewService) RestoreReview(r *http.Request, reviewID, username string) (models.Review, error) {
+	review, err := rs.storage.GetReview(r.Context(), reviewID)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	err = rs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	if !rs.platform.IsAdmin(username) {
+		return models.Review{}, util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
+	}
+
+	if review.HiddenAt == nil {
+		return models.Review{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.ReviewNotHidden}
+	}
+
+	var restoredReview models.Review
+	err = rs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		restoredReview, err = rs.storage.RestoreReview(ctx, reviewID)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, rs.storage, models.ReviewRestored, models.ReviewAggregate, restoredReview.ID, username, "", restoredReview)
+	})
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	return restoredReview, nil
+}
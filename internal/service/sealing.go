@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// sealBid Для запечатанного тендера шифрует название, описание и цену предложения,
+// а открытые поля очищает. Шифротекст привязан к тендеру.
+func (bs *BidService) sealBid(ctx context.Context, bid *models.Bid) error {
+	sealing, err := bs.storage.GetTenderSealing(ctx, bid.TenderID.String())
+	if err != nil {
+		return err
+	}
+
+	if !sealing.Sealed {
+		return nil
+	}
+
+	if sealing.BiddingClosed(time.Now()) {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.BiddingClosed}
+	}
+
+	data, err := json.Marshal(models.SealedBidContent{Name: bid.Name, Description: bid.Description, Price: bid.Price})
+	if err != nil {
+		return err
+	}
+
+	bid.SealedPayload, err = bs.sealer.Seal(data, []byte(bid.TenderID.String()))
+	if err != nil {
+		return err
+	}
+
+	bid.Name, bid.Description, bid.Price = "", "", nil
+	return nil
+}
+
+// OpenBids Только Ответственный за тендер может вскрыть запечатанные предложения, и только после времени вскрытия.
+// Все предложения тендера расшифровываются в одной транзакции.
+func (bs *BidService) OpenBids(r *http.Request, tenderID, username string) (models.BidOpening, error) {
+	err := bs.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return models.BidOpening{}, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.BidOpening{}, err
+	}
+
+	err = bs.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return models.BidOpening{}, err
+	}
+
+	sealing, err := bs.storage.GetTenderSealing(r.Context(), tenderID)
+	if err != nil {
+		return models.BidOpening{}, err
+	}
+
+	if !sealing.Sealed {
+		return models.BidOpening{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.TenderNotSealed}
+	}
+
+	if sealing.OpeningAt != nil && time.Now().Before(*sealing.OpeningAt) {
+		return models.BidOpening{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.OpeningNotDue}
+	}
+
+	var opening models.BidOpening
+	err = bs.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		opening, err = bs.storage.CreateBidOpening(ctx, tenderID, username)
+		if err != nil {
+			return err
+		}
+
+		var bids []models.Bid
+		bids, err = bs.storage.GetSealedBids(ctx, tenderID)
+		if err != nil {
+			return err
+		}
+
+		for _, bid := range bids {
+			var data []byte
+			data, err = bs.sealer.Open(bid.SealedPayload, []byte(tenderID))
+			if err != nil {
+				return err
+			}
+
+			var content models.SealedBidContent
+			if err = json.Unmarshal(data, &content); err != nil {
+				return err
+			}
+
+			if err = bs.storage.UnsealBid(ctx, bid.ID, &content); err != nil {
+				return err
+			}
+		}
+
+		return appendEvent(ctx, bs.storage, models.BidsOpened, models.TenderAggregate, opening.TenderID, username, "", opening)
+	})
+	if err != nil {
+		return models.BidOpening{}, err
+	}
+
+	return opening, nil
+}
+
+// GetBidOpening Только Ответственный за тендер видит, кто и когда вскрыл предложения.
+func (bs *BidService) GetBidOpening(r *http.Request, tenderID, username string) (models.BidOpening, error) {
+	err := bs.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return models.BidOpening{}, err
+	}
+
+	err = bs.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.BidOpening{}, err
+	}
+
+	err = bs.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return models.BidOpening{}, err
+	}
+
+	return bs.storage.GetBidOpening(r.Context(), tenderID)
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// validateTemplate Проверяет шаблон теми же правилами, что и тендер, его критерии и требования.
+func validateTemplate(template *models.TenderTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidTemplate}
+	}
+
+	if _, ok := models.ServiceTypeMap[template.ServiceType]; !ok {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidTemplate}
+	}
+
+	criteria := template.TenderCriteria()
+	if err := validateCriteria(criteria); err != nil {
+		return err
+	}
+	for i := range criteria {
+		template.Criteria[i].MaxScore = criteria[i].MaxScore
+	}
+
+	rules := template.EligibilityRules(template.ID)
+	return validateEligibility(&rules)
+}
+
+// getTemplateAs Шаблоны видны и меняются только Ответственными организации.
+func (ts *TenderService) getTemplateAs(ctx context.Context, templateID, username string) (models.TenderTemplate, error) {
+	template, err := ts.storage.GetTemplate(ctx, templateID)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	err = ts.storage.CheckUserExists(ctx, username)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	err = ts.storage.ValidateUserResponsibleOrgID(ctx, template.OrganizationID.String(), username)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	return template, nil
+}
+
+// createTenderWith Создаёт тендер вместе с критериями и требованиями к поставщикам. Вызывается в транзакции.
+func (ts *TenderService) createTenderWith(ctx context.Context, tender *models.Tender, criteria []models.Criterion,
+	rules models.EligibilityRules, reason string) (models.Tender, error) {
+	newTender, err := ts.storage.CreateTender(ctx, tender)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	if len(criteria) > 0 {
+		_, err = ts.storage.ReplaceCriteria(ctx, newTender.ID.String(), criteria)
+		if err != nil {
+			return models.Tender{}, err
+		}
+	}
+
+	if !rules.Empty() {
+		rules.TenderID = newTender.ID
+		rules.UpdatedBy = &tender.CreatorUsername
+		_, err = ts.storage.SetEligibilityRules(ctx, &rules)
+		if err != nil {
+			return models.Tender{}, err
+		}
+	}
+
+	err = appendEvent(ctx, ts.storage, models.TenderCreated, models.TenderAggregate, newTender.ID, tender.CreatorUsername, reason, newTender)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	return newTender, nil
+}
+
+// CreateTemplate Ответственный организации сохраняет шаблон для повторяющихся тендеров.
+func (ts *TenderService) CreateTemplate(r *http.Request, template *models.TenderTemplate, username string) (models.TenderTemplate, error) {
+	orgID := template.OrganizationID.String()
+	err := ts.storage.CheckOrganizationExists(r.Context(), orgID)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	err = ts.storage.ValidateUserResponsibleOrgID(r.Context(), orgID, username)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	err = validateTemplate(template)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	template.CreatorUsername = &username
+
+	var newTemplate models.TenderTemplate
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newTemplate, err = ts.storage.CreateTemplate(ctx, template)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.TemplateCreated, models.TemplateAggregate, newTemplate.ID, username, "", newTemplate)
+	})
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	return newTemplate, nil
+}
+
+func (ts *TenderService) GetTemplates(r *http.Request, orgID, username string, offset, limit int32) ([]models.TenderTemplate, error) {
+	err := ts.storage.CheckOrganizationExists(r.Context(), orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.storage.ValidateUserResponsibleOrgID(r.Context(), orgID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.storage.GetTemplates(r.Context(), orgID, offset, limit)
+}
+
+func (ts *TenderService) GetTemplate(r *http.Request, templateID, username string) (models.TenderTemplate, error) {
+	return ts.getTemplateAs(r.Context(), templateID, username)
+}
+
+// EditTemplate Меняет только переданные поля. Каждая правка создаёт новую версию.
+func (ts *TenderService) EditTemplate(r *http.Request, templateID string, changes *models.TemplateChanges, username string) (models.TenderTemplate, error) {
+	template, err := ts.getTemplateAs(r.Context(), templateID, username)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	template.Apply(changes)
+	err = validateTemplate(&template)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	var newTemplate models.TenderTemplate
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newTemplate, err = ts.storage.UpdateTemplate(ctx, &template)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.TemplateEdited, models.TemplateAggregate, newTemplate.ID, username, "", newTemplate)
+	})
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	return newTemplate, nil
+}
+
+// RollbackTemplate Откат к версии шаблона считается новой правкой.
+func (ts *TenderService) RollbackTemplate(r *http.Request, templateID string, version int32, username string) (models.TenderTemplate, error) {
+	_, err := ts.getTemplateAs(r.Context(), templateID, username)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	err = ts.storage.CheckTemplateVersionExists(r.Context(), templateID, version)
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	var newTemplate models.TenderTemplate
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newTemplate, err = ts.storage.RollbackTemplate(ctx, templateID, version)
+		if err != nil {
+			return err
+		}
+
+		reason := fmt.Sprintf("rollback to version %d", version)
+		return appendEvent(ctx, ts.storage, models.TemplateRolledBack, models.TemplateAggregate, newTemplate.ID, username, reason, newTemplate)
+	})
+	if err != nil {
+		return models.TenderTemplate{}, err
+	}
+
+	return newTemplate, nil
+}
+
+// CreateTenderFromTemplate Создаёт тендер в статусе Created по текущей версии шаблона.
+func (ts *TenderService) CreateTenderFromTemplate(r *http.Request, templateID, username string) (models.Tender, error) {
+	template, err := ts.getTemplateAs(r.Context(), templateID, username)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	tender := models.Tender{
+		Name:            template.Name,
+		Description:     template.Description,
+		ServiceType:     template.ServiceType,
+		Status:          models.Created,
+		OrganizationID:  template.OrganizationID,
+		CreatorUsername: username,
+	}
+
+	var newTender models.Tender
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		reason := fmt.Sprintf("from template %s version %d", template.ID, template.Version)
+		newTender, err = ts.createTenderWith(ctx, &tender, template.TenderCriteria(), template.EligibilityRules(template.ID), reason)
+		return err
+	})
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	return newTender, nil
+}
+
+// CloneTender Копирует закрытый тендер вместе с критериями и требованиями в новый тендер в статусе Created,
+// созданный от имени вызывающего. Запечатанному тендеру нужно новое время вскрытия. Приглашения не копируются.
+func (ts *TenderService) CloneTender(r *http.Request, tenderID, username string, openingAt *time.Time) (models.Tender, error) {
+	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	err = ts.storage.CheckUserExists(r.Context(), username)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	err = ts.storage.ValidateUserResponsible(r.Context(), tenderID, username)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	source, err := ts.storage.GetTender(r.Context(), tenderID)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	if source.Status != models.Closed {
+		return models.Tender{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.TenderNotClosed}
+	}
+
+	criteria, err := ts.storage.GetCriteria(r.Context(), tenderID)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	rules, err := ts.storage.GetEligibilityRules(r.Context(), tenderID)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	tender := models.Tender{
+		Name:            source.Name,
+		Description:     source.Description,
+		ServiceType:     source.ServiceType,
+		Status:          models.Created,
+		OrganizationID:  source.OrganizationID,
+		CreatorUsername: username,
+		Sealed:          source.Sealed,
+		OpeningAt:       openingAt,
+		TwoEnvelope:     source.TwoEnvelope,
+		Private:         source.Private,
+		SourceTenderID:  &source.ID,
+	}
+	if err = checkTenderMode(&tender); err != nil {
+		return models.Tender{}, err
+	}
+
+	var newTender models.Tender
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		reason := fmt.Sprintf("clone of tender %s", source.ID)
+		newTender, err = ts.createTenderWith(ctx, &tender, criteria, rules, reason)
+		return err
+	})
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	return newTender, nil
+}
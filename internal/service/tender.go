@@ -1,35 +1,114 @@
 package service
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"time"
+	"unicode/utf8"
 	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/models/config"
 	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+const (
+	maxTenderNameLength        = 100
+	maxTenderDescriptionLength = 500
 )
 
 type TenderService struct {
 	storage storage.Storage
+	batch   *config.BatchConfig
+}
+
+func NewTenderService(s storage.Storage, batch *config.BatchConfig) *TenderService {
+	return &TenderService{storage: s, batch: batch}
+}
+
+// checkTenderMode Проверяет режим нового тендера: запечатанный и двухконвертный несовместимы,
+// этапы оценки наступают только переходом из Published.
+func checkTenderMode(tender *models.Tender) error {
+	if tender.Sealed && tender.TwoEnvelope {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidTenderMode}
+	}
+
+	if tender.Status == models.TechnicalEvaluation || tender.Status == models.CommercialEvaluation {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidStatusChange}
+	}
+
+	// Запечатанному тендеру нужно время вскрытия, до которого принимаются предложения.
+	if tender.Sealed && (tender.OpeningAt == nil || !tender.OpeningAt.After(time.Now())) {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidOpeningTime}
+	}
+	if !tender.Sealed {
+		tender.OpeningAt = nil
+	}
+
+	return nil
+}
+
+// validateTenderFields Ограничения полей нового тендера из спецификации.
+func validateTenderFields(tender *models.Tender) error {
+	if utf8.RuneCountInString(tender.Name) > maxTenderNameLength ||
+		utf8.RuneCountInString(tender.Description) > maxTenderDescriptionLength {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidTender}
+	}
+
+	if _, ok := models.ServiceTypeMap[tender.ServiceType]; !ok {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidTender}
+	}
+
+	if _, ok := models.TenderStatusMap[tender.Status]; !ok {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidTender}
+	}
+
+	return nil
 }
 
-func NewTenderService(s storage.Storage) *TenderService {
-	return &TenderService{storage: s}
+// checkNewTender Проверки создания тендера: автор - Ответственный организации, поля и режим корректны.
+func (ts *TenderService) checkNewTender(ctx context.Context, tender *models.Tender) error {
+	if err := ts.storage.CheckUserExists(ctx, tender.CreatorUsername); err != nil {
+		return err
+	}
+
+	if err := ts.storage.ValidateUserResponsibleOrgID(ctx, tender.OrganizationID.String(), tender.CreatorUsername); err != nil {
+		return err
+	}
+
+	if err := validateTenderFields(tender); err != nil {
+		return err
+	}
+
+	return checkTenderMode(tender)
 }
 
 func (ts *TenderService) CreateTender(r *http.Request, tender *models.Tender) (models.Tender, error) {
 	var emptyTender models.Tender
-	if err := ts.storage.CheckUserExists(r.Context(), tender.CreatorUsername); err != nil {
+	if err := ts.checkNewTender(r.Context(), tender); err != nil {
 		return emptyTender, err
 	}
 
-	if err := ts.storage.ValidateUserResponsibleOrgID(r.Context(), tender.OrganizationID.String(), tender.CreatorUsername); err != nil {
+	var newTender models.Tender
+	err := ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		var err error
+		newTender, err = ts.storage.CreateTender(ctx, tender)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.TenderCreated, models.TenderAggregate, newTender.ID, tender.CreatorUsername, "", newTender)
+	})
+	if err != nil {
 		return emptyTender, err
 	}
 
-	return ts.storage.CreateTender(r.Context(), tender)
+	return newTender, nil
 }
 
-// GetTenders Вывести PUBLISHED тендеры.
-func (ts *TenderService) GetTenders(r *http.Request, offset, limit int32, serviceTypes []string) ([]models.Tender, error) {
-	return ts.storage.GetTenders(r.Context(), offset, limit, serviceTypes)
+// GetTenders Вывести PUBLISHED тендеры. Закрытые тендеры видны только приглашённым организациям.
+func (ts *TenderService) GetTenders(r *http.Request, offset, limit int32, serviceTypes []string, username string) ([]models.Tender, error) {
+	return ts.storage.GetTenders(r.Context(), offset, limit, serviceTypes, username)
 }
 
 func (ts *TenderService) GetUserTenders(r *http.Request, offset, limit int32, username string) ([]models.Tender, error) {
@@ -73,7 +152,33 @@ func (ts *TenderService) UpdateTenderStatus(r *http.Request, tenderID, status, u
 		return emptyTender, err
 	}
 
-	return ts.storage.UpdateTenderStatus(r.Context(), tenderID, status, username)
+	err = ts.checkStageTransition(r.Context(), tenderID, models.TenderStatus(status))
+	if err != nil {
+		return emptyTender, err
+	}
+
+	// Тендер с лотами закрывается только после решения по каждому лоту.
+	if models.TenderStatus(status) == models.Closed {
+		err = ts.storage.CheckTenderLotsResolved(r.Context(), tenderID)
+		if err != nil {
+			return emptyTender, err
+		}
+	}
+
+	var updatedTender models.Tender
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		updatedTender, err = ts.storage.UpdateTenderStatus(ctx, tenderID, status, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, tenderStatusEvent(status), models.TenderAggregate, updatedTender.ID, username, "", updatedTender)
+	})
+	if err != nil {
+		return emptyTender, err
+	}
+
+	return updatedTender, nil
 }
 
 // EditTender Только Ответственный за тендер может изменить его.
@@ -94,10 +199,23 @@ func (ts *TenderService) EditTender(r *http.Request, tender *models.Tender, tend
 		return emptyTender, err
 	}
 
-	return ts.storage.EditTender(r.Context(), tender, tenderID, username)
+	var newTender models.Tender
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newTender, err = ts.storage.EditTender(ctx, tender, tenderID, username)
+		if err != nil {
+			return err
+		}
+
+		return appendEvent(ctx, ts.storage, models.TenderEdited, models.TenderAggregate, newTender.ID, username, "", newTender)
+	})
+	if err != nil {
+		return emptyTender, err
+	}
+
+	return newTender, nil
 }
 
-// RollbackTender Только Ответственный за тендер может совершить откат.
+// RollbackTender Только Ответственный за тендер может совершить откат. Откат не обходит порядок этапов тендера.
 func (ts *TenderService) RollbackTender(r *http.Request, tenderID string, version int32, username string) (models.Tender, error) {
 	var emptyTender models.Tender
 	err := ts.storage.CheckTenderExists(r.Context(), tenderID)
@@ -120,5 +238,29 @@ func (ts *TenderService) RollbackTender(r *http.Request, tenderID string, versio
 		return emptyTender, err
 	}
 
-	return ts.storage.RollbackTender(r.Context(), tenderID, version, username)
+	err = ts.checkRollbackStatus(r.Context(), tenderID, version)
+	if err != nil {
+		return emptyTender, err
+	}
+
+	var newTender models.Tender
+	err = ts.storage.WithTx(r.Context(), func(ctx context.Context) error {
+		newTender, err = ts.storage.RollbackTender(ctx, tenderID, version, username)
+		if err != nil {
+			return err
+		}
+
+		err = ts.storage.RestoreAttachments(ctx, models.TenderAttachment, tenderID, version, newTender.Version)
+		if err != nil {
+			return err
+		}
+
+		reason := fmt.Sprintf("rollback to version %d", version)
+		return appendEvent(ctx, ts.storage, models.TenderRolledBack, models.TenderAggregate, newTender.ID, username, reason, newTender)
+	})
+	if err != nil {
+		return emptyTender, err
+	}
+
+	return newTender, nil
 }
\ No newline at end of file
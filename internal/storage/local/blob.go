@@ -0,0 +1,105 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"zadanie-6105/internal/models/config"
+	"zadanie-6105/internal/storage"
+	"zadanie-6105/internal/util"
+)
+
+const dirPerm = 0o750
+
+// BlobStore Хранит вложения файлами в локальной директории.
+type BlobStore struct {
+	root string
+}
+
+func NewLocalBlobStore(cfg *config.AttachmentConfig) (storage.BlobStore, error) {
+	if err := os.MkdirAll(cfg.Dir, dirPerm); err != nil {
+		return nil, fmt.Errorf("storage.NewLocalBlobStore: %w", err)
+	}
+
+	return &BlobStore{root: cfg.Dir}, nil
+}
+
+// path Раскладывает файлы по подкаталогам по первым символам ключа.
+func (b *BlobStore) path(key string) (string, error) {
+	if len(key) < 2 || filepath.Base(key) != key {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(b.root, key[:2], key), nil
+}
+
+// Put Пишет во временный файл и переименовывает, чтобы читатели не видели недописанный файл.
+func (b *BlobStore) Put(_ context.Context, key string, r io.Reader) error {
+	const op = "storage.BlobStore.Put"
+
+	path, err := b.path(key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (b *BlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	const op = "storage.BlobStore.Get"
+
+	path, err := b.path(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, util.MyResponseError{Status: http.StatusNotFound, Msg: util.AttachmentNotFound}
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return f, nil
+}
+
+func (b *BlobStore) Delete(_ context.Context, key string) error {
+	const op = "storage.BlobStore.Delete"
+
+	path, err := b.path(key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+func (d *Database) CreateEmployee(ctx context.Context, employee *models.Employee) (models.Employee, error) {
+	const op = "storage.CreateEmployee"
+
+	query := `INSERT INTO employee (username, first_name, last_name)
+				VALUES ($1, $2, $3)
+				RETURNING id, username, COALESCE(first_name, '') AS first_name, COALESCE(last_name, '') AS last_name,
+					created_at, updated_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, employee.Username, employee.FirstName, employee.LastName)
+	if err != nil {
+		return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newEmployee models.Employee
+	if err = pgxscan.ScanOne(&newEmployee, rows); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.Employee{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.UserExists}
+		}
+		return models.Employee{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newEmployee, nil
+}
+
+// CreateOrganization Без заданного ID идентификатор выдаёт база.
+func (d *Database) CreateOrganization(ctx context.Context, organization *models.Organization) (models.Organization, error) {
+	const op = "storage.CreateOrganization"
+
+	query := `INSERT INTO organization (id, name, description, type)
+				VALUES (COALESCE($1, uuid_generate_v4()), $2, $3, $4)
+				RETURNING id, name, COALESCE(description, '') AS description, type, created_at, updated_at;`
+
+	id := uuid.NullUUID{UUID: organization.ID, Valid: organization.ID != uuid.Nil}
+	rows, err := d.conn(ctx).Query(ctx, query, id, organization.Name, organization.Desc, organization.Type)
+	if err != nil {
+		return models.Organization{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newOrganization models.Organization
+	if err = pgxscan.ScanOne(&newOrganization, rows); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.Organization{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.OrganizationExists}
+		}
+		return models.Organization{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newOrganization, nil
+}
+
+// AddOrganizationResponsible Пользователь может быть Ответственным только одной организации.
+func (d *Database) AddOrganizationResponsible(ctx context.Context, orgID, username string) (models.OrganizationResponsible, error) {
+	const op = "storage.AddOrganizationResponsible"
+
+	query := `INSERT INTO organization_responsible (organization_id, user_id)
+				SELECT $1, e.id
+				FROM employee e
+				WHERE e.username = $2
+				RETURNING id, organization_id, user_id;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, orgID, username)
+	if err != nil {
+		return models.OrganizationResponsible{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var responsible models.OrganizationResponsible
+	if err = pgxscan.ScanOne(&responsible, rows); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.OrganizationResponsible{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.AlreadyResponsible}
+		}
+		return models.OrganizationResponsible{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return responsible, nil
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const attachmentColumns = `id, entity_type, entity_id, file_name, content_type, size, checksum, storage_key, uploaded_by, version_from, version_to, created_at`
+
+// BumpTenderVersion Создаёт новую версию тендера без изменения полей, триггеры пишут историю.
+func (d *Database) BumpTenderVersion(ctx context.Context, tenderID string) (int, error) {
+	const op = "storage.BumpTenderVersion"
+
+	query := `UPDATE tender
+				SET updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1
+				RETURNING version;`
+
+	var version int
+	if err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&version); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, nil
+}
+
+// BumpBidVersion Создаёт новую версию предложения без изменения полей, триггеры пишут историю.
+func (d *Database) BumpBidVersion(ctx context.Context, bidID string) (int, error) {
+	const op = "storage.BumpBidVersion"
+
+	query := `UPDATE bid
+				SET updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1
+				RETURNING version;`
+
+	var version int
+	if err := d.conn(ctx).QueryRow(ctx, query, bidID).Scan(&version); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, nil
+}
+
+func (d *Database) CreateAttachment(ctx context.Context, a *models.Attachment) (models.Attachment, error) {
+	const op = "storage.CreateAttachment"
+
+	query := `INSERT INTO attachment (entity_type, entity_id, file_name, content_type, size, checksum, storage_key, uploaded_by, version_from)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				RETURNING ` + attachmentColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, a.EntityType, a.EntityID, a.FileName, a.ContentType, a.Size, a.Checksum,
+		a.StorageKey, a.UploadedBy, a.VersionFrom)
+	if err != nil {
+		return models.Attachment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newAttachment models.Attachment
+	if err = pgxscan.ScanOne(&newAttachment, rows); err != nil {
+		return models.Attachment{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newAttachment, nil
+}
+
+// GetAttachments Возвращает вложения, видимые в указанной версии сущности. Версия 0 - текущая.
+func (d *Database) GetAttachments(ctx context.Context, entityType models.AttachmentEntity, entityID string, version int32) ([]models.Attachment, error) {
+	const op = "storage.GetAttachments"
+
+	query := `WITH v AS (
+					SELECT COALESCE(NULLIF($3::INT, 0),
+						(SELECT version FROM tender WHERE id = $2 AND $1 = 'Tender'),
+						(SELECT version FROM bid WHERE id = $2 AND $1 = 'Bid')) AS version
+				)
+				SELECT ` + attachmentColumns + `
+				FROM attachment, v
+				WHERE entity_type = $1 AND entity_id = $2
+					AND version_from <= v.version AND (version_to IS NULL OR version_to > v.version)
+				ORDER BY created_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, entityType, entityID, version)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var attachments []models.Attachment
+	if err = pgxscan.ScanAll(&attachments, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return attachments, nil
+}
+
+func (d *Database) GetAttachment(ctx context.Context, entityType models.AttachmentEntity, entityID, attachmentID string) (models.Attachment, error) {
+	const op = "storage.GetAttachment"
+
+	query := `SELECT ` + attachmentColumns + `
+				FROM attachment
+				WHERE id = $1 AND entity_type = $2 AND entity_id = $3;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, attachmentID, entityType, entityID)
+	if err != nil {
+		return models.Attachment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var attachment models.Attachment
+	if err = pgxscan.ScanOne(&attachment, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Attachment{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.AttachmentNotFound}
+		}
+		return models.Attachment{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return attachment, nil
+}
+
+// CloseAttachment Скрывает вложение начиная с версии version.
+func (d *Database) CloseAttachment(ctx context.Context, attachmentID string, version int) error {
+	const op = "storage.CloseAttachment"
+
+	query := `UPDATE attachment
+				SET version_to = $2
+				WHERE id = $1 AND version_to IS NULL;`
+
+	tag, err := d.conn(ctx).Exec(ctx, query, attachmentID, version)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return util.MyResponseError{Status: http.StatusNotFound, Msg: util.AttachmentNotFound}
+	}
+
+	return nil
+}
+
+// RestoreAttachments Приводит набор вложений версии toVersion к набору версии fromVersion при откате.
+func (d *Database) RestoreAttachments(ctx context.Context, entityType models.AttachmentEntity, entityID string, fromVersion int32, toVersion int) error {
+	const op = "storage.RestoreAttachments"
+
+	closeQuery := `UPDATE attachment
+				SET version_to = $4
+				WHERE entity_type = $1 AND entity_id = $2 AND version_to IS NULL AND version_from > $3;`
+
+	if _, err := d.conn(ctx).Exec(ctx, closeQuery, entityType, entityID, fromVersion, toVersion); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	reopenQuery := `INSERT INTO attachment (entity_type, entity_id, file_name, content_type, size, checksum, storage_key, uploaded_by, version_from)
+				SELECT entity_type, entity_id, file_name, content_type, size, checksum, storage_key, uploaded_by, $4
+				FROM attachment
+				WHERE entity_type = $1 AND entity_id = $2 AND version_from <= $3 AND version_to > $3 AND version_to < $4;`
+
+	if _, err := d.conn(ctx).Exec(ctx, reopenQuery, entityType, entityID, fromVersion, toVersion); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
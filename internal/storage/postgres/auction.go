@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const auctionColumns = `tender_id, starts_at, ends_at, duration_seconds, extension_seconds, min_step::FLOAT8 AS min_step,
+					start_price::FLOAT8 AS start_price,
+					(SELECT MIN(p.price) FROM auction_price p WHERE p.tender_id = auction.tender_id)::FLOAT8 AS best_price,
+					winner_bid_id, closed_at, created_at`
+
+// CheckTenderAuctionAllowed Аукцион проводится только по тендерам вида Delivery с открытыми ценами.
+func (d *Database) CheckTenderAuctionAllowed(ctx context.Context, tenderID string) error {
+	const op = "storage.CheckTenderAuctionAllowed"
+
+	query := `SELECT service_type = 'Delivery' AND NOT sealed AND NOT two_envelope
+				FROM tender
+				WHERE id = $1;`
+
+	var allowed bool
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&allowed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !allowed {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.AuctionNotAllowed}
+	}
+
+	return nil
+}
+
+// ScheduleAuction Создаёт аукцион или меняет его параметры, пока он не начался.
+func (d *Database) ScheduleAuction(ctx context.Context, auction *models.Auction, now time.Time) (models.Auction, error) {
+	const op = "storage.ScheduleAuction"
+
+	query := `INSERT INTO auction (tender_id, starts_at, ends_at, duration_seconds, extension_seconds, min_step, start_price)
+				VALUES ($1, $2, $2::TIMESTAMP + make_interval(secs => $3::INT), $3, $4, $5, $6)
+				ON CONFLICT (tender_id) DO UPDATE
+				SET starts_at = EXCLUDED.starts_at,
+					ends_at = EXCLUDED.ends_at,
+					duration_seconds = EXCLUDED.duration_seconds,
+					extension_seconds = EXCLUDED.extension_seconds,
+					min_step = EXCLUDED.min_step,
+					start_price = EXCLUDED.start_price
+				WHERE auction.starts_at > $7
+				RETURNING ` + auctionColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, auction.TenderID, auction.StartsAt, auction.DurationSeconds,
+		auction.ExtensionSeconds, auction.MinStep, auction.StartPrice, now)
+	if err != nil {
+		return models.Auction{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newAuction models.Auction
+	if err = pgxscan.ScanOne(&newAuction, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Auction{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.AuctionStarted}
+		}
+		return models.Auction{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newAuction, nil
+}
+
+func (d *Database) GetAuction(ctx context.Context, tenderID string) (models.Auction, error) {
+	const op = "storage.GetAuction"
+
+	query := `SELECT ` + auctionColumns + `
+				FROM auction
+				WHERE tender_id = $1;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return models.Auction{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var auction models.Auction
+	if err = pgxscan.ScanOne(&auction, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Auction{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.AuctionNotFound}
+		}
+		return models.Auction{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return auction, nil
+}
+
+// LockAuction Сериализует подачу цен и закрытие аукциона. Должен вызываться внутри WithTx.
+func (d *Database) LockAuction(ctx context.Context, tenderID string) error {
+	const op = "storage.LockAuction"
+
+	query := `SELECT 1
+				FROM auction
+				WHERE tender_id = $1
+				FOR UPDATE;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.AuctionNotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (d *Database) CreateAuctionPrice(ctx context.Context, tenderID, bidID string, price float64, now time.Time) (models.AuctionPrice, error) {
+	const op = "storage.CreateAuctionPrice"
+
+	query := `INSERT INTO auction_price (tender_id, bid_id, price, created_at)
+				VALUES ($1, $2, $3, $4)
+				RETURNING id, tender_id, bid_id, price::FLOAT8 AS price, created_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, bidID, price, now)
+	if err != nil {
+		return models.AuctionPrice{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newPrice models.AuctionPrice
+	if err = pgxscan.ScanOne(&newPrice, rows); err != nil {
+		return models.AuctionPrice{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newPrice, nil
+}
+
+func (d *Database) ExtendAuction(ctx context.Context, tenderID string, endsAt time.Time) error {
+	const op = "storage.ExtendAuction"
+
+	query := `UPDATE auction
+				SET ends_at = $1
+				WHERE tender_id = $2;`
+
+	_, err := d.conn(ctx).Exec(ctx, query, endsAt, tenderID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetAuctionRanking Лучшая цена каждого участника. При равной цене выше тот, кто подал её раньше.
+func (d *Database) GetAuctionRanking(ctx context.Context, tenderID string) ([]models.AuctionRank, error) {
+	const op = "storage.GetAuctionRanking"
+
+	query := `SELECT ROW_NUMBER() OVER (ORDER BY best.price, best.created_at) AS rank, best.bid_id, best.price::FLOAT8 AS price
+				FROM (
+					SELECT DISTINCT ON (bid_id) bid_id, price, created_at
+					FROM auction_price
+					WHERE tender_id = $1
+					ORDER BY bid_id, price, created_at
+				) best
+				ORDER BY rank;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var ranking []models.AuctionRank
+	if err = pgxscan.ScanAll(&ranking, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return ranking, nil
+}
+
+// CloseDueAuctions Закрывает истёкшие аукционы и назначает победителем участника с наименьшей ценой.
+// Аукционы, по которым сейчас подаётся цена, пропускаются до следующего прохода.
+func (d *Database) CloseDueAuctions(ctx context.Context, now time.Time, limit int32) ([]models.Auction, error) {
+	const op = "storage.CloseDueAuctions"
+
+	query := `UPDATE auction
+				SET closed_at = $1,
+					winner_bid_id = (
+						SELECT p.bid_id
+						FROM auction_price p
+						WHERE p.tender_id = auction.tender_id
+						ORDER BY p.price, p.created_at
+						LIMIT 1
+					)
+				WHERE tender_id IN (
+						SELECT tender_id
+						FROM auction
+						WHERE closed_at IS NULL AND ends_at <= $1
+						ORDER BY ends_at
+						LIMIT $2
+						FOR UPDATE SKIP LOCKED
+					)
+					AND closed_at IS NULL AND ends_at <= $1
+				RETURNING ` + auctionColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var closed []models.Auction
+	if err = pgxscan.ScanAll(&closed, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return closed, nil
+}
+
+// GetUserTenderBidIDs Предложения пользователя по тендеру. Пустой список означает, что пользователь не участвует.
+func (d *Database) GetUserTenderBidIDs(ctx context.Context, tenderID, username string) ([]uuid.UUID, error) {
+	const op = "storage.GetUserTenderBidIDs"
+
+	query := `SELECT b.id
+				FROM bid b
+				JOIN employee e ON (b.author_id = e.id)
+				WHERE b.tender_id = $1 AND e.username = $2;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, username)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var ids []uuid.UUID
+	if err = pgxscan.ScanAll(&ids, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return ids, nil
+}
+
+func (d *Database) GetBidTenderID(ctx context.Context, bidID string) (string, error) {
+	const op = "storage.GetBidTenderID"
+
+	var tenderID string
+	err := d.conn(ctx).QueryRow(ctx, `SELECT tender_id::TEXT FROM bid WHERE id = $1;`, bidID).Scan(&tenderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tenderID, nil
+}
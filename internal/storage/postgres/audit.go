@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"zadanie-6105/internal/models"
+)
+
+// auditLockKey Ключ advisory-блокировки, сериализующей добавление в цепочку аудита.
+const auditLockKey = 6105027
+
+// LockAuditLog Блокирует цепочку аудита до конца текущей транзакции.
+func (d *Database) LockAuditLog(ctx context.Context) error {
+	const op = "storage.LockAuditLog"
+
+	_, err := d.conn(ctx).Exec(ctx, `SELECT pg_advisory_xact_lock($1);`, auditLockKey)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (d *Database) GetLastAuditHash(ctx context.Context) (string, error) {
+	const op = "storage.GetLastAuditHash"
+
+	query := `SELECT hash
+				FROM audit_log
+				ORDER BY seq DESC
+				LIMIT 1;`
+
+	var hash string
+	err := d.conn(ctx).QueryRow(ctx, query).Scan(&hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return hash, nil
+}
+
+// GetUserOrganizationID Возвращает организацию, за которую отвечает пользователь, если она есть.
+func (d *Database) GetUserOrganizationID(ctx context.Context, username string) (uuid.NullUUID, error) {
+	const op = "storage.GetUserOrganizationID"
+
+	query := `SELECT o.organization_id
+				FROM organization_responsible o
+				JOIN employee e ON o.user_id = e.id
+				WHERE e.username = $1;`
+
+	var orgID uuid.NullUUID
+	err := d.conn(ctx).QueryRow(ctx, query, username).Scan(&orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.NullUUID{}, nil
+		}
+		return uuid.NullUUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return orgID, nil
+}
+
+func (d *Database) GetUsernameByID(ctx context.Context, userID string) (string, error) {
+	const op = "storage.GetUsernameByID"
+
+	query := `SELECT username
+				FROM employee
+				WHERE id = $1;`
+
+	var username string
+	err := d.conn(ctx).QueryRow(ctx, query, userID).Scan(&username)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return username, nil
+}
+
+func (d *Database) AppendAuditEntry(ctx context.Context, entry *models.AuditEntry) error {
+	const op = "storage.AppendAuditEntry"
+
+	query := `INSERT INTO audit_log (id, actor, organization_id, request_id, client_ip, action, target, outcome, status, prev_hash, hash, created_at)
+				VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9, $10, $11, $12);`
+
+	_, err := d.conn(ctx).Exec(ctx, query, entry.ID, entry.Actor, entry.OrganizationID, entry.RequestID, entry.ClientIP,
+		entry.Action, entry.Target, entry.Outcome, entry.Status, entry.PrevHash, entry.Hash, entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (d *Database) GetAuditLog(ctx context.Context, orgID uuid.UUID, offset, limit int32) ([]models.AuditEntry, error) {
+	const op = "storage.GetAuditLog"
+
+	// chain_prev_hash - хеш предыдущей записи общей цепочки, какой бы организации она ни принадлежала.
+	query := `SELECT id, actor, organization_id, request_id, client_ip, action, COALESCE(target, '') AS target, outcome, status, prev_hash, hash, created_at,
+					COALESCE((SELECT p.hash FROM audit_log p WHERE p.seq < a.seq ORDER BY p.seq DESC LIMIT 1), '') AS chain_prev_hash
+				FROM audit_log a
+				WHERE organization_id = $1
+				ORDER BY seq DESC
+				OFFSET $2
+				FETCH NEXT $3 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, orgID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var entries []models.AuditEntry
+	if err = pgxscan.ScanAll(&entries, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return entries, nil
+}
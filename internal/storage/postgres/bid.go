@@ -10,11 +10,11 @@ import (
 func (d *Database) CreateBid(ctx context.Context, bid *models.Bid) (models.Bid, error) {
 	const op = "storage.CreateBid"
 
-	query := `INSERT INTO bid (name, description, tender_id, author_type, author_id)
-				VALUES ($1,	$2, $3, $4, $5)
-				RETURNING id, name, description, status, tender_id, author_type, author_id, version, created_at;`
+	query := `INSERT INTO bid (name, description, tender_id, author_type, author_id, price, technical_proposal, commercial_proposal, sealed_payload)
+				VALUES ($1,	$2, $3, $4, $5, $6, $7, $8, $9)
+				RETURNING id, name, description, status, tender_id, author_type, author_id, author_username, version, price, technical_proposal, commercial_proposal, sealed_payload IS NOT NULL AS sealed, created_at;`
 
-	rows, err := d.Pool.Query(ctx, query, bid.Name, bid.Description, bid.TenderID, bid.AuthorType, bid.AuthorID)
+	rows, err := d.conn(ctx).Query(ctx, query, bid.Name, bid.Description, bid.TenderID, bid.AuthorType, bid.AuthorID, bid.Price, bid.TechnicalProposal, bid.CommercialProposal, bid.SealedPayload)
 	if err != nil {
 		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -32,7 +32,7 @@ func (d *Database) CreateBid(ctx context.Context, bid *models.Bid) (models.Bid,
 func (d *Database) GetUserBids(ctx context.Context, offset, limit int32, username string) ([]models.Bid, error) {
 	const op = "storage.GetUserBids"
 
-	query := `SELECT b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.created_at, b.updated_at
+	query := `SELECT b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.price, b.technical_proposal, b.commercial_proposal, b.sealed_payload IS NOT NULL AS sealed, b.created_at, b.updated_at
 				FROM bid b
 				JOIN employee e ON (b.author_id = e.id)
 				WHERE e.username = $1
@@ -40,7 +40,7 @@ func (d *Database) GetUserBids(ctx context.Context, offset, limit int32, usernam
 				OFFSET $2
 				FETCH NEXT $3 ROWS ONLY;`
 
-	rows, err := d.Pool.Query(ctx, query, username, offset, limit)
+	rows, err := d.conn(ctx).Query(ctx, query, username, offset, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -55,14 +55,25 @@ func (d *Database) GetUserBids(ctx context.Context, offset, limit int32, usernam
 	return bids, err
 }
 
-func (d *Database) GetBidsForTender(ctx context.Context, tenderID string, offset, limit int32, status ...string) ([]models.Bid, error) {
-	const op = "storage.GetBidsForTender"
-
-	query := `SELECT b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.created_at, b.updated_at
+// bidsForTenderQuery Предложения тендера с фильтром по статусу, без пагинации. Общий для списка и выгрузки.
+const bidsForTenderQuery = `SELECT b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.technical_proposal, b.sealed_payload IS NOT NULL AS sealed, b.created_at, b.updated_at,
+					b.tender_version,
+					EXISTS (SELECT 1 FROM clarification c WHERE c.tender_id = b.tender_id AND c.tender_version > b.tender_version) AS pre_clarification,
+					CASE WHEN ` + commercialVisible + ` THEN b.price END AS price,
+					CASE WHEN ` + commercialVisible + ` THEN b.commercial_proposal END AS commercial_proposal,
+					COALESCE(q.qualification::TEXT, '') AS qualification,
+					` + bidDebarred + ` AS debarred
 				FROM bid b
 				JOIN employee e ON (b.author_id = e.id)
+				JOIN tender t ON (b.tender_id = t.id)
+				LEFT JOIN bid_qualification_record q ON (q.bid_id = b.id)
 				WHERE b.tender_id = $1 AND (NULLIF($2, '') IS NULL OR b.status = $2::bid_status)
-				ORDER BY b.name
+				ORDER BY b.name`
+
+func (d *Database) GetBidsForTender(ctx context.Context, tenderID string, offset, limit int32, status ...string) ([]models.Bid, error) {
+	const op = "storage.GetBidsForTender"
+
+	query := bidsForTenderQuery + `
 				OFFSET $3
 				FETCH NEXT $4 ROWS ONLY;`
 
@@ -76,7 +87,7 @@ func (d *Database) GetBidsForTender(ctx context.Context, tenderID string, offset
 		return nil, fmt.Errorf("%s: %s", op, "too much statuses")
 	}
 
-	rows, err := d.Pool.Query(ctx, query, tenderID, singleStatus, offset, limit)
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, singleStatus, offset, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -99,7 +110,7 @@ func (d *Database) GetBidStatus(ctx context.Context, bidID, username string) (st
 				JOIN employee e ON (b.author_id = e.id)
 				WHERE b.id = $1 AND e.username = $2;`
 
-	rows, err := d.Pool.Query(ctx, query, bidID, username)
+	rows, err := d.conn(ctx).Query(ctx, query, bidID, username)
 	if err != nil {
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
@@ -121,9 +132,9 @@ func (d *Database) UpdateBidStatus(ctx context.Context, bidID, status, username
 				SET status = $1
 				FROM employee e
 				WHERE b.author_id = e.id AND b.id = $2 AND e.username = $3
-				RETURNING b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.created_at, b.updated_at;`
+				RETURNING b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.price, b.technical_proposal, b.commercial_proposal, b.sealed_payload IS NOT NULL AS sealed, b.created_at, b.updated_at;`
 
-	rows, err := d.Pool.Query(ctx, query, status, bidID, username)
+	rows, err := d.conn(ctx).Query(ctx, query, status, bidID, username)
 	if err != nil {
 		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -144,12 +155,15 @@ func (d *Database) EditBid(ctx context.Context, bid *models.Bid, bidID, username
 	query := `UPDATE bid b
 				SET 
 					name = COALESCE(NULLIF($1, ''), name), 
-					description = COALESCE(NULLIF($2, ''), description) 
+					description = COALESCE(NULLIF($2, ''), description),
+					price = COALESCE($5, price),
+					technical_proposal = COALESCE($6, technical_proposal),
+					commercial_proposal = COALESCE($7, commercial_proposal)
 				FROM employee e
 				WHERE b.author_id = e.id AND b.id = $3 AND e.username = $4
-				RETURNING b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.created_at, b.updated_at;`
+				RETURNING b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.price, b.technical_proposal, b.commercial_proposal, b.sealed_payload IS NOT NULL AS sealed, b.created_at, b.updated_at;`
 
-	rows, err := d.Pool.Query(ctx, query, bid.Name, bid.Description, bidID, username)
+	rows, err := d.conn(ctx).Query(ctx, query, bid.Name, bid.Description, bidID, username, bid.Price, bid.TechnicalProposal, bid.CommercialProposal)
 	if err != nil {
 		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -171,9 +185,9 @@ func (d *Database) SubmitBidDecision(ctx context.Context, bidID, decision, usern
 				SET decision = $1
 				FROM employee e
 				WHERE b.author_id = e.id AND b.id = $2 AND e.username = $3
-				RETURNING b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.created_at, b.updated_at;`
+				RETURNING b.id, b.name, b.description, b.tender_id, b.status, b.decision, b.author_type, b.author_id, b.version, b.price, b.technical_proposal, b.commercial_proposal, b.sealed_payload IS NOT NULL AS sealed, b.created_at, b.updated_at;`
 
-	rows, err := d.Pool.Query(ctx, query, decision, bidID, username)
+	rows, err := d.conn(ctx).Query(ctx, query, decision, bidID, username)
 	if err != nil {
 		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -188,21 +202,19 @@ func (d *Database) SubmitBidDecision(ctx context.Context, bidID, decision, usern
 	return updatedBid, nil
 }
 
-func (d *Database) SubmitBidFeedback(ctx context.Context, bidID, bidFeedback, username string) (models.Bid, error) {
+func (d *Database) SubmitBidFeedback(ctx context.Context, review *models.Review) (models.Bid, error) {
 	const op = "storage.SubmitBidFeedback"
 
-	insertQuery := `INSERT INTO review (bid_id, author_username, description)
-				VALUES ($1,	$2, $3);`
-	_, err := d.Pool.Exec(ctx, insertQuery, bidID, username, bidFeedback)
+	_, err := d.CreateReview(ctx, review)
 	if err != nil {
 		return models.Bid{}, err
 	}
 
-	query := `SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at
+	query := `SELECT id, name, description, status, tender_id, author_type, author_id, version, price, technical_proposal, commercial_proposal, sealed_payload IS NOT NULL AS sealed, created_at
 				FROM bid
 				WHERE id = $1;`
 
-	rows, err := d.Pool.Query(ctx, query, bidID)
+	rows, err := d.conn(ctx).Query(ctx, query, review.BidID)
 	if err != nil {
 		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -217,16 +229,55 @@ func (d *Database) SubmitBidFeedback(ctx context.Context, bidID, bidFeedback, us
 	return bid, nil
 }
 
-func (d *Database) GetBidReviews(ctx context.Context, authorUsername string, offset, limit int32) ([]models.Review, error) {
-	const op = "storage.GetBidReviews"
+// reviewListColumns Поля отзыва для публичных списков, без сведений о модерации. Ожидает псевдоним r (review).
+const reviewListColumns = `r.id, r.bid_id, r.author_username, r.description, r.rating, r.completed, r.created_at,
+					r.reply, r.reply_author_username, r.replied_at, r.updated_at`
+
+// bidReviewsQuery Отзывы на предложения автора, если он подавал предложение на тендер $1, без пагинации.
+const bidReviewsQuery = `SELECT ` + reviewListColumns + `
+				FROM review r
+				JOIN bid b ON (r.bid_id = b.id)
+				WHERE b.author_username = $2 AND r.hidden_at IS NULL
+				AND EXISTS (SELECT 1 FROM bid tb WHERE tb.tender_id = $1 AND tb.author_username = $2)
+				ORDER BY r.created_at DESC`
 
-	query := `SELECT r.id, r.bid_id, r.author_username, r.description, r.created_at
+// reviewsForBidQuery Видимые отзывы на предложение, без пагинации.
+const reviewsForBidQuery = `SELECT ` + reviewListColumns + `
 				FROM review r
-				WHERE r.author_username = $1
+				WHERE r.bid_id = $1 AND r.hidden_at IS NULL
+				ORDER BY r.created_at DESC`
+
+// GetBidReviews Отзывы на предложения автора, если он подавал предложение на тендер tenderID.
+func (d *Database) GetBidReviews(ctx context.Context, tenderID, authorUsername string, offset, limit int32) ([]models.Review, error) {
+	const op = "storage.GetBidReviews"
+
+	query := bidReviewsQuery + `
+				OFFSET $3
+				FETCH NEXT $4 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, authorUsername, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var reviews []models.Review
+	err = pgxscan.ScanAll(&reviews, rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return reviews, nil
+}
+
+func (d *Database) GetReviewsForBid(ctx context.Context, bidID string, offset, limit int32) ([]models.Review, error) {
+	const op = "storage.GetReviewsForBid"
+
+	query := reviewsForBidQuery + `
 				OFFSET $2
 				FETCH NEXT $3 ROWS ONLY;`
 
-	rows, err := d.Pool.Query(ctx, query, authorUsername, offset, limit)
+	rows, err := d.conn(ctx).Query(ctx, query, bidID, offset, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -245,7 +296,7 @@ func (d *Database) RollbackBid(ctx context.Context, bidID string, version int32,
 	const op = "storage.RollbackBid"
 
 	query := `SELECT * FROM rollback_bid_version($1::UUID, $2::INT, $3::VARCHAR)`
-	rows, err := d.Pool.Query(ctx, query, bidID, version, username)
+	rows, err := d.conn(ctx).Query(ctx, query, bidID, version, username)
 	if err != nil {
 		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const clarificationColumns = `id, tender_id, author_username, question, answer, answered_by, visibility, tender_version, created_at, answered_at`
+
+func (d *Database) CreateClarification(ctx context.Context, c *models.Clarification) (models.Clarification, error) {
+	const op = "storage.CreateClarification"
+
+	query := `INSERT INTO clarification (tender_id, author_username, question)
+				VALUES ($1, $2, $3)
+				RETURNING ` + clarificationColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, c.TenderID, c.AuthorUsername, c.Question)
+	if err != nil {
+		return models.Clarification{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newClarification models.Clarification
+	if err = pgxscan.ScanOne(&newClarification, rows); err != nil {
+		return models.Clarification{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newClarification, nil
+}
+
+// GetClarifications Возвращает вопросы по тендеру. Если all = false, только вопросы пользователя
+// и вопросы с опубликованными ответами.
+func (d *Database) GetClarifications(ctx context.Context, tenderID, username string, all bool, offset, limit int32) ([]models.Clarification, error) {
+	const op = "storage.GetClarifications"
+
+	query := `SELECT ` + clarificationColumns + `
+				FROM clarification
+				WHERE tender_id = $1
+					AND ($2 OR author_username = $3 OR (answer IS NOT NULL AND visibility = 'Public'))
+				ORDER BY created_at
+				OFFSET $4
+				FETCH NEXT $5 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, all, username, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var clarifications []models.Clarification
+	if err = pgxscan.ScanAll(&clarifications, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return clarifications, nil
+}
+
+func (d *Database) CheckClarificationExists(ctx context.Context, tenderID, clarificationID string) error {
+	const op = "storage.CheckClarificationExists"
+
+	query := `SELECT 1
+				FROM clarification
+				WHERE id = $1 AND tender_id = $2;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, clarificationID, tenderID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.ClarificationNotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AnswerClarification Записывает ответ, если его ещё нет.
+func (d *Database) AnswerClarification(ctx context.Context, c *models.Clarification) (models.Clarification, error) {
+	const op = "storage.AnswerClarification"
+
+	query := `UPDATE clarification
+				SET answer = $1, answered_by = $2, visibility = $3, tender_version = $4, answered_at = CURRENT_TIMESTAMP
+				WHERE id = $5 AND answer IS NULL
+				RETURNING ` + clarificationColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, c.Answer, c.AnsweredBy, c.Visibility, c.TenderVersion, c.ID)
+	if err != nil {
+		return models.Clarification{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var answered models.Clarification
+	if err = pgxscan.ScanOne(&answered, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Clarification{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.AlreadyAnswered}
+		}
+		return models.Clarification{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return answered, nil
+}
@@ -0,0 +1,261 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const contractColumns = `id, tender_id, bid_id, organization_id, supplier_username, amount, status,
+					created_by, closed_by, closed_at, review_id, created_at, updated_at`
+
+const milestoneColumns = `id, contract_id, deliverable, description, due_date, amount, status,
+					supplier_comment, decision_comment, decided_by, decided_at, created_at, updated_at`
+
+func (d *Database) scanContract(ctx context.Context, op string, notFound util.MyResponseError, query string, args ...any) (models.Contract, error) {
+	rows, err := d.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return models.Contract{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	op2 := op + "pgxscan"
+	var contract models.Contract
+	err = pgxscan.ScanOne(&contract, rows)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Contract{}, notFound
+		}
+		return models.Contract{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return contract, nil
+}
+
+func (d *Database) scanMilestone(ctx context.Context, op string, query string, args ...any) (models.Milestone, error) {
+	rows, err := d.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return models.Milestone{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	op2 := op + "pgxscan"
+	var milestone models.Milestone
+	err = pgxscan.ScanOne(&milestone, rows)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Milestone{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.MilestoneNotFound}
+		}
+		return models.Milestone{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return milestone, nil
+}
+
+// CheckBidWon Предложение выиграло, если по нему принято решение Approved, оно победило в лоте или в аукционе.
+func (d *Database) CheckBidWon(ctx context.Context, bidID string) error {
+	const op = "storage.CheckBidWon"
+
+	query := `SELECT 1
+				FROM bid b
+				WHERE b.id = $1
+				AND (b.decision = 'Approved'
+					OR EXISTS (SELECT 1 FROM lot l WHERE l.winning_bid_id = b.id)
+					OR EXISTS (SELECT 1 FROM auction a WHERE a.winner_bid_id = b.id));`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, bidID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusConflict, Msg: util.BidNotWon}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CreateContract Стороны и сумма берутся из предложения и тендера, для победителя аукциона - его лучшая цена.
+// Повторный контракт по предложению не создаётся.
+func (d *Database) CreateContract(ctx context.Context, bidID, username string) (models.Contract, error) {
+	query := `INSERT INTO contract (tender_id, bid_id, organization_id, supplier_username, amount, created_by)
+				SELECT b.tender_id, b.id, t.organization_id, b.author_username,
+					COALESCE((SELECT MIN(p.price)
+						FROM auction a
+						JOIN auction_price p ON (p.tender_id = a.tender_id AND p.bid_id = a.winner_bid_id)
+						WHERE a.winner_bid_id = b.id), b.price),
+					$2
+				FROM bid b
+				JOIN tender t ON (t.id = b.tender_id)
+				WHERE b.id = $1
+				ON CONFLICT (bid_id) DO NOTHING
+				RETURNING ` + contractColumns + `;`
+
+	return d.scanContract(ctx, "storage.CreateContract",
+		util.MyResponseError{Status: http.StatusConflict, Msg: util.ContractExists}, query, bidID, username)
+}
+
+func (d *Database) GetContract(ctx context.Context, contractID string) (models.Contract, error) {
+	query := `SELECT ` + contractColumns + `
+				FROM contract
+				WHERE id = $1;`
+
+	return d.scanContract(ctx, "storage.GetContract",
+		util.MyResponseError{Status: http.StatusNotFound, Msg: util.ContractNotFound}, query, contractID)
+}
+
+// GetUserContracts Контракты, где пользователь - поставщик или Ответственный организации-заказчика.
+func (d *Database) GetUserContracts(ctx context.Context, username string, offset, limit int32) ([]models.Contract, error) {
+	const op = "storage.GetUserContracts"
+
+	query := `SELECT ` + contractColumns + `
+				FROM contract c
+				WHERE c.supplier_username = $1
+				OR c.organization_id IN (
+					SELECT o.organization_id
+					FROM organization_responsible o
+					JOIN employee e ON (o.user_id = e.id)
+					WHERE e.username = $1
+				)
+				ORDER BY c.created_at DESC
+				OFFSET $2
+				FETCH NEXT $3 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, username, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	contracts := []models.Contract{}
+	if err = pgxscan.ScanAll(&contracts, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return contracts, nil
+}
+
+// CloseContract Закрывает действующий контракт и связывает его с отзывом заказчика.
+func (d *Database) CloseContract(ctx context.Context, contractID string, status models.ContractStatus, reviewID uuid.UUID, username string) (models.Contract, error) {
+	query := `UPDATE contract
+				SET status = $2, review_id = $3, closed_by = $4, closed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1 AND status = 'Active'
+				RETURNING ` + contractColumns + `;`
+
+	return d.scanContract(ctx, "storage.CloseContract",
+		util.MyResponseError{Status: http.StatusConflict, Msg: util.ContractNotActive}, query, contractID, status, reviewID, username)
+}
+
+func (d *Database) AddMilestone(ctx context.Context, milestone *models.Milestone) (models.Milestone, error) {
+	query := `INSERT INTO contract_milestone (contract_id, deliverable, description, due_date, amount)
+				VALUES ($1, $2, $3, $4, $5)
+				RETURNING ` + milestoneColumns + `;`
+
+	return d.scanMilestone(ctx, "storage.AddMilestone", query,
+		milestone.ContractID, milestone.Deliverable, milestone.Description, milestone.DueDate, milestone.Amount)
+}
+
+// GetMilestones Этапы контракта в порядке сроков.
+func (d *Database) GetMilestones(ctx context.Context, contractID string) ([]models.Milestone, error) {
+	const op = "storage.GetMilestones"
+
+	query := `SELECT ` + milestoneColumns + `
+				FROM contract_milestone
+				WHERE contract_id = $1
+				ORDER BY due_date, created_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, contractID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	milestones := []models.Milestone{}
+	if err = pgxscan.ScanAll(&milestones, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return milestones, nil
+}
+
+func (d *Database) GetMilestone(ctx context.Context, contractID, milestoneID string) (models.Milestone, error) {
+	query := `SELECT ` + milestoneColumns + `
+				FROM contract_milestone
+				WHERE contract_id = $1 AND id = $2;`
+
+	return d.scanMilestone(ctx, "storage.GetMilestone", query, contractID, milestoneID)
+}
+
+// GetMilestonesAmount Сумма всех этапов контракта.
+func (d *Database) GetMilestonesAmount(ctx context.Context, contractID string) (float64, error) {
+	const op = "storage.GetMilestonesAmount"
+
+	var amount float64
+	err := d.conn(ctx).QueryRow(ctx, `SELECT COALESCE(SUM(amount), 0)::FLOAT8 FROM contract_milestone WHERE contract_id = $1;`, contractID).Scan(&amount)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return amount, nil
+}
+
+// UpdateMilestoneStatus Отметка поставщика о ходе работ по этапу.
+func (d *Database) UpdateMilestoneStatus(ctx context.Context, milestoneID string, from, status models.MilestoneStatus, comment *string) (models.Milestone, error) {
+	query := `UPDATE contract_milestone
+				SET status = $2, supplier_comment = COALESCE($3, supplier_comment), updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1 AND status = $4
+				RETURNING ` + milestoneColumns + `;`
+
+	return milestoneTransition(d.scanMilestone(ctx, "storage.UpdateMilestoneStatus", query, milestoneID, status, comment, from))
+}
+
+// DecideMilestone Решение заказчика по сданному этапу.
+func (d *Database) DecideMilestone(ctx context.Context, milestoneID string, status models.MilestoneStatus, comment *string, username string) (models.Milestone, error) {
+	query := `UPDATE contract_milestone
+				SET status = $2, decision_comment = $3, decided_by = $4, decided_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1 AND status = 'Submitted'
+				RETURNING ` + milestoneColumns + `;`
+
+	return milestoneTransition(d.scanMilestone(ctx, "storage.DecideMilestone", query, milestoneID, status, comment, username))
+}
+
+// milestoneTransition Обновление этапа идёт с условием на прежний статус. Этап к этому моменту уже найден,
+// поэтому отсутствие строки значит, что параллельный запрос успел сменить статус.
+func milestoneTransition(milestone models.Milestone, err error) (models.Milestone, error) {
+	var customErr util.MyResponseError
+	if errors.As(err, &customErr) && customErr.Status == http.StatusNotFound {
+		return models.Milestone{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.InvalidMilestoneStatus}
+	}
+
+	return milestone, err
+}
+
+// CheckMilestonesAccepted Все этапы контракта приняты заказчиком, и хотя бы один этап есть.
+func (d *Database) CheckMilestonesAccepted(ctx context.Context, contractID string) error {
+	const op = "storage.CheckMilestonesAccepted"
+
+	query := `SELECT COUNT(*) FILTER (WHERE status = 'Accepted'), COUNT(*) FILTER (WHERE status <> 'Accepted')
+				FROM contract_milestone
+				WHERE contract_id = $1;`
+
+	var accepted, pending int
+	err := d.conn(ctx).QueryRow(ctx, query, contractID).Scan(&accepted, &pending)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if pending > 0 {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.MilestonesNotAccepted}
+	}
+	if accepted == 0 {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.NoAcceptedMilestones}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const debarmentColumns = `id, organization_id, supplier_organization_id, supplier_username, reason, starts_at, ends_at,
+					approved_by, lifted_by, lifted_at, expired_at, created_at`
+
+// debarmentActive Отстранение действует в своём периоде, пока его не сняли. Время хранится в UTC.
+// Ожидает псевдоним d (debarment).
+const debarmentActive = `d.lifted_at IS NULL
+					AND d.starts_at <= (NOW() AT TIME ZONE 'UTC') AND d.ends_at > (NOW() AT TIME ZONE 'UTC')`
+
+// bidDebarred Автор предложения или его организация отстранены на площадке или организацией тендера.
+// Ожидает псевдонимы b (bid) и t (tender).
+const bidDebarred = `EXISTS (
+					SELECT 1
+					FROM debarment d
+					LEFT JOIN organization_responsible r ON (r.user_id = b.author_id)
+					WHERE (d.organization_id IS NULL OR d.organization_id = t.organization_id)
+					AND (d.supplier_username = b.author_username OR d.supplier_organization_id = r.organization_id)
+					AND ` + debarmentActive + `
+				)`
+
+func (d *Database) CreateDebarment(ctx context.Context, debarment *models.Debarment) (models.Debarment, error) {
+	const op = "storage.CreateDebarment"
+
+	query := `INSERT INTO debarment (organization_id, supplier_organization_id, supplier_username, reason, starts_at, ends_at, approved_by)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+				RETURNING ` + debarmentColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, debarment.OrganizationID, debarment.SupplierOrganizationID, debarment.SupplierUsername,
+		debarment.Reason, debarment.StartsAt.UTC(), debarment.EndsAt.UTC(), debarment.ApprovedBy)
+	if err != nil {
+		return models.Debarment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newDebarment models.Debarment
+	if err = pgxscan.ScanOne(&newDebarment, rows); err != nil {
+		return models.Debarment{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newDebarment, nil
+}
+
+// GetDebarments Отстранения организации-заказчика или, если orgID пуст, отстранения на всей площадке.
+func (d *Database) GetDebarments(ctx context.Context, orgID uuid.NullUUID, activeOnly bool, offset, limit int32) ([]models.Debarment, error) {
+	const op = "storage.GetDebarments"
+
+	query := `SELECT ` + debarmentColumns + `
+				FROM debarment d
+				WHERE d.organization_id IS NOT DISTINCT FROM $1
+				AND (NOT $2 OR (` + debarmentActive + `))
+				ORDER BY d.created_at DESC
+				OFFSET $3
+				FETCH NEXT $4 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, orgID, activeOnly, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var debarments []models.Debarment
+	if err = pgxscan.ScanAll(&debarments, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return debarments, nil
+}
+
+// LiftDebarment Досрочно снимает отстранение, выданное организацией orgID (пустое значение - площадкой).
+func (d *Database) LiftDebarment(ctx context.Context, orgID uuid.NullUUID, debarmentID, username string) (models.Debarment, error) {
+	const op = "storage.LiftDebarment"
+
+	query := `UPDATE debarment d
+				SET lifted_by = $3, lifted_at = (NOW() AT TIME ZONE 'UTC')
+				WHERE d.id = $2 AND d.organization_id IS NOT DISTINCT FROM $1
+				AND d.lifted_at IS NULL AND d.expired_at IS NULL
+				RETURNING ` + debarmentColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, orgID, debarmentID, username)
+	if err != nil {
+		return models.Debarment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var debarment models.Debarment
+	if err = pgxscan.ScanOne(&debarment, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Debarment{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.DebarmentNotFound}
+		}
+		return models.Debarment{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return debarment, nil
+}
+
+// CheckBidderNotDebarred Отказывает автору, если он или его организация отстранены на площадке
+// или организацией тендера. В отказе указываются причина и срок.
+func (d *Database) CheckBidderNotDebarred(ctx context.Context, tenderID, authorID string) error {
+	const op = "storage.CheckBidderNotDebarred"
+
+	query := `SELECT d.reason, d.ends_at
+				FROM debarment d
+				JOIN tender t ON (t.id = $1)
+				JOIN employee e ON (e.id = $2)
+				LEFT JOIN organization_responsible r ON (r.user_id = e.id)
+				WHERE (d.organization_id IS NULL OR d.organization_id = t.organization_id)
+				AND (d.supplier_username = e.username OR d.supplier_organization_id = r.organization_id)
+				AND ` + debarmentActive + `
+				ORDER BY d.ends_at DESC
+				LIMIT 1;`
+
+	var reason string
+	var endsAt time.Time
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID, authorID).Scan(&reason, &endsAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return util.MyResponseError{
+		Status: http.StatusForbidden,
+		Msg:    fmt.Sprintf("%s до %s: %s", util.SupplierDebarred, endsAt.Format(time.RFC3339), reason),
+	}
+}
+
+// ExpireDebarments Отмечает отстранения, срок которых истёк. Строки блокируются с SKIP LOCKED,
+// чтобы несколько реплик не обрабатывали одно отстранение.
+func (d *Database) ExpireDebarments(ctx context.Context, now time.Time, limit int32) ([]models.Debarment, error) {
+	const op = "storage.ExpireDebarments"
+
+	query := `UPDATE debarment d
+				SET expired_at = $1
+				WHERE d.id IN (
+					SELECT id
+					FROM debarment
+					WHERE lifted_at IS NULL AND expired_at IS NULL AND ends_at <= $1
+					ORDER BY ends_at
+					LIMIT $2
+					FOR UPDATE SKIP LOCKED
+				)
+				RETURNING ` + debarmentColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var debarments []models.Debarment
+	if err = pgxscan.ScanAll(&debarments, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return debarments, nil
+}
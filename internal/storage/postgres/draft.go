@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// Ожидают псевдоним d для черновика.
+const (
+	bidDraftColumns    = `d.bid_id, d.name, d.description, d.price, d.technical_proposal, d.commercial_proposal, d.base_version, d.saved_by, d.saved_at`
+	tenderDraftColumns = `d.tender_id, d.name, d.description, d.service_type, d.base_version, d.saved_by, d.saved_at`
+)
+
+func (d *Database) scanBidDraft(ctx context.Context, op, query string, args ...any) (models.BidDraft, error) {
+	rows, err := d.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return models.BidDraft{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	op2 := op + "pgxscan"
+	var draft models.BidDraft
+	err = pgxscan.ScanOne(&draft, rows)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.BidDraft{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.DraftNotFound}
+		}
+		return models.BidDraft{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return draft, nil
+}
+
+func (d *Database) scanTenderDraft(ctx context.Context, op, query string, args ...any) (models.TenderDraft, error) {
+	rows, err := d.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return models.TenderDraft{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	op2 := op + "pgxscan"
+	var draft models.TenderDraft
+	err = pgxscan.ScanOne(&draft, rows)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.TenderDraft{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.DraftNotFound}
+		}
+		return models.TenderDraft{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return draft, nil
+}
+
+// SaveBidDraft Заменяет черновик целиком и запоминает текущую версию предложения. Версия предложения не меняется.
+func (d *Database) SaveBidDraft(ctx context.Context, draft *models.BidDraft) (models.BidDraft, error) {
+	query := `WITH d AS (
+					INSERT INTO bid_draft (bid_id, name, description, price, technical_proposal, commercial_proposal, base_version, saved_by)
+					SELECT b.id, $2, $3, $4, $5, $6, b.version, $7
+					FROM bid b
+					WHERE b.id = $1
+					ON CONFLICT (bid_id) DO UPDATE
+					SET name = EXCLUDED.name,
+						description = EXCLUDED.description,
+						price = EXCLUDED.price,
+						technical_proposal = EXCLUDED.technical_proposal,
+						commercial_proposal = EXCLUDED.commercial_proposal,
+						base_version = EXCLUDED.base_version,
+						saved_by = EXCLUDED.saved_by,
+						saved_at = CURRENT_TIMESTAMP
+					RETURNING *
+				)
+				SELECT ` + bidDraftColumns + `, d.base_version AS current_version
+				FROM d;`
+
+	return d.scanBidDraft(ctx, "storage.SaveBidDraft", query, draft.BidID, draft.Name, draft.Description,
+		draft.Price, draft.TechnicalProposal, draft.CommercialProposal, draft.SavedBy)
+}
+
+func (d *Database) GetBidDraft(ctx context.Context, bidID string) (models.BidDraft, error) {
+	query := `SELECT ` + bidDraftColumns + `, b.version AS current_version
+				FROM bid_draft d
+				JOIN bid b ON (b.id = d.bid_id)
+				WHERE d.bid_id = $1;`
+
+	return d.scanBidDraft(ctx, "storage.GetBidDraft", query, bidID)
+}
+
+func (d *Database) DeleteBidDraft(ctx context.Context, bidID string) (models.BidDraft, error) {
+	query := `WITH d AS (
+					DELETE FROM bid_draft
+					WHERE bid_id = $1
+					RETURNING *
+				)
+				SELECT ` + bidDraftColumns + `, b.version AS current_version
+				FROM d
+				JOIN bid b ON (b.id = d.bid_id);`
+
+	return d.scanBidDraft(ctx, "storage.DeleteBidDraft", query, bidID)
+}
+
+// SaveTenderDraft Заменяет черновик целиком и запоминает текущую версию тендера. Версия тендера не меняется.
+func (d *Database) SaveTenderDraft(ctx context.Context, draft *models.TenderDraft) (models.TenderDraft, error) {
+	query := `WITH d AS (
+					INSERT INTO tender_draft (tender_id, name, description, service_type, base_version, saved_by)
+					SELECT t.id, $2, $3, $4, t.version, $5
+					FROM tender t
+					WHERE t.id = $1
+					ON CONFLICT (tender_id) DO UPDATE
+					SET name = EXCLUDED.name,
+						description = EXCLUDED.description,
+						service_type = EXCLUDED.service_type,
+						base_version = EXCLUDED.base_version,
+						saved_by = EXCLUDED.saved_by,
+						saved_at = CURRENT_TIMESTAMP
+					RETURNING *
+				)
+				SELECT ` + tenderDraftColumns + `, d.base_version AS current_version
+				FROM d;`
+
+	return d.scanTenderDraft(ctx, "storage.SaveTenderDraft", query, draft.TenderID, draft.Name, draft.Description,
+		draft.ServiceType, draft.SavedBy)
+}
+
+func (d *Database) GetTenderDraft(ctx context.Context, tenderID string) (models.TenderDraft, error) {
+	query := `SELECT ` + tenderDraftColumns + `, t.version AS current_version
+				FROM tender_draft d
+				JOIN tender t ON (t.id = d.tender_id)
+				WHERE d.tender_id = $1;`
+
+	return d.scanTenderDraft(ctx, "storage.GetTenderDraft", query, tenderID)
+}
+
+func (d *Database) DeleteTenderDraft(ctx context.Context, tenderID string) (models.TenderDraft, error) {
+	query := `WITH d AS (
+					DELETE FROM tender_draft
+					WHERE tender_id = $1
+					RETURNING *
+				)
+				SELECT ` + tenderDraftColumns + `, t.version AS current_version
+				FROM d
+				JOIN tender t ON (t.id = d.tender_id);`
+
+	return d.scanTenderDraft(ctx, "storage.DeleteTenderDraft", query, tenderID)
+}
@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const certificateColumns = `id, organization_id, name, valid_until, created_by, verified_by, verified_at, created_at`
+
+// completedContracts Число исполненных организацией контрактов: одобренные предложения
+// её сотрудников по закрытым тендерам. Ожидает псевдоним org (organization).
+const completedContracts = `(SELECT COUNT(*)
+					FROM bid b
+					JOIN organization_responsible r ON (r.user_id = b.author_id)
+					JOIN tender t ON (t.id = b.tender_id)
+					WHERE r.organization_id = org.id AND b.decision = 'Approved' AND t.status = 'Closed')::INT`
+
+func (d *Database) SetEligibilityRules(ctx context.Context, rules *models.EligibilityRules) (models.EligibilityRules, error) {
+	const op = "storage.SetEligibilityRules"
+
+	query := `INSERT INTO tender_eligibility (tender_id, organization_types, min_completed_contracts, certificate, updated_by)
+				VALUES ($1, $2, $3, NULLIF($4, ''), $5)
+				ON CONFLICT (tender_id) DO UPDATE
+				SET organization_types = EXCLUDED.organization_types,
+					min_completed_contracts = EXCLUDED.min_completed_contracts,
+					certificate = EXCLUDED.certificate,
+					updated_by = EXCLUDED.updated_by,
+					updated_at = CURRENT_TIMESTAMP
+				RETURNING tender_id, organization_types, min_completed_contracts, certificate, updated_by, updated_at;`
+
+	organizationTypes := rules.OrganizationTypes
+	if organizationTypes == nil {
+		organizationTypes = []models.OrganizationType{}
+	}
+
+	var certificate string
+	if rules.Certificate != nil {
+		certificate = *rules.Certificate
+	}
+
+	rows, err := d.conn(ctx).Query(ctx, query, rules.TenderID, organizationTypes, rules.MinCompletedContracts, certificate, rules.UpdatedBy)
+	if err != nil {
+		return models.EligibilityRules{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newRules models.EligibilityRules
+	if err = pgxscan.ScanOne(&newRules, rows); err != nil {
+		return models.EligibilityRules{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newRules, nil
+}
+
+// GetEligibilityRules Если требования не заданы, возвращает пустой набор, которому соответствует любой поставщик.
+func (d *Database) GetEligibilityRules(ctx context.Context, tenderID string) (models.EligibilityRules, error) {
+	const op = "storage.GetEligibilityRules"
+
+	query := `SELECT tender_id, organization_types, min_completed_contracts, certificate, updated_by, updated_at
+				FROM tender_eligibility
+				WHERE tender_id = $1;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return models.EligibilityRules{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var rules models.EligibilityRules
+	if err = pgxscan.ScanOne(&rules, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.EligibilityRules{TenderID: uuid.MustParse(tenderID), OrganizationTypes: []models.OrganizationType{}}, nil
+		}
+		return models.EligibilityRules{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return rules, nil
+}
+
+// GetEmployeeOrganizationID Организация, за которую отвечает сотрудник. Пустое значение, если её нет.
+func (d *Database) GetEmployeeOrganizationID(ctx context.Context, userID string) (uuid.NullUUID, error) {
+	const op = "storage.GetEmployeeOrganizationID"
+
+	query := `SELECT organization_id
+				FROM organization_responsible
+				WHERE user_id = $1;`
+
+	var orgID uuid.NullUUID
+	err := d.conn(ctx).QueryRow(ctx, query, userID).Scan(&orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.NullUUID{}, nil
+		}
+		return uuid.NullUUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return orgID, nil
+}
+
+// GetSupplierProfile Учитываются только подтверждённые сертификаты, срок действия которых не истёк.
+func (d *Database) GetSupplierProfile(ctx context.Context, orgID uuid.UUID) (models.SupplierProfile, error) {
+	const op = "storage.GetSupplierProfile"
+
+	query := `SELECT org.id AS organization_id,
+					COALESCE(org.type::TEXT, '') AS type,
+					` + completedContracts + ` AS completed_contracts,
+					ARRAY(
+						SELECT c.name
+						FROM organization_certificate c
+						WHERE c.organization_id = org.id AND c.verified_at IS NOT NULL
+						AND (c.valid_until IS NULL OR c.valid_until >= CURRENT_DATE)
+					) AS certificates
+				FROM organization org
+				WHERE org.id = $1;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, orgID)
+	if err != nil {
+		return models.SupplierProfile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var profile models.SupplierProfile
+	if err = pgxscan.ScanOne(&profile, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SupplierProfile{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.OrganizationNotFound}
+		}
+		return models.SupplierProfile{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return profile, nil
+}
+
+func (d *Database) AddCertificate(ctx context.Context, certificate *models.Certificate) (models.Certificate, error) {
+	const op = "storage.AddCertificate"
+
+	query := `INSERT INTO organization_certificate (organization_id, name, valid_until, created_by)
+				VALUES ($1, $2, $3, $4)
+				RETURNING ` + certificateColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, certificate.OrganizationID, certificate.Name, certificate.ValidUntil, certificate.CreatedBy)
+	if err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newCertificate models.Certificate
+	if err = pgxscan.ScanOne(&newCertificate, rows); err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newCertificate, nil
+}
+
+func (d *Database) GetCertificates(ctx context.Context, orgID string) ([]models.Certificate, error) {
+	const op = "storage.GetCertificates"
+
+	query := `SELECT ` + certificateColumns + `
+				FROM organization_certificate
+				WHERE organization_id = $1
+				ORDER BY name, created_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var certificates []models.Certificate
+	if err = pgxscan.ScanAll(&certificates, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return certificates, nil
+}
+
+func (d *Database) VerifyCertificate(ctx context.Context, orgID, certificateID, username string) (models.Certificate, error) {
+	const op = "storage.VerifyCertificate"
+
+	query := `UPDATE organization_certificate
+				SET verified_by = $3, verified_at = CURRENT_TIMESTAMP
+				WHERE organization_id = $1 AND id = $2
+				RETURNING ` + certificateColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, orgID, certificateID, username)
+	if err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var certificate models.Certificate
+	if err = pgxscan.ScanOne(&certificate, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Certificate{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.CertificateNotFound}
+		}
+		return models.Certificate{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return certificate, nil
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+// commercialVisible Коммерческая часть предложения двухконвертного тендера видна Ответственным
+// только на этапе коммерческой оценки и после него, и только у допущенных предложений.
+// Ожидает псевдонимы t (tender) и q (bid_qualification_record).
+const commercialVisible = `(NOT t.two_envelope OR (t.status IN ('CommercialEvaluation', 'Closed') AND q.qualification = 'Qualified'))`
+
+func (d *Database) GetTenderStage(ctx context.Context, tenderID string) (models.TenderStage, error) {
+	const op = "storage.GetTenderStage"
+
+	query := `SELECT two_envelope, status
+				FROM tender
+				WHERE id = $1;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return models.TenderStage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var stage models.TenderStage
+	if err = pgxscan.ScanOne(&stage, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.TenderStage{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return models.TenderStage{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return stage, nil
+}
+
+// CheckTenderAcceptsBids Предложения подаются и меняются только пока тендер опубликован:
+// не до публикации, не на этапах оценки и не после закрытия.
+func (d *Database) CheckTenderAcceptsBids(ctx context.Context, tenderID string) error {
+	const op = "storage.CheckTenderAcceptsBids"
+
+	query := `SELECT status
+				FROM tender
+				WHERE id = $1;`
+
+	var status models.TenderStatus
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch status {
+	case models.Published:
+		return nil
+	case models.TechnicalEvaluation, models.CommercialEvaluation:
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.TenderInEvaluation}
+	case models.Closed:
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.TenderClosed}
+	default:
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.TenderNotPublished}
+	}
+}
+
+// CheckBidCommercialVisible Возвращает 409, если коммерческая часть предложения ещё скрыта от Ответственных.
+func (d *Database) CheckBidCommercialVisible(ctx context.Context, bidID string) error {
+	const op = "storage.CheckBidCommercialVisible"
+
+	query := `SELECT ` + commercialVisible + `
+				FROM bid b
+				JOIN tender t ON (b.tender_id = t.id)
+				LEFT JOIN bid_qualification_record q ON (q.bid_id = b.id)
+				WHERE b.id = $1;`
+
+	var visible bool
+	err := d.conn(ctx).QueryRow(ctx, query, bidID).Scan(&visible)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !visible {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.CommercialHidden}
+	}
+
+	return nil
+}
+
+func (d *Database) QualifyBid(ctx context.Context, record *models.QualificationRecord) (models.QualificationRecord, error) {
+	const op = "storage.QualifyBid"
+
+	query := `INSERT INTO bid_qualification_record (bid_id, qualification, reason, username)
+				VALUES ($1, $2, NULLIF($3, ''), $4)
+				ON CONFLICT (bid_id) DO UPDATE
+				SET qualification = EXCLUDED.qualification,
+					reason = EXCLUDED.reason,
+					username = EXCLUDED.username,
+					updated_at = CURRENT_TIMESTAMP
+				RETURNING bid_id, qualification, reason, username, updated_at;`
+
+	var reason string
+	if record.Reason != nil {
+		reason = *record.Reason
+	}
+
+	rows, err := d.conn(ctx).Query(ctx, query, record.BidID, record.Qualification, reason, record.Username)
+	if err != nil {
+		return models.QualificationRecord{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newRecord models.QualificationRecord
+	if err = pgxscan.ScanOne(&newRecord, rows); err != nil {
+		return models.QualificationRecord{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newRecord, nil
+}
+
+// CheckBidsQualified Коммерческая оценка начинается, когда по каждому опубликованному предложению есть решение.
+func (d *Database) CheckBidsQualified(ctx context.Context, tenderID string) error {
+	const op = "storage.CheckBidsQualified"
+
+	query := `SELECT EXISTS (
+					SELECT 1
+					FROM bid b
+					LEFT JOIN bid_qualification_record q ON (q.bid_id = b.id)
+					WHERE b.tender_id = $1 AND b.status = 'Published' AND q.bid_id IS NULL
+				);`
+
+	var pending bool
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&pending)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if pending {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.BidsNotQualified}
+	}
+
+	return nil
+}
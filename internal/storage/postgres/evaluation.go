@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const criterionColumns = `id, tender_id, name, weight::FLOAT8 AS weight, max_score, created_at`
+
+const bidScoreColumns = `id, bid_id, criterion_id, scorer_username, score, comment, created_at, updated_at`
+
+// ReplaceCriteria Заменяет весь набор критериев тендера.
+func (d *Database) ReplaceCriteria(ctx context.Context, tenderID string, criteria []models.Criterion) ([]models.Criterion, error) {
+	const op = "storage.ReplaceCriteria"
+
+	_, err := d.conn(ctx).Exec(ctx, `DELETE FROM evaluation_criterion WHERE tender_id = $1;`, tenderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	names := make([]string, len(criteria))
+	weights := make([]float64, len(criteria))
+	maxScores := make([]int32, len(criteria))
+	for i, c := range criteria {
+		names[i] = c.Name
+		weights[i] = c.Weight
+		maxScores[i] = int32(c.MaxScore)
+	}
+
+	query := `INSERT INTO evaluation_criterion (tender_id, name, weight, max_score)
+				SELECT $1, x.name, x.weight, x.max_score
+				FROM unnest($2::VARCHAR[], $3::NUMERIC[], $4::INT[]) AS x(name, weight, max_score)
+				RETURNING ` + criterionColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, names, weights, maxScores)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var newCriteria []models.Criterion
+	if err = pgxscan.ScanAll(&newCriteria, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newCriteria, nil
+}
+
+func (d *Database) GetCriteria(ctx context.Context, tenderID string) ([]models.Criterion, error) {
+	const op = "storage.GetCriteria"
+
+	query := `SELECT ` + criterionColumns + `
+				FROM evaluation_criterion
+				WHERE tender_id = $1
+				ORDER BY name;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var criteria []models.Criterion
+	if err = pgxscan.ScanAll(&criteria, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return criteria, nil
+}
+
+// CheckTenderNotScored Критерии нельзя менять после того, как по ним выставлена хотя бы одна оценка.
+func (d *Database) CheckTenderNotScored(ctx context.Context, tenderID string) error {
+	const op = "storage.CheckTenderNotScored"
+
+	query := `SELECT EXISTS (
+					SELECT 1
+					FROM bid_score s
+					JOIN evaluation_criterion c ON (s.criterion_id = c.id)
+					WHERE c.tender_id = $1
+				);`
+
+	var scored bool
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&scored)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if scored {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.CriteriaLocked}
+	}
+
+	return nil
+}
+
+// UpsertBidScores Сохраняет оценки Ответственного. Критерий должен относиться к тендеру предложения,
+// а оценка не превышать максимум критерия, иначе ни одна оценка не сохраняется.
+// Должен вызываться внутри WithTx.
+func (d *Database) UpsertBidScores(ctx context.Context, bidID, username string, scores []models.BidScore) ([]models.BidScore, error) {
+	const op = "storage.UpsertBidScores"
+
+	criterionIDs := make([]uuid.UUID, len(scores))
+	values := make([]int32, len(scores))
+	comments := make([]string, len(scores))
+	for i, s := range scores {
+		criterionIDs[i] = s.CriterionID
+		values[i] = int32(s.Score)
+		if s.Comment != nil {
+			comments[i] = *s.Comment
+		}
+	}
+
+	query := `INSERT INTO bid_score (bid_id, criterion_id, scorer_username, score, comment)
+				SELECT $1, x.criterion_id, $2, x.score, NULLIF(x.comment, '')
+				FROM unnest($3::UUID[], $4::INT[], $5::TEXT[]) AS x(criterion_id, score, comment)
+				JOIN evaluation_criterion c ON (c.id = x.criterion_id)
+				WHERE c.tender_id = (SELECT tender_id FROM bid WHERE id = $1)
+					AND x.score BETWEEN 0 AND c.max_score
+				ON CONFLICT (bid_id, criterion_id, scorer_username) DO UPDATE
+				SET score = EXCLUDED.score,
+					comment = EXCLUDED.comment,
+					updated_at = CURRENT_TIMESTAMP
+				RETURNING ` + bidScoreColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, bidID, username, criterionIDs, values, comments)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var newScores []models.BidScore
+	if err = pgxscan.ScanAll(&newScores, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	if len(newScores) != len(scores) {
+		return nil, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.InvalidScore}
+	}
+
+	return newScores, nil
+}
+
+func (d *Database) GetBidScores(ctx context.Context, bidID string) ([]models.BidScore, error) {
+	const op = "storage.GetBidScores"
+
+	query := `SELECT ` + bidScoreColumns + `
+				FROM bid_score
+				WHERE bid_id = $1
+				ORDER BY criterion_id, scorer_username;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, bidID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var scores []models.BidScore
+	if err = pgxscan.ScanAll(&scores, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return scores, nil
+}
+
+// GetTenderRanking Ранжирует опубликованные предложения тендера по взвешенной оценке.
+func (d *Database) GetTenderRanking(ctx context.Context, tenderID string, offset, limit int32) ([]models.BidRanking, error) {
+	const op = "storage.GetTenderRanking"
+
+	query := `SELECT RANK() OVER (ORDER BY r.weighted_total DESC) AS rank, r.*
+				FROM (
+					SELECT b.id AS bid_id, b.name AS bid_name, b.decision,
+						COALESCE(bid_weighted_total(b.id), 0)::FLOAT8 AS weighted_total,
+						(SELECT COUNT(DISTINCT scorer_username) FROM bid_score WHERE bid_id = b.id)::INT AS scorers
+					FROM bid b
+					WHERE b.tender_id = $1 AND b.status = 'Published'
+				) r
+				ORDER BY rank, r.bid_name
+				OFFSET $2
+				FETCH NEXT $3 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var ranking []models.BidRanking
+	if err = pgxscan.ScanAll(&ranking, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return ranking, nil
+}
+
+// CreateDecisionRecord Сохраняет решение вместе со снимком оценок предложения.
+func (d *Database) CreateDecisionRecord(ctx context.Context, bidID, decision, username string) (models.DecisionRecord, error) {
+	const op = "storage.CreateDecisionRecord"
+
+	query := `INSERT INTO bid_decision_record (bid_id, decision, username, scores, weighted_total)
+				SELECT $1, $2, $3,
+					COALESCE((
+						SELECT jsonb_agg(jsonb_build_object(
+							'criterionId', c.id,
+							'criterion', c.name,
+							'weight', c.weight,
+							'maxScore', c.max_score,
+							'scorerUsername', s.scorer_username,
+							'score', s.score,
+							'comment', s.comment
+						) ORDER BY c.name, s.scorer_username)
+						FROM bid_score s
+						JOIN evaluation_criterion c ON (s.criterion_id = c.id)
+						WHERE s.bid_id = $1
+					), '[]'::JSONB),
+					bid_weighted_total($1)
+				RETURNING id, bid_id, decision, username, scores, weighted_total::FLOAT8 AS weighted_total, created_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, bidID, decision, username)
+	if err != nil {
+		return models.DecisionRecord{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var record models.DecisionRecord
+	if err = pgxscan.ScanOne(&record, rows); err != nil {
+		return models.DecisionRecord{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return record, nil
+}
+
+func (d *Database) GetDecisionRecords(ctx context.Context, bidID string, offset, limit int32) ([]models.DecisionRecord, error) {
+	const op = "storage.GetDecisionRecords"
+
+	query := `SELECT id, bid_id, decision, username, scores, weighted_total::FLOAT8 AS weighted_total, created_at
+				FROM bid_decision_record
+				WHERE bid_id = $1
+				ORDER BY created_at DESC
+				OFFSET $2
+				FETCH NEXT $3 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, bidID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var records []models.DecisionRecord
+	if err = pgxscan.ScanAll(&records, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return records, nil
+}
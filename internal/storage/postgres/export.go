@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"zadanie-6105/internal/models"
+)
+
+// streamRows Читает результат запроса курсором pgx по одной строке и передаёт её в fn,
+// не загружая весь результат в память. Ошибка fn прерывает чтение.
+func streamRows[T any](ctx context.Context, d *Database, op, query string, fn func(*T) error, args ...any) error {
+	rows, err := d.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	op2 := op + "pgxscan"
+	scanner := pgxscan.NewRowScanner(rows)
+	for rows.Next() {
+		var item T
+		if err = scanner.Scan(&item); err != nil {
+			return fmt.Errorf("%s: %w", op2, err)
+		}
+		if err = fn(&item); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (d *Database) ExportUserTenders(ctx context.Context, username string, fn func(*models.Tender) error) error {
+	return streamRows(ctx, d, "storage.ExportUserTenders", userTendersQuery, fn, username)
+}
+
+func (d *Database) ExportBidsForTender(ctx context.Context, tenderID string, fn func(*models.Bid) error) error {
+	return streamRows(ctx, d, "storage.ExportBidsForTender", bidsForTenderQuery, fn, tenderID, "")
+}
+
+func (d *Database) ExportBidReviews(ctx context.Context, tenderID, authorUsername string, fn func(*models.Review) error) error {
+	return streamRows(ctx, d, "storage.ExportBidReviews", bidReviewsQuery, fn, tenderID, authorUsername)
+}
+
+func (d *Database) ExportReviewsForBid(ctx context.Context, bidID string, fn func(*models.Review) error) error {
+	return streamRows(ctx, d, "storage.ExportReviewsForBid", reviewsForBidQuery, fn, bidID)
+}
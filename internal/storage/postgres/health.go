@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"zadanie-6105/internal/models"
+)
+
+func (d *Database) Ping(ctx context.Context) error {
+	const op = "storage.Ping"
+
+	if err := d.Pool.Ping(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (d *Database) GetPoolStats() models.PoolStats {
+	stat := d.Pool.Stat()
+
+	return models.PoolStats{
+		TotalConns:           stat.TotalConns(),
+		AcquiredConns:        stat.AcquiredConns(),
+		IdleConns:            stat.IdleConns(),
+		MaxConns:             stat.MaxConns(),
+		AcquireCount:         stat.AcquireCount(),
+		EmptyAcquireCount:    stat.EmptyAcquireCount(),
+		CanceledAcquireCount: stat.CanceledAcquireCount(),
+		AcquireDuration:      stat.AcquireDuration().String(),
+	}
+}
@@ -2,8 +2,11 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
 	"go.uber.org/zap"
 	"zadanie-6105/internal/models/config"
 	"zadanie-6105/internal/storage"
@@ -13,6 +16,10 @@ import (
 type Database struct {
 	Pool      *pgxpool.Pool
 	zapLogger *zap.SugaredLogger
+
+	// sqlDB Обёртка database/sql над пулом для goose. Создаётся один раз вместе с провайдером миграций.
+	sqlDB      *sql.DB
+	migrations *goose.Provider
 }
 
 func NewPostgresRepository(ctx context.Context, cfg *config.DBConfig, zap *zap.SugaredLogger) storage.Storage {
@@ -37,8 +44,22 @@ func NewPostgresRepository(ctx context.Context, cfg *config.DBConfig, zap *zap.S
 	}
 	zap.Infoln("Connected to db")
 
-	return &Database{
+	d := &Database{
 		Pool:      pool,
 		zapLogger: zap,
+		sqlDB:     stdlib.OpenDBFromPool(pool),
+	}
+	if err = d.initMigrations(); err != nil {
+		zap.Fatalln(err, "migrations init error")
 	}
-}
\ No newline at end of file
+
+	return d
+}
+
+// Close Закрывает обёртку database/sql и пул. Соединения обёртки принадлежат пулу.
+func (d *Database) Close() {
+	if err := d.sqlDB.Close(); err != nil {
+		d.zapLogger.Errorf("storage.Close: %v", err)
+	}
+	d.Pool.Close()
+}
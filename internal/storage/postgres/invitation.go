@@ -0,0 +1,253 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const invitationColumns = `tender_id, organization_id, status, invited_by, responded_by, created_at, responded_at`
+
+// tenderVisibleTo Закрытый тендер виден только организациям, которые приглашены и не отказались.
+// Ожидает псевдоним t (tender); param - номер параметра с именем пользователя.
+func tenderVisibleTo(param string) string {
+	return `(NOT t.private OR EXISTS (
+					SELECT 1
+					FROM tender_invitation i
+					JOIN organization_responsible o ON (o.organization_id = i.organization_id)
+					JOIN employee e ON (o.user_id = e.id)
+					WHERE i.tender_id = t.id AND i.status <> 'Declined' AND e.username = ` + param + `
+				))`
+}
+
+func (d *Database) CheckOrganizationExists(ctx context.Context, orgID string) error {
+	const op = "storage.CheckOrganizationExists"
+
+	query := `SELECT 1
+				FROM organization
+				WHERE id = $1;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, orgID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.OrganizationNotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CheckTenderPrivate Приглашениями управляют только в закрытом тендере.
+func (d *Database) CheckTenderPrivate(ctx context.Context, tenderID string) error {
+	const op = "storage.CheckTenderPrivate"
+
+	var private bool
+	err := d.conn(ctx).QueryRow(ctx, `SELECT private FROM tender WHERE id = $1;`, tenderID).Scan(&private)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !private {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.TenderNotPrivate}
+	}
+
+	return nil
+}
+
+// InviteOrganization Повторное приглашение отказавшейся организации возвращает его в Pending.
+func (d *Database) InviteOrganization(ctx context.Context, tenderID, orgID, username string) (models.Invitation, error) {
+	const op = "storage.InviteOrganization"
+
+	query := `INSERT INTO tender_invitation (tender_id, organization_id, invited_by)
+				VALUES ($1, $2, $3)
+				ON CONFLICT (tender_id, organization_id) DO UPDATE
+				SET status = 'Pending',
+					invited_by = EXCLUDED.invited_by,
+					responded_by = NULL,
+					responded_at = NULL
+				RETURNING ` + invitationColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, orgID, username)
+	if err != nil {
+		return models.Invitation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var invitation models.Invitation
+	if err = pgxscan.ScanOne(&invitation, rows); err != nil {
+		return models.Invitation{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return invitation, nil
+}
+
+func (d *Database) RevokeInvitation(ctx context.Context, tenderID, orgID string) (models.Invitation, error) {
+	const op = "storage.RevokeInvitation"
+
+	query := `DELETE FROM tender_invitation
+				WHERE tender_id = $1 AND organization_id = $2
+				RETURNING ` + invitationColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, orgID)
+	if err != nil {
+		return models.Invitation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var invitation models.Invitation
+	if err = pgxscan.ScanOne(&invitation, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Invitation{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.InvitationNotFound}
+		}
+		return models.Invitation{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return invitation, nil
+}
+
+func (d *Database) GetInvitations(ctx context.Context, tenderID string, offset, limit int32) ([]models.Invitation, error) {
+	const op = "storage.GetInvitations"
+
+	query := `SELECT ` + invitationColumns + `
+				FROM tender_invitation
+				WHERE tender_id = $1
+				ORDER BY created_at
+				OFFSET $2
+				FETCH NEXT $3 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var invitations []models.Invitation
+	if err = pgxscan.ScanAll(&invitations, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return invitations, nil
+}
+
+// RespondInvitation Отвечает на приглашение от имени организации пользователя. Ответить можно один раз:
+// на уже принятое или отклонённое приглашение возвращается 409.
+func (d *Database) RespondInvitation(ctx context.Context, tenderID, username string, status models.InvitationStatus) (models.Invitation, error) {
+	const op = "storage.RespondInvitation"
+
+	query := `UPDATE tender_invitation i
+				SET status = $3,
+					responded_by = e.username,
+					responded_at = CURRENT_TIMESTAMP
+				FROM organization_responsible o
+				JOIN employee e ON (o.user_id = e.id)
+				WHERE i.organization_id = o.organization_id AND i.tender_id = $1 AND e.username = $2 AND i.status = 'Pending'
+				RETURNING i.tender_id, i.organization_id, i.status, i.invited_by, i.responded_by, i.created_at, i.responded_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, username, status)
+	if err != nil {
+		return models.Invitation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var invitation models.Invitation
+	if err = pgxscan.ScanOne(&invitation, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Invitation{}, d.invitationNotPending(ctx, tenderID, username)
+		}
+		return models.Invitation{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return invitation, nil
+}
+
+// invitationNotPending Различает отсутствие приглашения и уже данный на него ответ.
+func (d *Database) invitationNotPending(ctx context.Context, tenderID, username string) error {
+	const op = "storage.invitationNotPending"
+
+	query := `SELECT 1
+				FROM tender_invitation i
+				JOIN organization_responsible o ON (i.organization_id = o.organization_id)
+				JOIN employee e ON (o.user_id = e.id)
+				WHERE i.tender_id = $1 AND e.username = $2;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID, username).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.InvitationNotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return util.MyResponseError{Status: http.StatusConflict, Msg: util.InvitationAnswered}
+}
+
+// GetInvitedTenders Опубликованные закрытые тендеры, в которые приглашена организация пользователя.
+func (d *Database) GetInvitedTenders(ctx context.Context, username string, offset, limit int32) ([]models.InvitedTender, error) {
+	const op = "storage.GetInvitedTenders"
+
+	query := `SELECT t.id, t.name, t.description, t.service_type, t.status, t.version, t.organization_id, t.creator_username,
+					t.sealed, t.opening_at, t.two_envelope, t.private, t.created_at, i.status AS invitation_status
+				FROM tender_invitation i
+				JOIN tender t ON (t.id = i.tender_id)
+				JOIN organization_responsible o ON (o.organization_id = i.organization_id)
+				JOIN employee e ON (o.user_id = e.id)
+				WHERE e.username = $1 AND t.status = $2
+				ORDER BY t.name
+				OFFSET $3
+				FETCH NEXT $4 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, username, models.Published, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var tenders []models.InvitedTender
+	if err = pgxscan.ScanAll(&tenders, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return tenders, nil
+}
+
+// CheckBidderInvited В закрытый тендер подают предложения только приглашённые организации, не отказавшиеся от участия.
+func (d *Database) CheckBidderInvited(ctx context.Context, tenderID, authorID string) error {
+	const op = "storage.CheckBidderInvited"
+
+	query := `SELECT NOT t.private OR EXISTS (
+					SELECT 1
+					FROM tender_invitation i
+					JOIN organization_responsible o ON (o.organization_id = i.organization_id)
+					WHERE i.tender_id = t.id AND i.status <> 'Declined' AND o.user_id = $2
+				)
+				FROM tender t
+				WHERE t.id = $1;`
+
+	var invited bool
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID, authorID).Scan(&invited)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !invited {
+		return util.MyResponseError{Status: http.StatusForbidden, Msg: util.NotInvited}
+	}
+
+	return nil
+}
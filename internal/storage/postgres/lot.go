@@ -0,0 +1,268 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const lotColumns = `id, tender_id, name, service_type, quantity, budget, status, winning_bid_id, created_at, updated_at`
+
+func (d *Database) CreateLot(ctx context.Context, lot *models.Lot) (models.Lot, error) {
+	const op = "storage.CreateLot"
+
+	query := `INSERT INTO lot (tender_id, name, service_type, quantity, budget)
+				VALUES ($1, $2, $3, $4, $5)
+				RETURNING ` + lotColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, lot.TenderID, lot.Name, lot.ServiceType, lot.Quantity, lot.Budget)
+	if err != nil {
+		return models.Lot{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newLot models.Lot
+	if err = pgxscan.ScanOne(&newLot, rows); err != nil {
+		return models.Lot{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newLot, nil
+}
+
+// EditLot Меняет параметры открытого лота. Пустые значения не меняются.
+func (d *Database) EditLot(ctx context.Context, lot *models.Lot, tenderID, lotID string) (models.Lot, error) {
+	const op = "storage.EditLot"
+
+	query := `UPDATE lot
+				SET
+					name = COALESCE(NULLIF($1, ''), name),
+					service_type = CASE
+						WHEN $2 = '' THEN service_type
+						ELSE $2::service_type
+					END,
+					quantity = COALESCE(NULLIF($3, 0), quantity),
+					budget = COALESCE(NULLIF($4::NUMERIC, 0), budget)
+				WHERE id = $5 AND tender_id = $6 AND status = 'Open'
+				RETURNING ` + lotColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, lot.Name, lot.ServiceType, lot.Quantity, lot.Budget, lotID, tenderID)
+	if err != nil {
+		return models.Lot{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newLot models.Lot
+	if err = pgxscan.ScanOne(&newLot, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Lot{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.LotNotOpen}
+		}
+		return models.Lot{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newLot, nil
+}
+
+// GetLots Возвращает лоты тендера в текущей или указанной версии тендера.
+func (d *Database) GetLots(ctx context.Context, tenderID string, version int32) ([]models.Lot, error) {
+	const op = "storage.GetLots"
+
+	query := `SELECT ` + lotColumns + `
+				FROM lot
+				WHERE tender_id = $1
+				ORDER BY created_at;`
+	args := []any{tenderID}
+
+	if version > 0 {
+		query = `SELECT ` + lotColumns + `
+				FROM (
+					SELECT DISTINCT ON (lot_id) lot_id AS id, tender_id, name, service_type, quantity, budget, status, winning_bid_id, created_at, updated_at
+					FROM lot_history
+					WHERE tender_id = $1 AND tender_version <= $2
+					ORDER BY lot_id, tender_version DESC, updated_at DESC
+				) h
+				ORDER BY created_at;`
+		args = append(args, version)
+	}
+
+	rows, err := d.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var lots []models.Lot
+	if err = pgxscan.ScanAll(&lots, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return lots, nil
+}
+
+func (d *Database) CheckLotExists(ctx context.Context, tenderID, lotID string) error {
+	const op = "storage.CheckLotExists"
+
+	query := `SELECT 1
+				FROM lot
+				WHERE id = $1 AND tender_id = $2;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, lotID, tenderID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.LotNotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ResolveLot Переводит открытый лот в итоговый статус.
+func (d *Database) ResolveLot(ctx context.Context, lotID string, status models.LotStatus, winningBidID uuid.NullUUID) (models.Lot, error) {
+	const op = "storage.ResolveLot"
+
+	query := `UPDATE lot
+				SET status = $1, winning_bid_id = $2
+				WHERE id = $3 AND status = 'Open'
+				RETURNING ` + lotColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, status, winningBidID, lotID)
+	if err != nil {
+		return models.Lot{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var lot models.Lot
+	if err = pgxscan.ScanOne(&lot, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Lot{}, util.MyResponseError{Status: http.StatusBadRequest, Msg: util.LotNotOpen}
+		}
+		return models.Lot{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return lot, nil
+}
+
+// CheckLotsOpen Проверяет, что все лоты относятся к тендеру и ещё не решены.
+func (d *Database) CheckLotsOpen(ctx context.Context, tenderID string, lotIDs []uuid.UUID) error {
+	const op = "storage.CheckLotsOpen"
+
+	query := `SELECT COUNT(*)
+				FROM lot
+				WHERE tender_id = $1 AND id = ANY($2) AND status = 'Open';`
+
+	var count int
+	if err := d.conn(ctx).QueryRow(ctx, query, tenderID, lotIDs).Scan(&count); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if count != len(lotIDs) {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.LotNotOpen}
+	}
+
+	return nil
+}
+
+func (d *Database) AddBidLots(ctx context.Context, bidID uuid.UUID, lotIDs []uuid.UUID) error {
+	const op = "storage.AddBidLots"
+
+	query := `INSERT INTO bid_lot (bid_id, lot_id)
+				SELECT $1, unnest($2::UUID[])
+				ON CONFLICT DO NOTHING;`
+
+	if _, err := d.conn(ctx).Exec(ctx, query, bidID, lotIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (d *Database) CheckBidInLot(ctx context.Context, bidID, lotID string) error {
+	const op = "storage.CheckBidInLot"
+
+	query := `SELECT 1
+				FROM bid_lot
+				WHERE bid_id = $1 AND lot_id = $2;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, bidID, lotID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.BidNotInLot}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CheckTenderLotsResolved Тендер без лотов считается решённым.
+func (d *Database) CheckTenderLotsResolved(ctx context.Context, tenderID string) error {
+	const op = "storage.CheckTenderLotsResolved"
+
+	query := `SELECT EXISTS (SELECT 1 FROM lot WHERE tender_id = $1 AND status = 'Open');`
+
+	var hasOpen bool
+	if err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&hasOpen); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if hasOpen {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.LotsNotResolved}
+	}
+
+	return nil
+}
+
+// CheckLotDecisionsKept Откат тендера не восстанавливает лоты, поэтому к версии, после которой
+// какой-либо лот был решён, откатиться нельзя.
+func (d *Database) CheckLotDecisionsKept(ctx context.Context, tenderID string, version int32) error {
+	const op = "storage.CheckLotDecisionsKept"
+
+	query := `SELECT EXISTS (
+					SELECT 1
+					FROM lot l
+					LEFT JOIN (
+						SELECT DISTINCT ON (lot_id) lot_id, status, winning_bid_id
+						FROM lot_history
+						WHERE tender_id = $1 AND tender_version <= $2
+						ORDER BY lot_id, tender_version DESC, updated_at DESC
+					) h ON (h.lot_id = l.id)
+					WHERE l.tender_id = $1 AND l.status <> 'Open'
+						AND (h.status IS DISTINCT FROM l.status OR h.winning_bid_id IS DISTINCT FROM l.winning_bid_id)
+				);`
+
+	var decidedLater bool
+	if err := d.conn(ctx).QueryRow(ctx, query, tenderID, version).Scan(&decidedLater); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if decidedLater {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.LotsDecidedLater}
+	}
+
+	return nil
+}
+
+// CheckTenderNotClosed Лоты закрытого тендера менять нельзя.
+func (d *Database) CheckTenderNotClosed(ctx context.Context, tenderID string) error {
+	const op = "storage.CheckTenderNotClosed"
+
+	query := `SELECT status = 'Closed'
+				FROM tender
+				WHERE id = $1;`
+
+	var closed bool
+	if err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&closed); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if closed {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.TenderClosed}
+	}
+
+	return nil
+}
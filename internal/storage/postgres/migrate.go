@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+	"net/http"
+	"path/filepath"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+	"zadanie-6105/migrations"
+)
+
+func (d *Database) initMigrations() error {
+	const op = "storage.initMigrations"
+
+	var err error
+	d.migrations, err = goose.NewProvider(goose.DialectPostgres, d.sqlDB, migrations.FS)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// withMigrationLock Схему меняют под advisory lock, чтобы реплики, запущенные одновременно,
+// не применяли миграции наперегонки. Блокировка держится на отдельном соединении всё время fn,
+// так что проверки версии и сами миграции видят одно и то же состояние схемы.
+func (d *Database) withMigrationLock(ctx context.Context, fn func() error) error {
+	const op = "storage.withMigrationLock"
+
+	conn, err := d.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer conn.Release()
+
+	if _, err = conn.Exec(ctx, `SELECT pg_advisory_lock($1);`, lock.DefaultLockID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if _, err := conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1);`, lock.DefaultLockID); err != nil {
+			d.zapLogger.Errorf("%s: %v", op, err)
+		}
+	}()
+
+	return fn()
+}
+
+func migrationResult(result *goose.MigrationResult) models.Migration {
+	return models.Migration{
+		Version: result.Source.Version,
+		Name:    filepath.Base(result.Source.Path),
+		Applied: result.Direction == "up",
+	}
+}
+
+// MigrateUp Применяет все ещё не применённые миграции и возвращает их по порядку. Если схема в базе
+// новее встроенных миграций, то есть её уже обновила более новая версия приложения, ничего не применяет.
+func (d *Database) MigrateUp(ctx context.Context) ([]models.Migration, error) {
+	const op = "storage.MigrateUp"
+
+	var results []*goose.MigrationResult
+	err := d.withMigrationLock(ctx, func() error {
+		current, latest, err := d.migrations.GetVersions(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if current > latest {
+			return util.MyResponseError{Status: http.StatusConflict, Msg: util.SchemaTooNew}
+		}
+
+		results, err = d.migrations.Up(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make([]models.Migration, len(results))
+	for i, result := range results {
+		applied[i] = migrationResult(result)
+	}
+
+	return applied, nil
+}
+
+// MigrateDown Откатывает последнюю применённую миграцию.
+func (d *Database) MigrateDown(ctx context.Context) (models.Migration, error) {
+	const op = "storage.MigrateDown"
+
+	var result *goose.MigrationResult
+	err := d.withMigrationLock(ctx, func() error {
+		var err error
+		result, err = d.migrations.Down(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.Migration{}, err
+	}
+
+	return migrationResult(result), nil
+}
+
+func (d *Database) GetMigrations(ctx context.Context) ([]models.Migration, error) {
+	const op = "storage.GetMigrations"
+
+	statuses, err := d.migrations.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	list := make([]models.Migration, len(statuses))
+	for i, status := range statuses {
+		list[i] = models.Migration{
+			Version: status.Source.Version,
+			Name:    filepath.Base(status.Source.Path),
+			Applied: status.State == goose.StateApplied,
+		}
+		if list[i].Applied {
+			appliedAt := status.AppliedAt
+			list[i].AppliedAt = &appliedAt
+		}
+	}
+
+	return list, nil
+}
+
+// GetSchemaVersion Версия схемы в базе и последняя из встроенных миграций. Блокировку не берёт.
+func (d *Database) GetSchemaVersion(ctx context.Context) (models.SchemaVersion, error) {
+	const op = "storage.GetSchemaVersion"
+
+	current, latest, err := d.migrations.GetVersions(ctx)
+	if err != nil {
+		return models.SchemaVersion{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return models.SchemaVersion{Current: current, Latest: latest}, nil
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const negotiationRoundColumns = `id, bid_id, round, party, proposed_by, price, terms, status, bid_version,
+					resulting_version, responded_by, responded_at, created_at`
+
+// CheckBidNegotiable Переговоры возможны, пока по предложению нет решения, тендер не закрыт
+// и по нему не назначен или не идёт аукцион.
+func (d *Database) CheckBidNegotiable(ctx context.Context, bidID string) error {
+	const op = "storage.CheckBidNegotiable"
+
+	query := `SELECT b.decision = '' AND t.status <> 'Closed'
+					AND NOT EXISTS (SELECT 1 FROM auction a WHERE a.tender_id = t.id AND a.closed_at IS NULL)
+				FROM bid b
+				JOIN tender t ON (t.id = b.tender_id)
+				WHERE b.id = $1;`
+
+	var negotiable bool
+	err := d.conn(ctx).QueryRow(ctx, query, bidID).Scan(&negotiable)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !negotiable {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.NegotiationClosed}
+	}
+
+	return nil
+}
+
+// GetNegotiationRounds Раунды переговоров по предложению по порядку.
+func (d *Database) GetNegotiationRounds(ctx context.Context, bidID string) ([]models.NegotiationRound, error) {
+	const op = "storage.GetNegotiationRounds"
+
+	query := `SELECT ` + negotiationRoundColumns + `
+				FROM negotiation_round
+				WHERE bid_id = $1
+				ORDER BY round;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, bidID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	rounds := []models.NegotiationRound{}
+	if err = pgxscan.ScanAll(&rounds, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return rounds, nil
+}
+
+// CreateNegotiationRound Номер раунда и версия предложения, к которой относятся условия, проставляются из базы.
+// Второй открытый раунд по предложению не создаётся.
+func (d *Database) CreateNegotiationRound(ctx context.Context, round *models.NegotiationRound) (models.NegotiationRound, error) {
+	const op = "storage.CreateNegotiationRound"
+
+	query := `INSERT INTO negotiation_round (bid_id, round, party, proposed_by, price, terms, bid_version)
+				SELECT b.id, (SELECT COALESCE(MAX(n.round), 0) + 1 FROM negotiation_round n WHERE n.bid_id = b.id),
+					$2, $3, $4, $5, b.version
+				FROM bid b
+				WHERE b.id = $1
+				RETURNING ` + negotiationRoundColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, round.BidID, round.Party, round.ProposedBy, round.Price, round.Terms)
+	if err != nil {
+		return models.NegotiationRound{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var newRound models.NegotiationRound
+	if err = pgxscan.ScanOne(&newRound, rows); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.NegotiationRound{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.NegotiationRoundOpen}
+		}
+		return models.NegotiationRound{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return newRound, nil
+}
+
+// CloseNegotiationRound Завершает открытый раунд ответом другой стороны.
+func (d *Database) CloseNegotiationRound(ctx context.Context, roundID string, status models.NegotiationStatus, resultingVersion *int, username string) (models.NegotiationRound, error) {
+	const op = "storage.CloseNegotiationRound"
+
+	query := `UPDATE negotiation_round
+				SET status = $2, resulting_version = $3, responded_by = $4, responded_at = CURRENT_TIMESTAMP
+				WHERE id = $1 AND status = 'Open'
+				RETURNING ` + negotiationRoundColumns + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, roundID, status, resultingVersion, username)
+	if err != nil {
+		return models.NegotiationRound{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var round models.NegotiationRound
+	if err = pgxscan.ScanOne(&round, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.NegotiationRound{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.NoOpenRound}
+		}
+		return models.NegotiationRound{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return round, nil
+}
+
+// ApplyNegotiatedTerms Принятые условия сохраняются новой версией предложения.
+func (d *Database) ApplyNegotiatedTerms(ctx context.Context, bidID string, price *float64) (models.Bid, error) {
+	const op = "storage.ApplyNegotiatedTerms"
+
+	query := `UPDATE bid
+				SET price = COALESCE($2, price)
+				WHERE id = $1
+				RETURNING id, name, description, tender_id, status, decision, author_type, author_id, version, price, technical_proposal, commercial_proposal, sealed_payload IS NOT NULL AS sealed, created_at, updated_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, bidID, price)
+	if err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var bid models.Bid
+	if err = pgxscan.ScanOne(&bid, rows); err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return bid, nil
+}
+
+// GetBidHistory Версии предложения от первой к последней вместе с раундом переговоров, который создал версию.
+func (d *Database) GetBidHistory(ctx context.Context, bidID string) ([]models.BidVersion, error) {
+	const op = "storage.GetBidHistory"
+
+	query := `SELECT h.bid_id AS id, h.name, h.description, h.tender_id, h.status, h.decision, h.author_type, h.author_id,
+					h.version, h.price, h.technical_proposal, h.commercial_proposal, h.created_at, h.updated_at,
+					n.round AS negotiation_round
+				FROM bid_history h
+				LEFT JOIN negotiation_round n ON (n.bid_id = h.bid_id AND n.resulting_version = h.version)
+				WHERE h.bid_id = $1
+				ORDER BY h.version;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, bidID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	versions := []models.BidVersion{}
+	if err = pgxscan.ScanAll(&versions, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"zadanie-6105/internal/models"
+)
+
+func (d *Database) AppendEvent(ctx context.Context, event *models.Event) error {
+	const op = "storage.AppendEvent"
+
+	query := `INSERT INTO outbox (type, aggregate_type, aggregate_id, actor, reason, payload)
+				VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6);`
+
+	_, err := d.conn(ctx).Exec(ctx, query, event.Type, event.AggregateType, event.AggregateID, event.Actor, event.Reason, event.Payload)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetUnpublishedEvents Блокирует неопубликованные события, чтобы реплики не отправили их дважды.
+// Должен вызываться внутри WithTx.
+func (d *Database) GetUnpublishedEvents(ctx context.Context, limit int32) ([]models.Event, error) {
+	const op = "storage.GetUnpublishedEvents"
+
+	query := `SELECT id, type, aggregate_type, aggregate_id, actor, COALESCE(reason, '') AS reason, payload, created_at, published_at
+				FROM outbox
+				WHERE published_at IS NULL
+				ORDER BY created_at
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var events []models.Event
+	if err = pgxscan.ScanAll(&events, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return events, nil
+}
+
+func (d *Database) MarkEventsPublished(ctx context.Context, ids []uuid.UUID) error {
+	const op = "storage.MarkEventsPublished"
+
+	query := `UPDATE outbox
+				SET published_at = CURRENT_TIMESTAMP
+				WHERE id = ANY($1);`
+
+	_, err := d.conn(ctx).Exec(ctx, query, ids)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
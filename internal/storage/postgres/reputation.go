@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"zadanie-6105/internal/models"
+)
+
+// reputationColumns Агрегаты по предложениям b и отзывам r на них. Отзывы размножают строки
+// предложений, поэтому предложения считаются через DISTINCT. Скрытые модератором отзывы не учитываются.
+const reputationColumns = `COUNT(r.id)::INT AS review_count,
+					AVG(r.rating)::FLOAT8 AS average_rating,
+					COUNT(DISTINCT b.id) FILTER (WHERE b.decision <> '')::INT AS decided_bids,
+					COUNT(DISTINCT b.id) FILTER (WHERE b.decision = 'Approved')::INT AS won_bids,
+					COUNT(r.id) FILTER (WHERE r.completed IS NOT NULL)::INT AS completion_reported,
+					COUNT(r.id) FILTER (WHERE r.completed)::INT AS completed_count`
+
+func (d *Database) getReputation(ctx context.Context, op, where string, arg any) (models.Reputation, error) {
+	query := `SELECT ` + reputationColumns + `
+				FROM bid b
+				LEFT JOIN review r ON (r.bid_id = b.id AND r.hidden_at IS NULL)
+				WHERE ` + where + `;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, arg)
+	if err != nil {
+		return models.Reputation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	op2 := op + "pgxscan"
+	var reputation models.Reputation
+	if err = pgxscan.ScanOne(&reputation, rows); err != nil {
+		return models.Reputation{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	reputation.ComputeRates()
+	return reputation, nil
+}
+
+func (d *Database) GetAuthorReputation(ctx context.Context, authorUsername string) (models.Reputation, error) {
+	return d.getReputation(ctx, "storage.GetAuthorReputation", `b.author_username = $1`, authorUsername)
+}
+
+// GetOrganizationReputation Репутация организации складывается из предложений её Ответственных.
+func (d *Database) GetOrganizationReputation(ctx context.Context, orgID string) (models.Reputation, error) {
+	return d.getReputation(ctx, "storage.GetOrganizationReputation",
+		`b.author_id IN (SELECT user_id FROM organization_responsible WHERE organization_id = $1)`, orgID)
+}
+
+// GetAuthorReputations Репутация сразу нескольких авторов для списка предложений.
+func (d *Database) GetAuthorReputations(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]models.Reputation, error) {
+	const op = "storage.GetAuthorReputations"
+
+	query := `SELECT b.author_id, ` + reputationColumns + `
+				FROM bid b
+				LEFT JOIN review r ON (r.bid_id = b.id AND r.hidden_at IS NULL)
+				WHERE b.author_id = ANY($1)
+				GROUP BY b.author_id;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, authorIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var reputations []models.Reputation
+	if err = pgxscan.ScanAll(&reputations, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	byAuthor := make(map[uuid.UUID]models.Reputation, len(reputations))
+	for _, reputation := range reputations {
+		reputation.ComputeRates()
+		byAuthor[reputation.AuthorID] = reputation
+	}
+
+	return byAuthor, nil
+}
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"time"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const reviewColumns = `id, bid_id, author_username, description, rating, completed, created_at,
+					reply, reply_author_username, replied_at, updated_at, hidden_by, hidden_reason, hidden_at`
+
+func (d *Database) scanReview(ctx context.Context, op, query string, args ...any) (models.Review, error) {
+	rows, err := d.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return models.Review{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	op2 := op + "pgxscan"
+	var review models.Review
+	err = pgxscan.ScanOne(&review, rows)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Review{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.ReviewNotFound}
+		}
+		return models.Review{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return review, nil
+}
+
+func (d *Database) CreateReview(ctx context.Context, review *models.Review) (models.Review, error) {
+	query := `INSERT INTO review (bid_id, author_username, description, rating, completed)
+				VALUES ($1, $2, $3, $4, $5)
+				RETURNING ` + reviewColumns + `;`
+
+	return d.scanReview(ctx, "storage.CreateReview", query,
+		review.BidID, review.AuthorUsername, review.Description, review.Rating, review.Completed)
+}
+
+// GetReview Возвращает отзыв вместе со скрытыми, проверки видимости - на стороне сервиса.
+func (d *Database) GetReview(ctx context.Context, reviewID string) (models.Review, error) {
+	query := `SELECT ` + reviewColumns + `
+				FROM review
+				WHERE id = $1;`
+
+	return d.scanReview(ctx, "storage.GetReview", query, reviewID)
+}
+
+// CheckReviewEditable Срок редактирования отсчитывается от создания отзыва по часам базы данных,
+// по которым проставлен created_at.
+func (d *Database) CheckReviewEditable(ctx context.Context, reviewID string, window time.Duration) error {
+	const op = "storage.CheckReviewEditable"
+
+	query := `SELECT 1
+				FROM review
+				WHERE id = $1 AND created_at + make_interval(secs => $2) > LOCALTIMESTAMP;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, reviewID, window.Seconds()).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusConflict, Msg: util.EditWindowClosed}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReplyToReview Ответ можно дать только один раз.
+func (d *Database) ReplyToReview(ctx context.Context, reviewID, reply, username string) (models.Review, error) {
+	query := `UPDATE review
+				SET reply = $2, reply_author_username = $3, replied_at = CURRENT_TIMESTAMP
+				WHERE id = $1 AND reply IS NULL
+				RETURNING ` + reviewColumns + `;`
+
+	review, err := d.scanReview(ctx, "storage.ReplyToReview", query, reviewID, reply, username)
+	var customErr util.MyResponseError
+	if errors.As(err, &customErr) && customErr.Status == http.StatusNotFound {
+		return models.Review{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.ReplyExists}
+	}
+
+	return review, err
+}
+
+// EditReview Сохраняет текущую редакцию в истории и применяет правку. Пустые поля не меняются.
+func (d *Database) EditReview(ctx context.Context, reviewID string, edit *models.Review, username string) (models.Review, error) {
+	const op = "storage.EditReview"
+
+	revisionQuery := `INSERT INTO review_revision (review_id, description, rating, completed, edited_by)
+				SELECT id, description, rating, completed, $2
+				FROM review
+				WHERE id = $1;`
+	_, err := d.conn(ctx).Exec(ctx, revisionQuery, reviewID, username)
+	if err != nil {
+		return models.Review{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var description *string
+	if edit.Description != "" {
+		description = &edit.Description
+	}
+
+	query := `UPDATE review
+				SET description = COALESCE($2, description),
+					rating = COALESCE($3, rating),
+					completed = COALESCE($4, completed),
+					updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1
+				RETURNING ` + reviewColumns + `;`
+
+	return d.scanReview(ctx, op, query, reviewID, description, edit.Rating, edit.Completed)
+}
+
+// GetReviewRevisions Редакции отзыва от первой к последней.
+func (d *Database) GetReviewRevisions(ctx context.Context, reviewID string) ([]models.ReviewRevision, error) {
+	const op = "storage.GetReviewRevisions"
+
+	query := `SELECT id, review_id, description, rating, completed, edited_by, created_at
+				FROM review_revision
+				WHERE review_id = $1
+				ORDER BY created_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, reviewID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	revisions := []models.ReviewRevision{}
+	if err = pgxscan.ScanAll(&revisions, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return revisions, nil
+}
+
+func (d *Database) HideReview(ctx context.Context, reviewID, reason, username string) (models.Review, error) {
+	query := `UPDATE review
+				SET hidden_by = $2, hidden_reason = $3, hidden_at = CURRENT_TIMESTAMP
+				WHERE id = $1
+				RETURNING ` + reviewColumns + `;`
+
+	return d.scanReview(ctx, "storage.HideReview", query, reviewID, username, reason)
+}
+
+// RestoreReview Снимает скрытие. Кто и почему скрывал, остаётся в журнале событий.
+func (d *Database) RestoreReview(ctx context.Context, reviewID string) (models.Review, error) {
+	query := `UPDATE review
+				SET hidden_by = NULL, hidden_reason = NULL, hidden_at = NULL
+				WHERE id = $1
+				RETURNING ` + reviewColumns + `;`
+
+	return d.scanReview(ctx, "storage.RestoreReview", query, reviewID)
+}
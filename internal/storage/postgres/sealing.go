@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+func (d *Database) GetTenderSealing(ctx context.Context, tenderID string) (models.TenderSealing, error) {
+	const op = "storage.GetTenderSealing"
+
+	query := `SELECT t.sealed, t.opening_at, o.opened_at
+				FROM tender t
+				LEFT JOIN bid_opening o ON (o.tender_id = t.id)
+				WHERE t.id = $1;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return models.TenderSealing{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var sealing models.TenderSealing
+	if err = pgxscan.ScanOne(&sealing, rows); err != nil {
+		return models.TenderSealing{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return sealing, nil
+}
+
+// CheckBidUnsealed Запечатанное предложение нельзя менять и оценивать до вскрытия.
+func (d *Database) CheckBidUnsealed(ctx context.Context, bidID string) error {
+	const op = "storage.CheckBidUnsealed"
+
+	query := `SELECT sealed_payload IS NOT NULL
+				FROM bid
+				WHERE id = $1;`
+
+	var sealed bool
+	err := d.conn(ctx).QueryRow(ctx, query, bidID).Scan(&sealed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if sealed {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.BidSealed}
+	}
+
+	return nil
+}
+
+// CheckBidVersionUnsealed Версии предложения запечатанного тендера до вскрытия хранят пустые открытые поля,
+// откат к ним стёр бы предложение. Версия, записанная при вскрытии, уже открыта.
+func (d *Database) CheckBidVersionUnsealed(ctx context.Context, bidID string, version int32) error {
+	const op = "storage.CheckBidVersionUnsealed"
+
+	query := `SELECT t.sealed AND (o.opened_at IS NULL OR h.updated_at < o.opened_at)
+				FROM bid_history h
+				JOIN tender t ON (h.tender_id = t.id)
+				LEFT JOIN bid_opening o ON (o.tender_id = t.id)
+				WHERE h.bid_id = $1 AND h.version = $2;`
+
+	var sealed bool
+	err := d.conn(ctx).QueryRow(ctx, query, bidID, version).Scan(&sealed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.VersionNotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if sealed {
+		return util.MyResponseError{Status: http.StatusConflict, Msg: util.SealedVersion}
+	}
+
+	return nil
+}
+
+// CreateBidOpening Фиксирует вскрытие. Повторное вскрытие, в том числе параллельное, возвращает 409.
+// Должен вызываться внутри WithTx вместе с UnsealBid.
+func (d *Database) CreateBidOpening(ctx context.Context, tenderID, username string) (models.BidOpening, error) {
+	const op = "storage.CreateBidOpening"
+
+	query := `INSERT INTO bid_opening (tender_id, opened_by, bids_count)
+				VALUES ($1, $2, (SELECT COUNT(*) FROM bid WHERE tender_id = $1 AND sealed_payload IS NOT NULL))
+				ON CONFLICT (tender_id) DO NOTHING
+				RETURNING tender_id, opened_by, opened_at, bids_count;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, username)
+	if err != nil {
+		return models.BidOpening{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var opening models.BidOpening
+	if err = pgxscan.ScanOne(&opening, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.BidOpening{}, util.MyResponseError{Status: http.StatusConflict, Msg: util.BidsAlreadyOpened}
+		}
+		return models.BidOpening{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return opening, nil
+}
+
+func (d *Database) GetBidOpening(ctx context.Context, tenderID string) (models.BidOpening, error) {
+	const op = "storage.GetBidOpening"
+
+	query := `SELECT tender_id, COALESCE(opened_by, '') AS opened_by, opened_at, bids_count
+				FROM bid_opening
+				WHERE tender_id = $1;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return models.BidOpening{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var opening models.BidOpening
+	if err = pgxscan.ScanOne(&opening, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.BidOpening{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.BidsNotOpened}
+		}
+		return models.BidOpening{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return opening, nil
+}
+
+// GetSealedBids Блокирует запечатанные предложения тендера до конца транзакции вскрытия.
+func (d *Database) GetSealedBids(ctx context.Context, tenderID string) ([]models.Bid, error) {
+	const op = "storage.GetSealedBids"
+
+	query := `SELECT id, tender_id, sealed_payload
+				FROM bid
+				WHERE tender_id = $1 AND sealed_payload IS NOT NULL
+				FOR UPDATE;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var bids []models.Bid
+	if err = pgxscan.ScanAll(&bids, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return bids, nil
+}
+
+// UnsealBid Записывает расшифрованное содержимое в открытые поля. Создаёт новую версию предложения.
+func (d *Database) UnsealBid(ctx context.Context, bidID uuid.UUID, content *models.SealedBidContent) error {
+	const op = "storage.UnsealBid"
+
+	query := `UPDATE bid
+				SET name = $1, description = $2, price = $3, sealed_payload = NULL
+				WHERE id = $4;`
+
+	_, err := d.conn(ctx).Exec(ctx, query, content.Name, content.Description, content.Price, bidID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
+)
+
+const templateColumns = `id, organization_id, name, description, service_type, criteria, organization_types,
+					min_completed_contracts, certificate, version, creator_username, created_at, updated_at`
+
+func (d *Database) scanTemplate(ctx context.Context, op, query string, args ...any) (models.TenderTemplate, error) {
+	rows, err := d.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return models.TenderTemplate{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	op2 := op + "pgxscan"
+	var template models.TenderTemplate
+	err = pgxscan.ScanOne(&template, rows)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.TenderTemplate{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.TemplateNotFound}
+		}
+		return models.TenderTemplate{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return template, nil
+}
+
+// templateArgs Пустые списки вместо nil, чтобы не нарушать NOT NULL.
+func templateArgs(template *models.TenderTemplate) ([]models.TemplateCriterion, []models.OrganizationType) {
+	criteria := template.Criteria
+	if criteria == nil {
+		criteria = []models.TemplateCriterion{}
+	}
+
+	organizationTypes := template.OrganizationTypes
+	if organizationTypes == nil {
+		organizationTypes = []models.OrganizationType{}
+	}
+
+	return criteria, organizationTypes
+}
+
+func (d *Database) CreateTemplate(ctx context.Context, template *models.TenderTemplate) (models.TenderTemplate, error) {
+	query := `INSERT INTO tender_template (organization_id, name, description, service_type, criteria, organization_types,
+					min_completed_contracts, certificate, creator_username)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				RETURNING ` + templateColumns + `;`
+
+	criteria, organizationTypes := templateArgs(template)
+	return d.scanTemplate(ctx, "storage.CreateTemplate", query, template.OrganizationID, template.Name, template.Description,
+		template.ServiceType, criteria, organizationTypes, template.MinCompletedContracts, template.Certificate, template.CreatorUsername)
+}
+
+func (d *Database) GetTemplate(ctx context.Context, templateID string) (models.TenderTemplate, error) {
+	query := `SELECT ` + templateColumns + `
+				FROM tender_template
+				WHERE id = $1;`
+
+	return d.scanTemplate(ctx, "storage.GetTemplate", query, templateID)
+}
+
+func (d *Database) GetTemplates(ctx context.Context, orgID string, offset, limit int32) ([]models.TenderTemplate, error) {
+	const op = "storage.GetTemplates"
+
+	query := `SELECT ` + templateColumns + `
+				FROM tender_template
+				WHERE organization_id = $1
+				ORDER BY name
+				OFFSET $2
+				FETCH NEXT $3 ROWS ONLY;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, orgID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var templates []models.TenderTemplate
+	if err = pgxscan.ScanAll(&templates, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return templates, nil
+}
+
+// UpdateTemplate Перезаписывает содержимое шаблона. Версию увеличивает триггер.
+func (d *Database) UpdateTemplate(ctx context.Context, template *models.TenderTemplate) (models.TenderTemplate, error) {
+	query := `UPDATE tender_template
+				SET name = $2, description = $3, service_type = $4, criteria = $5, organization_types = $6,
+					min_completed_contracts = $7, certificate = $8
+				WHERE id = $1
+				RETURNING ` + templateColumns + `;`
+
+	criteria, organizationTypes := templateArgs(template)
+	return d.scanTemplate(ctx, "storage.UpdateTemplate", query, template.ID, template.Name, template.Description,
+		template.ServiceType, criteria, organizationTypes, template.MinCompletedContracts, template.Certificate)
+}
+
+func (d *Database) CheckTemplateVersionExists(ctx context.Context, templateID string, version int32) error {
+	const op = "storage.CheckTemplateVersionExists"
+
+	query := `SELECT 1
+				FROM tender_template_history
+				WHERE template_id = $1 AND version = $2;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, templateID, version).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.VersionNotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RollbackTemplate Восстанавливает содержимое версии. Как и у тендера, откат - новая правка со следующей версией.
+func (d *Database) RollbackTemplate(ctx context.Context, templateID string, version int32) (models.TenderTemplate, error) {
+	query := `UPDATE tender_template t
+				SET name = h.name, description = h.description, service_type = h.service_type, criteria = h.criteria,
+					organization_types = h.organization_types, min_completed_contracts = h.min_completed_contracts,
+					certificate = h.certificate
+				FROM tender_template_history h
+				WHERE t.id = $1 AND h.template_id = t.id AND h.version = $2
+				RETURNING t.id, t.organization_id, t.name, t.description, t.service_type, t.criteria, t.organization_types,
+					t.min_completed_contracts, t.certificate, t.version, t.creator_username, t.created_at, t.updated_at;`
+
+	return d.scanTemplate(ctx, "storage.RollbackTemplate", query, templateID, version)
+}
@@ -2,18 +2,25 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"net/http"
+	"time"
 	"zadanie-6105/internal/models"
+	"zadanie-6105/internal/util"
 )
 
-func (d *Database) GetTenders(ctx context.Context, offset, limit int32, serviceTypes []string) ([]models.Tender, error) {
+// GetTenders Закрытые тендеры попадают в список, только если организация пользователя приглашена.
+func (d *Database) GetTenders(ctx context.Context, offset, limit int32, serviceTypes []string, username string) ([]models.Tender, error) {
 	const op = "storage.GetTenders"
 
-	query := `SELECT id, name, description, service_type, status, version, organization_id, creator_username, created_at
-				FROM tender
+	query := `SELECT id, name, description, service_type, status, version, organization_id, creator_username, sealed, opening_at, two_envelope, private, source_tender_id, created_at
+				FROM tender t
 				WHERE status = $1
   				AND ($2::VARCHAR[] IS NULL OR service_type::VARCHAR = ANY($2::VARCHAR[]))
+				AND ` + tenderVisibleTo("$5") + `
 				ORDER BY name
 				OFFSET $3
 				FETCH NEXT $4 ROWS ONLY`
@@ -22,7 +29,7 @@ func (d *Database) GetTenders(ctx context.Context, offset, limit int32, serviceT
 		serviceTypes = nil
 	}
 
-	rows, err := d.Pool.Query(ctx, query, models.Published, serviceTypes, offset, limit)
+	rows, err := d.conn(ctx).Query(ctx, query, models.Published, serviceTypes, offset, limit, username)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -40,11 +47,11 @@ func (d *Database) GetTenders(ctx context.Context, offset, limit int32, serviceT
 func (d *Database) CreateTender(ctx context.Context, tender *models.Tender) (models.Tender, error) {
 	const op = "storage.CreateTender"
 
-	query := `INSERT INTO tender (name, description, service_type, status, organization_id, creator_username)
-				VALUES ($1,	$2, $3, $4, $5, $6)
-				RETURNING id, name, description, service_type, status, version, organization_id, creator_username, created_at;`
+	query := `INSERT INTO tender (name, description, service_type, status, organization_id, creator_username, sealed, opening_at, two_envelope, private, source_tender_id)
+				VALUES ($1,	$2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+				RETURNING id, name, description, service_type, status, version, organization_id, creator_username, sealed, opening_at, two_envelope, private, source_tender_id, created_at;`
 
-	rows, err := d.Pool.Query(ctx, query, tender.Name, tender.Description, tender.ServiceType, tender.Status, tender.OrganizationID, tender.CreatorUsername)
+	rows, err := d.conn(ctx).Query(ctx, query, tender.Name, tender.Description, tender.ServiceType, tender.Status, tender.OrganizationID, tender.CreatorUsername, tender.Sealed, tender.OpeningAt, tender.TwoEnvelope, tender.Private, tender.SourceTenderID)
 	if err != nil {
 		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -59,17 +66,44 @@ func (d *Database) CreateTender(ctx context.Context, tender *models.Tender) (mod
 	return newTender, nil
 }
 
-func (d *Database) GetUserTenders(ctx context.Context, offset, limit int32, username string) ([]models.Tender, error) {
-	const op = "storage.GetUserTenders"
+func (d *Database) GetTender(ctx context.Context, tenderID string) (models.Tender, error) {
+	const op = "storage.GetTender"
 
-	query := `SELECT id, name, description, service_type, status, version, organization_id, creator_username, created_at
+	query := `SELECT id, name, description, service_type, status, version, organization_id, creator_username, sealed, opening_at, two_envelope, private, source_tender_id, created_at, updated_at
+				FROM tender
+				WHERE id = $1;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID)
+	if err != nil {
+		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const op2 = op + "pgxscan"
+	var tender models.Tender
+	if err = pgxscan.ScanOne(&tender, rows); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Tender{}, util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return models.Tender{}, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return tender, nil
+}
+
+// userTendersQuery Тендеры пользователя без пагинации. Общий для списка и выгрузки.
+const userTendersQuery = `SELECT id, name, description, service_type, status, version, organization_id, creator_username, sealed, opening_at, two_envelope, private, source_tender_id, created_at
 				FROM tender
 				WHERE creator_username = $1
-				ORDER BY name
+				ORDER BY name`
+
+func (d *Database) GetUserTenders(ctx context.Context, offset, limit int32, username string) ([]models.Tender, error) {
+	const op = "storage.GetUserTenders"
+
+	query := userTendersQuery + `
 				OFFSET $2
 				FETCH NEXT $3 ROWS ONLY`
 
-	rows, err := d.Pool.Query(ctx, query, username, offset, limit)
+	rows, err := d.conn(ctx).Query(ctx, query, username, offset, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -92,7 +126,7 @@ func (d *Database) GetTenderStatus(ctx context.Context, tenderID, username strin
 				WHERE id = $1
   				AND creator_username = $2`
 
-	rows, err := d.Pool.Query(ctx, query, tenderID, username)
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, username)
 	if err != nil {
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
@@ -113,10 +147,10 @@ func (d *Database) UpdateTenderStatus(ctx context.Context, tenderID, status, use
 	query := `UPDATE tender
 				SET status = $1
 				WHERE id = $2 and creator_username = $3
-				RETURNING id, name, description, service_type, status, version, organization_id, creator_username, created_at, updated_at;
+				RETURNING id, name, description, service_type, status, version, organization_id, creator_username, sealed, opening_at, two_envelope, private, source_tender_id, created_at, updated_at;
 	`
 
-	rows, err := d.Pool.Query(ctx, query, status, tenderID, username)
+	rows, err := d.conn(ctx).Query(ctx, query, status, tenderID, username)
 	if err != nil {
 		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -143,10 +177,10 @@ func (d *Database) EditTender(ctx context.Context, tender *models.Tender, tender
 						ELSE $3::service_type
 					END 
 				WHERE id = $4 AND creator_username = $5
-				RETURNING id, name, description, service_type, status, version, organization_id, creator_username, created_at, updated_at;
+				RETURNING id, name, description, service_type, status, version, organization_id, creator_username, sealed, opening_at, two_envelope, private, source_tender_id, created_at, updated_at;
 		`
 
-	rows, err := d.Pool.Query(ctx, query, tender.Name, tender.Description, tender.ServiceType, tenderID, username)
+	rows, err := d.conn(ctx).Query(ctx, query, tender.Name, tender.Description, tender.ServiceType, tenderID, username)
 	if err != nil {
 		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -166,7 +200,7 @@ func (d *Database) RollbackTender(ctx context.Context, tenderID string, version
 
 	query := `SELECT * FROM rollback_tender_version($1::UUID, $2::INT, $3::VARCHAR)`
 
-	rows, err := d.Pool.Query(ctx, query, tenderID, version, username)
+	rows, err := d.conn(ctx).Query(ctx, query, tenderID, version, username)
 	if err != nil {
 		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -179,4 +213,48 @@ func (d *Database) RollbackTender(ctx context.Context, tenderID string, version
 	}
 
 	return newTender, nil
-}
\ No newline at end of file
+}
+
+// GetTenderVersionStatus Статус, который восстановит откат к версии.
+func (d *Database) GetTenderVersionStatus(ctx context.Context, tenderID string, version int32) (models.TenderStatus, error) {
+	const op = "storage.GetTenderVersionStatus"
+
+	query := `SELECT status
+				FROM tender_history
+				WHERE tender_id = $1 AND version = $2;`
+
+	var status models.TenderStatus
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID, version).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", util.MyResponseError{Status: http.StatusNotFound, Msg: util.VersionNotFound}
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return status, nil
+}
+
+// GetExpiredTenders Опубликованные тендеры, созданные раньше before.
+func (d *Database) GetExpiredTenders(ctx context.Context, before time.Time) ([]models.Tender, error) {
+	const op = "storage.GetExpiredTenders"
+
+	query := `SELECT id, name, description, service_type, status, version, organization_id, creator_username, sealed, opening_at, two_envelope, private, source_tender_id, created_at
+				FROM tender
+				WHERE status = 'Published' AND created_at < $1
+				ORDER BY created_at;`
+
+	rows, err := d.conn(ctx).Query(ctx, query, before)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	const op2 = op + "pgxscan"
+	var tenders []models.Tender
+	if err = pgxscan.ScanAll(&tenders, rows); err != nil {
+		return nil, fmt.Errorf("%s: %w", op2, err)
+	}
+
+	return tenders, nil
+}
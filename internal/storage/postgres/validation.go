@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/jackc/pgx/v5"
 	"net/http"
+	"zadanie-6105/internal/models"
 	"zadanie-6105/internal/util"
 )
 
@@ -17,7 +18,7 @@ func (d *Database) CheckUserExists(ctx context.Context, username string) error {
 				WHERE username = $1;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, username).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, username).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusUnauthorized, Msg: util.Unauthorized}
@@ -36,7 +37,7 @@ func (d *Database) CheckUserByIDExists(ctx context.Context, id string) error {
 				WHERE id = $1;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, id).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, id).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusUnauthorized, Msg: util.Unauthorized}
@@ -55,7 +56,7 @@ func (d *Database) CheckTenderExists(ctx context.Context, tenderID string) error
 				WHERE id = $1;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, tenderID).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
@@ -66,6 +67,27 @@ func (d *Database) CheckTenderExists(ctx context.Context, tenderID string) error
 	return nil
 }
 
+// CheckTenderPublished Проверяет, что тендер опубликован и виден пользователю:
+// открытый - всем, закрытый - только приглашённым организациям.
+func (d *Database) CheckTenderPublished(ctx context.Context, tenderID, username string) error {
+	const op = "storage.CheckTenderPublished"
+
+	query := `SELECT 1
+				FROM tender t
+				WHERE t.id = $1 AND t.status = $2 AND ` + tenderVisibleTo("$3") + `;`
+
+	var dummy int
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID, models.Published, username).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
 func (d *Database) CheckBidExists(ctx context.Context, bidID string) error {
 	const op = "storage.IsTenderExists"
 
@@ -74,7 +96,27 @@ func (d *Database) CheckBidExists(ctx context.Context, bidID string) error {
 				WHERE id = $1;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, bidID).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, bidID).Scan(&dummy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CheckBidPublished Присудить можно только опубликованное предложение.
+func (d *Database) CheckBidPublished(ctx context.Context, bidID string) error {
+	const op = "storage.CheckBidPublished"
+
+	query := `SELECT status = 'Published'
+				FROM bid
+				WHERE id = $1;`
+
+	var published bool
+	err := d.conn(ctx).QueryRow(ctx, query, bidID).Scan(&published)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.NotFound}
@@ -82,6 +124,10 @@ func (d *Database) CheckBidExists(ctx context.Context, bidID string) error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if !published {
+		return util.MyResponseError{Status: http.StatusBadRequest, Msg: util.BidNotPublished}
+	}
+
 	return nil
 }
 
@@ -94,7 +140,7 @@ func (d *Database) CheckUserBidAuthor(ctx context.Context, bidID, requestedUser
 				WHERE id = $1 AND author_username = $2;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, bidID, requestedUser).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, bidID, requestedUser).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
@@ -116,7 +162,7 @@ func (d *Database) ValidateUserResponsible(ctx context.Context, tenderID, userna
 				WHERE t.id = $1 AND e.username = $2;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, tenderID, username).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID, username).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
@@ -138,7 +184,7 @@ func (d *Database) ValidateUserResponsibleBidID(ctx context.Context, bidID, user
 				WHERE b.id = $1 AND e.username = $2;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, bidID, username).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, bidID, username).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
@@ -158,7 +204,7 @@ func (d *Database) ValidateUserResponsibleUserID(ctx context.Context, userID, te
 				WHERE o.user_id = $1 AND t.id = $2;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, userID, tenderID).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, userID, tenderID).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
@@ -178,7 +224,7 @@ func (d *Database) ValidateUserResponsibleOrgID(ctx context.Context, orgID, user
 				WHERE organization_id = $1 AND e.username = $2;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, orgID, username).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, orgID, username).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusForbidden, Msg: util.Forbidden}
@@ -197,7 +243,7 @@ func (d *Database) CheckBidVersionExists(ctx context.Context, bidID string, vers
 				WHERE bid_id = $1 AND version = $2;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, bidID, version).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, bidID, version).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.VersionNotFound}
@@ -216,7 +262,7 @@ func (d *Database) CheckTenderVersionExists(ctx context.Context, tenderID string
 				WHERE tender_id = $1 AND version = $2;`
 
 	var dummy int
-	err := d.Pool.QueryRow(ctx, query, tenderID, version).Scan(&dummy)
+	err := d.conn(ctx).QueryRow(ctx, query, tenderID, version).Scan(&dummy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return util.MyResponseError{Status: http.StatusNotFound, Msg: util.VersionNotFound}
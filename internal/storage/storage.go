@@ -2,6 +2,9 @@ package storage
 
 import (
 	"context"
+	"io"
+	"time"
+	"github.com/google/uuid"
 	"zadanie-6105/internal/models"
 )
 
@@ -10,16 +13,47 @@ type Storage interface {
 	Bid
 	Checker
 	Validator
+	Outbox
+	Audit
+	Attachment
+	Clarification
+	Lot
+	Evaluation
+	Sealing
+	Auction
+	Envelope
+	Invitation
+	Eligibility
+	Debarment
+	Reputation
+	Review
+	Contract
+	Negotiation
+	Template
+	Draft
+	Export
+	Admin
+	Migrations
+	Health
+	Transactor
+}
+
+// Transactor Выполняет fn в транзакции, которая передаётся дальше через ctx.
+type Transactor interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Tender interface {
 	CreateTender(ctx context.Context, tender *models.Tender) (models.Tender, error)
-	GetTenders(ctx context.Context, offset, limit int32, serviceTypes []string) ([]models.Tender, error)
+	GetTender(ctx context.Context, tenderID string) (models.Tender, error)
+	GetTenders(ctx context.Context, offset, limit int32, serviceTypes []string, username string) ([]models.Tender, error)
 	GetUserTenders(ctx context.Context, offset, limit int32, username string) ([]models.Tender, error)
 	GetTenderStatus(ctx context.Context, tenderID, username string) (string, error)
 	UpdateTenderStatus(ctx context.Context, tenderID, status, username string) (models.Tender, error)
 	EditTender(ctx context.Context, tender *models.Tender, tenderID, username string) (models.Tender, error)
 	RollbackTender(ctx context.Context, tenderID string, version int32, username string) (models.Tender, error)
+	GetTenderVersionStatus(ctx context.Context, tenderID string, version int32) (models.TenderStatus, error)
+	GetExpiredTenders(ctx context.Context, before time.Time) ([]models.Tender, error)
 }
 
 type Bid interface {
@@ -30,8 +64,9 @@ type Bid interface {
 	UpdateBidStatus(ctx context.Context, bidID, status, username string) (models.Bid, error)
 	EditBid(ctx context.Context, bid *models.Bid, bidID, username string) (models.Bid, error)
 	SubmitBidDecision(ctx context.Context, bidID, decision, username string) (models.Bid, error)
-	SubmitBidFeedback(ctx context.Context, bidID, bidFeedback, username string) (models.Bid, error)
-	GetBidReviews(ctx context.Context, authorUsername string, offset, limit int32) ([]models.Review, error)
+	SubmitBidFeedback(ctx context.Context, review *models.Review) (models.Bid, error)
+	GetBidReviews(ctx context.Context, tenderID, authorUsername string, offset, limit int32) ([]models.Review, error)
+	GetReviewsForBid(ctx context.Context, bidID string, offset, limit int32) ([]models.Review, error)
 	RollbackBid(ctx context.Context, bidID string, version int32, username string) (models.Bid, error)
 }
 
@@ -39,7 +74,9 @@ type Checker interface {
 	CheckUserExists(ctx context.Context, username string) error
 	CheckUserByIDExists(ctx context.Context, id string) error
 	CheckTenderExists(ctx context.Context, tenderID string) error
+	CheckTenderPublished(ctx context.Context, tenderID, username string) error
 	CheckBidExists(ctx context.Context, bidID string) error
+	CheckBidPublished(ctx context.Context, bidID string) error
 	CheckUserBidAuthor(ctx context.Context, bidID, requestedUser string) error
 	CheckBidVersionExists(ctx context.Context, bidID string, version int32) error
 	CheckTenderVersionExists(ctx context.Context, tenderID string, version int32) error
@@ -50,4 +87,215 @@ type Validator interface {
 	ValidateUserResponsibleUserID(ctx context.Context, userID, tenderID string) error
 	ValidateUserResponsibleOrgID(ctx context.Context, orgID, username string) error
 	ValidateUserResponsibleBidID(ctx context.Context, bidID, username string) error
-}
\ No newline at end of file
+}
+type Outbox interface {
+	AppendEvent(ctx context.Context, event *models.Event) error
+	GetUnpublishedEvents(ctx context.Context, limit int32) ([]models.Event, error)
+	MarkEventsPublished(ctx context.Context, ids []uuid.UUID) error
+}
+
+type Audit interface {
+	LockAuditLog(ctx context.Context) error
+	GetLastAuditHash(ctx context.Context) (string, error)
+	GetUserOrganizationID(ctx context.Context, username string) (uuid.NullUUID, error)
+	GetUsernameByID(ctx context.Context, userID string) (string, error)
+	AppendAuditEntry(ctx context.Context, entry *models.AuditEntry) error
+	GetAuditLog(ctx context.Context, orgID uuid.UUID, offset, limit int32) ([]models.AuditEntry, error)
+}
+
+// BlobStore Хранилище содержимого вложений. Ключи генерирует вызывающая сторона.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Attachment interface {
+	BumpTenderVersion(ctx context.Context, tenderID string) (int, error)
+	BumpBidVersion(ctx context.Context, bidID string) (int, error)
+	CreateAttachment(ctx context.Context, attachment *models.Attachment) (models.Attachment, error)
+	GetAttachments(ctx context.Context, entityType models.AttachmentEntity, entityID string, version int32) ([]models.Attachment, error)
+	GetAttachment(ctx context.Context, entityType models.AttachmentEntity, entityID, attachmentID string) (models.Attachment, error)
+	CloseAttachment(ctx context.Context, attachmentID string, version int) error
+	RestoreAttachments(ctx context.Context, entityType models.AttachmentEntity, entityID string, fromVersion int32, toVersion int) error
+}
+
+type Clarification interface {
+	CreateClarification(ctx context.Context, clarification *models.Clarification) (models.Clarification, error)
+	GetClarifications(ctx context.Context, tenderID, username string, all bool, offset, limit int32) ([]models.Clarification, error)
+	CheckClarificationExists(ctx context.Context, tenderID, clarificationID string) error
+	AnswerClarification(ctx context.Context, clarification *models.Clarification) (models.Clarification, error)
+}
+
+type Lot interface {
+	CreateLot(ctx context.Context, lot *models.Lot) (models.Lot, error)
+	EditLot(ctx context.Context, lot *models.Lot, tenderID, lotID string) (models.Lot, error)
+	GetLots(ctx context.Context, tenderID string, version int32) ([]models.Lot, error)
+	CheckLotExists(ctx context.Context, tenderID, lotID string) error
+	ResolveLot(ctx context.Context, lotID string, status models.LotStatus, winningBidID uuid.NullUUID) (models.Lot, error)
+	CheckLotsOpen(ctx context.Context, tenderID string, lotIDs []uuid.UUID) error
+	AddBidLots(ctx context.Context, bidID uuid.UUID, lotIDs []uuid.UUID) error
+	CheckBidInLot(ctx context.Context, bidID, lotID string) error
+	CheckTenderLotsResolved(ctx context.Context, tenderID string) error
+	CheckLotDecisionsKept(ctx context.Context, tenderID string, version int32) error
+	CheckTenderNotClosed(ctx context.Context, tenderID string) error
+}
+
+type Evaluation interface {
+	ReplaceCriteria(ctx context.Context, tenderID string, criteria []models.Criterion) ([]models.Criterion, error)
+	GetCriteria(ctx context.Context, tenderID string) ([]models.Criterion, error)
+	CheckTenderNotScored(ctx context.Context, tenderID string) error
+	UpsertBidScores(ctx context.Context, bidID, username string, scores []models.BidScore) ([]models.BidScore, error)
+	GetBidScores(ctx context.Context, bidID string) ([]models.BidScore, error)
+	GetTenderRanking(ctx context.Context, tenderID string, offset, limit int32) ([]models.BidRanking, error)
+	CreateDecisionRecord(ctx context.Context, bidID, decision, username string) (models.DecisionRecord, error)
+	GetDecisionRecords(ctx context.Context, bidID string, offset, limit int32) ([]models.DecisionRecord, error)
+}
+
+type Sealing interface {
+	GetTenderSealing(ctx context.Context, tenderID string) (models.TenderSealing, error)
+	CheckBidUnsealed(ctx context.Context, bidID string) error
+	CheckBidVersionUnsealed(ctx context.Context, bidID string, version int32) error
+	CreateBidOpening(ctx context.Context, tenderID, username string) (models.BidOpening, error)
+	GetBidOpening(ctx context.Context, tenderID string) (models.BidOpening, error)
+	GetSealedBids(ctx context.Context, tenderID string) ([]models.Bid, error)
+	UnsealBid(ctx context.Context, bidID uuid.UUID, content *models.SealedBidContent) error
+}
+
+type Auction interface {
+	CheckTenderAuctionAllowed(ctx context.Context, tenderID string) error
+	ScheduleAuction(ctx context.Context, auction *models.Auction, now time.Time) (models.Auction, error)
+	GetAuction(ctx context.Context, tenderID string) (models.Auction, error)
+	LockAuction(ctx context.Context, tenderID string) error
+	CreateAuctionPrice(ctx context.Context, tenderID, bidID string, price float64, now time.Time) (models.AuctionPrice, error)
+	ExtendAuction(ctx context.Context, tenderID string, endsAt time.Time) error
+	GetAuctionRanking(ctx context.Context, tenderID string) ([]models.AuctionRank, error)
+	CloseDueAuctions(ctx context.Context, now time.Time, limit int32) ([]models.Auction, error)
+	GetUserTenderBidIDs(ctx context.Context, tenderID, username string) ([]uuid.UUID, error)
+	GetBidTenderID(ctx context.Context, bidID string) (string, error)
+}
+
+type Envelope interface {
+	GetTenderStage(ctx context.Context, tenderID string) (models.TenderStage, error)
+	CheckTenderAcceptsBids(ctx context.Context, tenderID string) error
+	CheckBidCommercialVisible(ctx context.Context, bidID string) error
+	QualifyBid(ctx context.Context, record *models.QualificationRecord) (models.QualificationRecord, error)
+	CheckBidsQualified(ctx context.Context, tenderID string) error
+}
+
+type Invitation interface {
+	CheckOrganizationExists(ctx context.Context, orgID string) error
+	CheckTenderPrivate(ctx context.Context, tenderID string) error
+	InviteOrganization(ctx context.Context, tenderID, orgID, username string) (models.Invitation, error)
+	RevokeInvitation(ctx context.Context, tenderID, orgID string) (models.Invitation, error)
+	GetInvitations(ctx context.Context, tenderID string, offset, limit int32) ([]models.Invitation, error)
+	RespondInvitation(ctx context.Context, tenderID, username string, status models.InvitationStatus) (models.Invitation, error)
+	GetInvitedTenders(ctx context.Context, username string, offset, limit int32) ([]models.InvitedTender, error)
+	CheckBidderInvited(ctx context.Context, tenderID, authorID string) error
+}
+
+type Eligibility interface {
+	SetEligibilityRules(ctx context.Context, rules *models.EligibilityRules) (models.EligibilityRules, error)
+	GetEligibilityRules(ctx context.Context, tenderID string) (models.EligibilityRules, error)
+	GetEmployeeOrganizationID(ctx context.Context, userID string) (uuid.NullUUID, error)
+	GetSupplierProfile(ctx context.Context, orgID uuid.UUID) (models.SupplierProfile, error)
+	AddCertificate(ctx context.Context, certificate *models.Certificate) (models.Certificate, error)
+	GetCertificates(ctx context.Context, orgID string) ([]models.Certificate, error)
+	VerifyCertificate(ctx context.Context, orgID, certificateID, username string) (models.Certificate, error)
+}
+
+type Debarment interface {
+	CreateDebarment(ctx context.Context, debarment *models.Debarment) (models.Debarment, error)
+	GetDebarments(ctx context.Context, orgID uuid.NullUUID, activeOnly bool, offset, limit int32) ([]models.Debarment, error)
+	LiftDebarment(ctx context.Context, orgID uuid.NullUUID, debarmentID, username string) (models.Debarment, error)
+	CheckBidderNotDebarred(ctx context.Context, tenderID, authorID string) error
+	ExpireDebarments(ctx context.Context, now time.Time, limit int32) ([]models.Debarment, error)
+}
+
+type Reputation interface {
+	GetAuthorReputation(ctx context.Context, authorUsername string) (models.Reputation, error)
+	GetOrganizationReputation(ctx context.Context, orgID string) (models.Reputation, error)
+	GetAuthorReputations(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]models.Reputation, error)
+}
+
+type Review interface {
+	CreateReview(ctx context.Context, review *models.Review) (models.Review, error)
+	GetReview(ctx context.Context, reviewID string) (models.Review, error)
+	CheckReviewEditable(ctx context.Context, reviewID string, window time.Duration) error
+	ReplyToReview(ctx context.Context, reviewID, reply, username string) (models.Review, error)
+	EditReview(ctx context.Context, reviewID string, edit *models.Review, username string) (models.Review, error)
+	GetReviewRevisions(ctx context.Context, reviewID string) ([]models.ReviewRevision, error)
+	HideReview(ctx context.Context, reviewID, reason, username string) (models.Review, error)
+	RestoreReview(ctx context.Context, reviewID string) (models.Review, error)
+}
+
+type Contract interface {
+	CheckBidWon(ctx context.Context, bidID string) error
+	CreateContract(ctx context.Context, bidID, username string) (models.Contract, error)
+	GetContract(ctx context.Context, contractID string) (models.Contract, error)
+	GetUserContracts(ctx context.Context, username string, offset, limit int32) ([]models.Contract, error)
+	CloseContract(ctx context.Context, contractID string, status models.ContractStatus, reviewID uuid.UUID, username string) (models.Contract, error)
+	AddMilestone(ctx context.Context, milestone *models.Milestone) (models.Milestone, error)
+	GetMilestones(ctx context.Context, contractID string) ([]models.Milestone, error)
+	GetMilestone(ctx context.Context, contractID, milestoneID string) (models.Milestone, error)
+	GetMilestonesAmount(ctx context.Context, contractID string) (float64, error)
+	UpdateMilestoneStatus(ctx context.Context, milestoneID string, from, status models.MilestoneStatus, comment *string) (models.Milestone, error)
+	DecideMilestone(ctx context.Context, milestoneID string, status models.MilestoneStatus, comment *string, username string) (models.Milestone, error)
+	CheckMilestonesAccepted(ctx context.Context, contractID string) error
+}
+
+type Negotiation interface {
+	CheckBidNegotiable(ctx context.Context, bidID string) error
+	GetNegotiationRounds(ctx context.Context, bidID string) ([]models.NegotiationRound, error)
+	CreateNegotiationRound(ctx context.Context, round *models.NegotiationRound) (models.NegotiationRound, error)
+	CloseNegotiationRound(ctx context.Context, roundID string, status models.NegotiationStatus, resultingVersion *int, username string) (models.NegotiationRound, error)
+	ApplyNegotiatedTerms(ctx context.Context, bidID string, price *float64) (models.Bid, error)
+	GetBidHistory(ctx context.Context, bidID string) ([]models.BidVersion, error)
+}
+
+type Template interface {
+	CreateTemplate(ctx context.Context, template *models.TenderTemplate) (models.TenderTemplate, error)
+	GetTemplate(ctx context.Context, templateID string) (models.TenderTemplate, error)
+	GetTemplates(ctx context.Context, orgID string, offset, limit int32) ([]models.TenderTemplate, error)
+	UpdateTemplate(ctx context.Context, template *models.TenderTemplate) (models.TenderTemplate, error)
+	CheckTemplateVersionExists(ctx context.Context, templateID string, version int32) error
+	RollbackTemplate(ctx context.Context, templateID string, version int32) (models.TenderTemplate, error)
+}
+
+type Draft interface {
+	SaveBidDraft(ctx context.Context, draft *models.BidDraft) (models.BidDraft, error)
+	GetBidDraft(ctx context.Context, bidID string) (models.BidDraft, error)
+	DeleteBidDraft(ctx context.Context, bidID string) (models.BidDraft, error)
+	SaveTenderDraft(ctx context.Context, draft *models.TenderDraft) (models.TenderDraft, error)
+	GetTenderDraft(ctx context.Context, tenderID string) (models.TenderDraft, error)
+	DeleteTenderDraft(ctx context.Context, tenderID string) (models.TenderDraft, error)
+}
+
+// Export Построчная выгрузка списков без пагинации. fn вызывается для каждой строки по мере чтения.
+type Export interface {
+	ExportUserTenders(ctx context.Context, username string, fn func(*models.Tender) error) error
+	ExportBidsForTender(ctx context.Context, tenderID string, fn func(*models.Bid) error) error
+	ExportBidReviews(ctx context.Context, tenderID, authorUsername string, fn func(*models.Review) error) error
+	ExportReviewsForBid(ctx context.Context, bidID string, fn func(*models.Review) error) error
+}
+
+// Admin Заведение пользователей и организаций, которых нет в API.
+type Admin interface {
+	CreateEmployee(ctx context.Context, employee *models.Employee) (models.Employee, error)
+	CreateOrganization(ctx context.Context, organization *models.Organization) (models.Organization, error)
+	AddOrganizationResponsible(ctx context.Context, orgID, username string) (models.OrganizationResponsible, error)
+}
+
+// Migrations Встроенные миграции схемы.
+type Migrations interface {
+	MigrateUp(ctx context.Context) ([]models.Migration, error)
+	MigrateDown(ctx context.Context) (models.Migration, error)
+	GetMigrations(ctx context.Context) ([]models.Migration, error)
+	GetSchemaVersion(ctx context.Context) (models.SchemaVersion, error)
+}
+
+type Health interface {
+	Ping(ctx context.Context) error
+	GetPoolStats() models.PoolStats
+	Close()
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 	"zadanie-6105/internal/models/config"
 )
@@ -35,6 +36,10 @@ func NewServerConfig() *config.ServerConfig {
 	if err != nil {
 		log.Fatalf("Error parsing GRACEFUL_TIMEOUT: %v\n", err)
 	}
+	drainTimeout, err := time.ParseDuration(os.Getenv("DRAIN_TIMEOUT"))
+	if err != nil {
+		log.Fatalf("Error parsing DRAIN_TIMEOUT: %v\n", err)
+	}
 
 	return &config.ServerConfig{
 		ServerAddr:      os.Getenv("SERVER_ADDRESS"),
@@ -42,6 +47,7 @@ func NewServerConfig() *config.ServerConfig {
 		ReadTimeout:     readTimeout,
 		IdleTimeout:     idleTimeout,
 		GracefulTimeout: gracefulTimeout,
+		DrainTimeout:    drainTimeout,
 	}
 }
 
@@ -66,6 +72,35 @@ func NewDBConfig() *config.DBConfig {
 	}
 }
 
+func NewOutboxConfig() *config.OutboxConfig {
+	interval, err := time.ParseDuration(os.Getenv("OUTBOX_INTERVAL"))
+	if err != nil {
+		log.Fatalf("Error parsing OUTBOX_INTERVAL: %v\n", err)
+	}
+	batchSize, err := strconv.Atoi(os.Getenv("OUTBOX_BATCH_SIZE"))
+	if err != nil {
+		log.Fatalf("err converting OUTBOX_BATCH_SIZE: %v\n", err)
+	}
+
+	return &config.OutboxConfig{
+		Interval:  interval,
+		BatchSize: int32(batchSize),
+	}
+}
+
+func NewAttachmentConfig() *config.AttachmentConfig {
+	maxSize, err := strconv.ParseInt(os.Getenv("ATTACHMENT_MAX_SIZE"), 10, 64)
+	if err != nil {
+		log.Fatalf("err converting ATTACHMENT_MAX_SIZE: %v\n", err)
+	}
+
+	return &config.AttachmentConfig{
+		Dir:       os.Getenv("ATTACHMENT_DIR"),
+		MaxSize:   maxSize,
+		MIMETypes: strings.Split(os.Getenv("ATTACHMENT_MIME_TYPES"), ","),
+	}
+}
+
 func NewZapLogger() *zap.SugaredLogger {
 	highPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
 		return lvl >= zapcore.ErrorLevel
@@ -96,4 +131,78 @@ func DoWithTries(fn func() error, attempts int, delay time.Duration) (err error)
 		return nil
 	}
 	return
-}
\ No newline at end of file
+}
+
+func NewSealingConfig() *config.SealingConfig {
+	keyFile := os.Getenv("SEALED_BID_KEY_FILE")
+	if keyFile == "" {
+		log.Fatalf("SEALED_BID_KEY_FILE is not set\n")
+	}
+
+	return &config.SealingConfig{
+		KeyFile: keyFile,
+	}
+}
+
+func NewAuctionConfig() *config.AuctionConfig {
+	closeInterval, err := time.ParseDuration(os.Getenv("AUCTION_CLOSE_INTERVAL"))
+	if err != nil {
+		log.Fatalf("Error parsing AUCTION_CLOSE_INTERVAL: %v\n", err)
+	}
+	streamInterval, err := time.ParseDuration(os.Getenv("AUCTION_STREAM_INTERVAL"))
+	if err != nil {
+		log.Fatalf("Error parsing AUCTION_STREAM_INTERVAL: %v\n", err)
+	}
+
+	return &config.AuctionConfig{
+		CloseInterval:  closeInterval,
+		StreamInterval: streamInterval,
+	}
+}
+
+func NewPlatformConfig() *config.PlatformConfig {
+	var admins []string
+	for _, username := range strings.Split(os.Getenv("PLATFORM_ADMINS"), ",") {
+		if username = strings.TrimSpace(username); username != "" {
+			admins = append(admins, username)
+		}
+	}
+
+	return &config.PlatformConfig{Admins: admins}
+}
+
+func NewDebarmentConfig() *config.DebarmentConfig {
+	expireInterval, err := time.ParseDuration(os.Getenv("DEBARMENT_EXPIRE_INTERVAL"))
+	if err != nil {
+		log.Fatalf("Error parsing DEBARMENT_EXPIRE_INTERVAL: %v\n", err)
+	}
+
+	return &config.DebarmentConfig{ExpireInterval: expireInterval}
+}
+
+func NewReviewConfig() *config.ReviewConfig {
+	editWindow, err := time.ParseDuration(os.Getenv("REVIEW_EDIT_WINDOW"))
+	if err != nil {
+		log.Fatalf("Error parsing REVIEW_EDIT_WINDOW: %v\n", err)
+	}
+
+	return &config.ReviewConfig{EditWindow: editWindow}
+}
+
+func NewNegotiationConfig() *config.NegotiationConfig {
+	maxRounds, err := strconv.Atoi(os.Getenv("NEGOTIATION_MAX_ROUNDS"))
+	if err != nil {
+		log.Fatalf("err converting NEGOTIATION_MAX_ROUNDS: %v\n", err)
+	}
+
+	return &config.NegotiationConfig{MaxRounds: maxRounds}
+}
+
+func NewBatchConfig() *config.BatchConfig {
+	maxOperations, err := strconv.Atoi(os.Getenv("BATCH_MAX_OPERATIONS"))
+	if err != nil {
+		log.Fatalf("err converting BATCH_MAX_OPERATIONS: %v\n", err)
+	}
+
+	return &config.BatchConfig{MaxOperations: maxOperations}
+}
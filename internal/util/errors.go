@@ -5,6 +5,123 @@ const (
 	Forbidden       = "Недостаточно прав для выполнения действия."
 	NotFound        = "Тендер или предложение не найдено."
 	VersionNotFound = "Версия не найдена."
+
+	AttachmentNotFound = "Вложение не найдено."
+	AttachmentTooLarge = "Размер вложения превышает допустимый."
+	AttachmentBadType  = "Недопустимый тип вложения."
+
+	ClarificationNotFound = "Вопрос не найден."
+	AlreadyAnswered       = "На вопрос уже дан ответ."
+	TenderNotPublished    = "Тендер не опубликован."
+
+	LotNotFound      = "Лот не найден."
+	LotNotOpen       = "Лот уже решён или не относится к тендеру."
+	BidNotInLot      = "Предложение не подано на этот лот."
+	BidNotPublished  = "Предложение не опубликовано."
+	LotsNotResolved  = "Тендер нельзя закрыть, пока не решены все лоты."
+	LotsDecidedLater = "Нельзя откатиться к версии, в которой лоты ещё не были решены."
+	TenderClosed     = "Тендер закрыт."
+
+	InvalidCriteria = "Критерии оценки заданы некорректно."
+	CriteriaLocked  = "Критерии нельзя менять после начала оценки."
+	InvalidScore    = "Оценка не соответствует критериям тендера."
+
+	InvalidOpeningTime = "Для запечатанного тендера нужно время вскрытия в будущем."
+	BiddingClosed      = "Приём запечатанных предложений завершён."
+	BidSealed          = "Предложение запечатано до вскрытия."
+	SealedVersion      = "Нельзя откатиться к версии, сохранённой до вскрытия."
+	TenderNotSealed    = "Тендер не в режиме запечатанных предложений."
+	OpeningNotDue      = "Время вскрытия ещё не наступило."
+	BidsAlreadyOpened  = "Предложения уже вскрыты."
+	BidsNotOpened      = "Предложения ещё не вскрыты."
+
+	InvalidAuction    = "Параметры аукциона заданы некорректно."
+	AuctionNotAllowed = "Аукцион доступен только для тендеров вида Delivery без запечатанных предложений и конвертов."
+	AuctionNotFound   = "Аукцион не найден."
+	AuctionStarted    = "Аукцион уже начался."
+	AuctionNotRunning = "Аукцион не идёт."
+	PriceTooHigh      = "Цена должна быть ниже текущей лучшей не меньше чем на шаг аукциона."
+	NotAParticipant   = "Пользователь не участвует в тендере."
+
+	InvalidTender        = "Параметры тендера заданы некорректно."
+	InvalidTenderMode    = "Тендер не может быть одновременно запечатанным и двухконвертным."
+	InvalidStatusChange  = "Недопустимый переход статуса тендера."
+	TenderInEvaluation   = "Тендер на этапе оценки, предложения нельзя подавать и менять."
+	CommercialHidden     = "Коммерческая часть предложения ещё не раскрыта."
+	QualificationNotOpen = "Квалификация возможна только на этапе технической оценки."
+	BidsNotQualified     = "Не по всем предложениям принято решение о допуске."
+
+	OrganizationNotFound = "Организация не найдена."
+	TenderNotPrivate     = "Тендер не закрытый, приглашения не требуются."
+	InvitationNotFound   = "Приглашение не найдено."
+	InvitationAnswered   = "На приглашение уже дан ответ."
+	NotInvited           = "Организация не приглашена к участию в тендере."
+
+	InvalidEligibility  = "Требования к поставщикам заданы некорректно."
+	NotEligible         = "Организация не соответствует требованиям тендера"
+	NoOrganization      = "Пользователь не является Ответственным ни одной организации."
+	CertificateNotFound = "Сертификат не найден."
+	InvalidCertificate  = "Сертификат задан некорректно."
+
+	InvalidDebarment  = "Отстранение задано некорректно."
+	DebarmentNotFound = "Действующее отстранение не найдено."
+	SupplierDebarred  = "Поставщик отстранён от участия"
+
+	InvalidRating = "Оценка должна быть от 1 до 5."
+
+	ReviewNotFound   = "Отзыв не найден."
+	ReviewHidden     = "Отзыв скрыт модератором."
+	ReviewNotHidden  = "Отзыв не скрыт."
+	ReplyExists      = "На отзыв уже дан ответ."
+	EditWindowClosed = "Срок редактирования отзыва истёк."
+	InvalidReview    = "Отзыв задан некорректно."
+	AuthorHasNoBid   = "Автор не подавал предложений на этот тендер."
+
+	ContractNotFound       = "Контракт не найден."
+	ContractExists         = "Контракт по этому предложению уже заключён."
+	BidNotWon              = "Контракт заключается только по выигравшему предложению."
+	ContractNotActive      = "Контракт уже закрыт."
+	InvalidContractClose   = "Контракт закрывается со статусом Completed или Terminated и отзывом."
+	MilestoneNotFound      = "Этап контракта не найден."
+	InvalidMilestone       = "Этап контракта задан некорректно."
+	MilestonesExceedAmount = "Сумма этапов превышает сумму контракта."
+	InvalidMilestoneStatus = "Недопустимый переход статуса этапа."
+	MilestonesNotAccepted  = "Контракт нельзя завершить, пока не приняты все этапы."
+	NoAcceptedMilestones   = "Контракт нельзя завершить без принятых этапов."
+
+	NegotiationClosed    = "Переговоры невозможны: решение по предложению принято, тендер закрыт или идёт аукцион."
+	NegotiationExhausted = "Исчерпано число раундов переговоров."
+	NegotiationRoundOpen = "Предыдущий раунд переговоров ещё не завершён."
+	NoOpenRound          = "Нет открытого раунда переговоров."
+	InvalidNegotiation   = "Условия переговоров заданы некорректно."
+	OwnRound             = "Нельзя отвечать на собственный раунд переговоров."
+
+	TemplateNotFound = "Шаблон тендера не найден."
+	InvalidTemplate  = "Шаблон тендера задан некорректно."
+	TenderNotClosed  = "Копировать можно только закрытый тендер."
+
+	DraftNotFound = "Черновик не найден."
+	EmptyDraft    = "Черновик не содержит изменений."
+	InvalidDraft  = "Неизвестный вид услуги в черновике."
+	DraftOutdated = "После сохранения черновика появилась новая версия. Сохраните черновик заново."
+
+	InvalidBatch   = "Пакет операций пуст или превышает допустимый размер."
+	InvalidBatchOp = "Операция пакета задана некорректно."
+	BatchAborted   = "Операция отменена: в атомарном пакете произошла ошибка."
+
+	InvalidExportFormat = "Неизвестный формат выгрузки."
+
+	InvalidImportFormat = "Неизвестный формат импорта."
+	InvalidImport       = "Файл импорта не удалось разобрать."
+	InvalidImportRow    = "Строку импорта не удалось разобрать"
+
+	InvalidUser         = "Имя пользователя задано некорректно."
+	UserExists          = "Пользователь уже существует."
+	InvalidOrganization = "Параметры организации заданы некорректно."
+	OrganizationExists  = "Организация уже существует."
+	AlreadyResponsible  = "Пользователь уже является Ответственным организации."
+
+	SchemaTooNew = "Схема базы новее миграций приложения."
 )
 
 type MalformedRequestError struct {
@@ -23,4 +140,4 @@ type MyResponseError struct {
 
 func (er MyResponseError) Error() string {
 	return er.Msg
-}
\ No newline at end of file
+}
@@ -0,0 +1,208 @@
+package util
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"zadanie-6105/internal/models"
+)
+
+// TableWriter Построчная запись выгрузки. Close дописывает файл, но не закрывает нижележащий io.Writer.
+type TableWriter interface {
+	WriteRow(values []any) error
+	Close() error
+}
+
+func NewTableWriter(w io.Writer, format models.ExportFormat) (TableWriter, error) {
+	switch format {
+	case models.ExportCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case models.ExportXLSX:
+		return newXLSXWriter(w)
+	}
+
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+// formatCell Значение ячейки строкой. numeric сообщает, что в XLSX его можно записать числом.
+func formatCell(v any) (value string, numeric bool) {
+	switch v := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, false
+	case *string:
+		if v == nil {
+			return "", false
+		}
+		return *v, false
+	case int:
+		return strconv.Itoa(v), true
+	case *int:
+		if v == nil {
+			return "", false
+		}
+		return strconv.Itoa(*v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case *float64:
+		if v == nil {
+			return "", false
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), false
+	case *bool:
+		if v == nil {
+			return "", false
+		}
+		return strconv.FormatBool(*v), false
+	case time.Time:
+		return v.Format(time.RFC3339), false
+	case *time.Time:
+		if v == nil {
+			return "", false
+		}
+		return v.Format(time.RFC3339), false
+	case fmt.Stringer:
+		return v.String(), false
+	}
+
+	return fmt.Sprint(v), false
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// WriteRow csv.Writer буферизует вывод и сам сбрасывает его клиенту по мере заполнения буфера.
+func (c *csvWriter) WriteRow(values []any) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		var numeric bool
+		record[i], numeric = formatCell(v)
+		if !numeric {
+			record[i] = neutralizeFormula(record[i])
+		}
+	}
+
+	return c.w.Write(record)
+}
+
+// neutralizeFormula Текст участников, начинающийся с =, +, -, @ или управляющего символа, табличный
+// редактор откроет как формулу. Апостроф перед таким значением заставляет считать его текстом.
+// В XLSX строки пишутся как текст и формулами не становятся.
+func neutralizeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// Минимальная книга XLSX из одного листа. Строки пишутся в лист сразу, без общей таблицы строк,
+// поэтому размер выгрузки не ограничен памятью.
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Export" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd = `</sheetData></worksheet>`
+)
+
+type xlsxWriter struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	const op = "util.newXLSXWriter"
+
+	zw := zip.NewWriter(w)
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err = io.WriteString(f, part.body); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	sheet := bufio.NewWriter(f)
+	if _, err = sheet.WriteString(xlsxSheetStart); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &xlsxWriter{zw: zw, sheet: sheet}, nil
+}
+
+func (x *xlsxWriter) WriteRow(values []any) error {
+	x.sheet.WriteString("<row>")
+	for _, v := range values {
+		value, numeric := formatCell(v)
+		switch {
+		case value == "":
+			x.sheet.WriteString("<c/>")
+		case numeric:
+			x.sheet.WriteString("<c><v>" + value + "</v></c>")
+		default:
+			x.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+			if err := xml.EscapeText(x.sheet, []byte(value)); err != nil {
+				return err
+			}
+			x.sheet.WriteString("</t></is></c>")
+		}
+	}
+	_, err := x.sheet.WriteString("</row>")
+	return err
+}
+
+func (x *xlsxWriter) Close() error {
+	if _, err := x.sheet.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+
+	return x.zw.Close()
+}
@@ -0,0 +1,224 @@
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"zadanie-6105/internal/models"
+)
+
+// maxImportLine Предел длины строки NDJSON.
+const maxImportLine = 1 << 20
+
+// tenderRecord Строка импорта тендера. Колонки CSV и поля NDJSON называются так же, как в API.
+type tenderRecord struct {
+	Name           string     `json:"name"`
+	Description    string     `json:"description"`
+	ServiceType    string     `json:"serviceType"`
+	Status         string     `json:"status"`
+	OrganizationID string     `json:"organizationId"`
+	Sealed         bool       `json:"sealed"`
+	OpeningAt      *time.Time `json:"openingAt"`
+	TwoEnvelope    bool       `json:"twoEnvelope"`
+	Private        bool       `json:"private"`
+}
+
+var tenderRecordColumns = map[string]bool{
+	"name": true, "description": false, "serviceType": true, "status": true, "organizationId": true,
+	"sealed": false, "openingAt": false, "twoEnvelope": false, "private": false,
+}
+
+func (t *tenderRecord) tender() (*models.Tender, error) {
+	if t.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	organizationID, err := uuid.Parse(t.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("organizationId: %w", err)
+	}
+
+	return &models.Tender{
+		Name:           t.Name,
+		Description:    t.Description,
+		ServiceType:    models.ServiceType(t.ServiceType),
+		Status:         models.TenderStatus(t.Status),
+		OrganizationID: organizationID,
+		Sealed:         t.Sealed,
+		OpeningAt:      t.OpeningAt,
+		TwoEnvelope:    t.TwoEnvelope,
+		Private:        t.Private,
+	}, nil
+}
+
+// ReadTenders Читает файл импорта построчно и передаёт каждую строку в fn вместе с номером строки.
+// Ошибка разбора строки передаётся в fn и не прерывает чтение. Возвращается ошибка всего файла
+// (неизвестный формат, некорректный заголовок CSV) или ошибка fn.
+func ReadTenders(src io.Reader, format models.ImportFormat, fn func(line int, tender *models.Tender, err error) error) error {
+	switch format {
+	case models.ImportCSV:
+		return readTendersCSV(src, fn)
+	case models.ImportNDJSON:
+		return readTendersNDJSON(src, fn)
+	}
+
+	return MyResponseError{Status: http.StatusBadRequest, Msg: InvalidImportFormat}
+}
+
+func readTendersCSV(src io.Reader, fn func(line int, tender *models.Tender, err error) error) error {
+	r := csv.NewReader(src)
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return MyResponseError{Status: http.StatusBadRequest, Msg: InvalidImport}
+	}
+
+	seen := make(map[string]bool, len(header))
+	for _, column := range header {
+		if _, ok := tenderRecordColumns[column]; !ok || seen[column] {
+			return MyResponseError{Status: http.StatusBadRequest, Msg: InvalidImport}
+		}
+		seen[column] = true
+	}
+	for column, required := range tenderRecordColumns {
+		if required && !seen[column] {
+			return MyResponseError{Status: http.StatusBadRequest, Msg: InvalidImport}
+		}
+	}
+
+	for {
+		values, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		var line int
+		var tender *models.Tender
+		var parseErr *csv.ParseError
+		switch {
+		case err == nil:
+			line, _ = r.FieldPos(0)
+			tender, err = csvTender(header, values)
+		case errors.As(err, &parseErr):
+			line = parseErr.Line
+		default:
+			return fmt.Errorf("util.ReadTenders: %w", err)
+		}
+		if err = fn(line, tender, err); err != nil {
+			return err
+		}
+	}
+}
+
+func csvTender(header, values []string) (*models.Tender, error) {
+	var record tenderRecord
+	for i, column := range header {
+		value := strings.TrimSpace(values[i])
+		var err error
+		switch column {
+		case "name":
+			record.Name = value
+		case "description":
+			record.Description = value
+		case "serviceType":
+			record.ServiceType = value
+		case "status":
+			record.Status = value
+		case "organizationId":
+			record.OrganizationID = value
+		case "sealed":
+			record.Sealed, err = parseImportBool(value)
+		case "twoEnvelope":
+			record.TwoEnvelope, err = parseImportBool(value)
+		case "private":
+			record.Private, err = parseImportBool(value)
+		case "openingAt":
+			if value != "" {
+				var openingAt time.Time
+				openingAt, err = time.Parse(time.RFC3339, value)
+				record.OpeningAt = &openingAt
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", column, err)
+		}
+	}
+
+	return record.tender()
+}
+
+// parseImportBool Пустая ячейка означает false.
+func parseImportBool(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+func readTendersNDJSON(src io.Reader, fn func(line int, tender *models.Tender, err error) error) error {
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLine)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+
+		var record tenderRecord
+		var tender *models.Tender
+		err := dec.Decode(&record)
+		if err == nil {
+			tender, err = record.tender()
+		}
+		if err = fn(line, tender, err); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return MyResponseError{Status: http.StatusBadRequest, Msg: InvalidImport}
+	}
+
+	return nil
+}
+
+// WriteImportReport Пишет отчёт об импорте таблицей, по строке на строку импорта.
+func WriteImportReport(w io.Writer, report *models.ImportReport, format models.ExportFormat) error {
+	tw, err := NewTableWriter(w, format)
+	if err != nil {
+		return err
+	}
+
+	header := make([]any, len(models.ImportReportHeader))
+	for i, h := range models.ImportReportHeader {
+		header[i] = h
+	}
+	if err = tw.WriteRow(header); err != nil {
+		return err
+	}
+
+	for i := range report.Rows {
+		if err = tw.WriteRow(report.Rows[i].ExportRow()); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
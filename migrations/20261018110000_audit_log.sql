@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_log (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID UNIQUE NOT NULL,
+    actor VARCHAR(50) NOT NULL,
+    organization_id UUID,
+    request_id VARCHAR(100) NOT NULL,
+    client_ip VARCHAR(64) NOT NULL,
+    action VARCHAR(200) NOT NULL,
+    target VARCHAR(100),
+    outcome VARCHAR(20) NOT NULL,
+    status INT NOT NULL,
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) UNIQUE NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX audit_log_organization_idx ON audit_log (organization_id, seq);
+
+CREATE OR REPLACE FUNCTION forbid_audit_log_change()
+    RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only_trigger
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW
+EXECUTE FUNCTION forbid_audit_log_change();
+
+CREATE TRIGGER audit_log_no_truncate_trigger
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT
+EXECUTE FUNCTION forbid_audit_log_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS audit_log_no_truncate_trigger ON audit_log;
+DROP TRIGGER IF EXISTS audit_log_append_only_trigger ON audit_log;
+DROP FUNCTION IF EXISTS forbid_audit_log_change();
+DROP TABLE IF EXISTS audit_log;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE attachment_entity AS ENUM (
+    'Tender',
+    'Bid'
+);
+
+CREATE TABLE attachment (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type attachment_entity NOT NULL,
+    entity_id UUID NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    storage_key VARCHAR(100) NOT NULL,
+    uploaded_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    version_from INT NOT NULL,
+    version_to INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (version_to IS NULL OR version_to > version_from)
+);
+
+CREATE INDEX attachment_entity_idx ON attachment (entity_type, entity_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS attachment;
+DROP TYPE IF EXISTS attachment_entity;
+-- +goose StatementEnd
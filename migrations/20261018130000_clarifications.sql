@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE clarification_visibility AS ENUM (
+    'Private',
+    'Public'
+);
+
+CREATE TABLE clarification (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    author_username VARCHAR(50) REFERENCES employee(username) ON DELETE CASCADE,
+    question TEXT NOT NULL,
+    answer TEXT,
+    answered_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    visibility clarification_visibility DEFAULT 'Private',
+    tender_version INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    answered_at TIMESTAMP
+);
+
+CREATE INDEX clarification_tender_idx ON clarification (tender_id, created_at);
+
+-- Версия тендера, на которую было подано предложение. Нужна, чтобы отличать
+-- предложения, поданные до опубликованных разъяснений.
+ALTER TABLE bid ADD COLUMN tender_version INT;
+
+UPDATE bid b
+SET tender_version = t.version
+FROM tender t
+WHERE b.tender_id = t.id;
+
+CREATE OR REPLACE FUNCTION set_bid_tender_version()
+    RETURNS TRIGGER AS $$
+BEGIN
+    SELECT version INTO NEW.tender_version
+    FROM tender
+    WHERE id = NEW.tender_id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER set_bid_tender_version_trigger
+    BEFORE INSERT ON bid
+    FOR EACH ROW
+EXECUTE FUNCTION set_bid_tender_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS set_bid_tender_version_trigger ON bid;
+DROP FUNCTION IF EXISTS set_bid_tender_version();
+ALTER TABLE bid DROP COLUMN IF EXISTS tender_version;
+
+DROP TABLE IF EXISTS clarification;
+DROP TYPE IF EXISTS clarification_visibility;
+-- +goose StatementEnd
@@ -0,0 +1,89 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE lot_status AS ENUM (
+    'Open',
+    'Awarded',
+    'Cancelled'
+);
+
+CREATE TABLE lot (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    service_type service_type NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    budget NUMERIC(15, 2) NOT NULL CHECK (budget >= 0),
+    status lot_status DEFAULT 'Open',
+    winning_bid_id UUID REFERENCES bid(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX lot_tender_idx ON lot (tender_id);
+
+-- Снимки лотов привязаны к версии тендера, в которой они сделаны.
+CREATE TABLE lot_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    lot_id UUID REFERENCES lot(id) ON DELETE CASCADE,
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    tender_version INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    service_type service_type NOT NULL,
+    quantity INT NOT NULL,
+    budget NUMERIC(15, 2) NOT NULL,
+    status lot_status NOT NULL,
+    winning_bid_id UUID,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX lot_history_tender_idx ON lot_history (tender_id, tender_version);
+
+CREATE TABLE bid_lot (
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    lot_id UUID REFERENCES lot(id) ON DELETE CASCADE,
+    PRIMARY KEY (bid_id, lot_id)
+);
+
+CREATE OR REPLACE FUNCTION update_lot_metadata()
+    RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at := NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER lot_metadata_trigger
+    BEFORE UPDATE ON lot
+    FOR EACH ROW
+EXECUTE FUNCTION update_lot_metadata();
+
+CREATE OR REPLACE FUNCTION save_lot_to_history()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO lot_history (lot_id, tender_id, tender_version, name, service_type, quantity, budget, status, winning_bid_id, created_at, updated_at)
+    SELECT NEW.id, NEW.tender_id, t.version, NEW.name, NEW.service_type, NEW.quantity, NEW.budget, NEW.status, NEW.winning_bid_id, NEW.created_at, NEW.updated_at
+    FROM tender t
+    WHERE t.id = NEW.tender_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER lot_insert_update_trigger
+    AFTER INSERT OR UPDATE ON lot
+    FOR EACH ROW
+EXECUTE FUNCTION save_lot_to_history();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS lot_insert_update_trigger ON lot;
+DROP FUNCTION IF EXISTS save_lot_to_history();
+DROP TRIGGER IF EXISTS lot_metadata_trigger ON lot;
+DROP FUNCTION IF EXISTS update_lot_metadata();
+
+DROP TABLE IF EXISTS bid_lot;
+DROP TABLE IF EXISTS lot_history;
+DROP TABLE IF EXISTS lot;
+DROP TYPE IF EXISTS lot_status;
+-- +goose StatementEnd
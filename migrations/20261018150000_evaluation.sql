@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evaluation_criterion (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    weight NUMERIC(6, 2) NOT NULL CHECK (weight > 0),
+    max_score INT NOT NULL DEFAULT 10 CHECK (max_score > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (tender_id, name)
+);
+
+CREATE TABLE bid_score (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    criterion_id UUID REFERENCES evaluation_criterion(id) ON DELETE CASCADE,
+    scorer_username VARCHAR(50) REFERENCES employee(username) ON DELETE CASCADE,
+    score INT NOT NULL CHECK (score >= 0),
+    comment TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (bid_id, criterion_id, scorer_username)
+);
+
+-- Оценки на момент решения. Хранятся отдельно, чтобы последующие правки оценок
+-- не меняли обоснование уже принятого решения.
+CREATE TABLE bid_decision_record (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    decision bid_decision NOT NULL,
+    username VARCHAR(50) REFERENCES employee(username) ON DELETE CASCADE,
+    scores JSONB NOT NULL DEFAULT '[]',
+    weighted_total NUMERIC(6, 2),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX bid_decision_record_bid_idx ON bid_decision_record (bid_id, created_at);
+
+-- Взвешенная оценка предложения по шкале от 0 до 100. Оценки разных Ответственных
+-- по критерию усредняются, неоценённые критерии дают 0. NULL, если у тендера нет критериев.
+CREATE OR REPLACE FUNCTION bid_weighted_total(p_bid_id UUID)
+    RETURNS NUMERIC AS $$
+    SELECT ROUND(
+        COALESCE(SUM(c.weight * a.avg_score / c.max_score), 0) * 100 / NULLIF(
+            (SELECT SUM(weight) FROM evaluation_criterion WHERE tender_id = (SELECT tender_id FROM bid WHERE id = p_bid_id)),
+            0),
+        2)
+    FROM (
+        SELECT criterion_id, AVG(score) AS avg_score
+        FROM bid_score
+        WHERE bid_id = p_bid_id
+        GROUP BY criterion_id
+    ) a
+    JOIN evaluation_criterion c ON c.id = a.criterion_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS bid_weighted_total(UUID);
+DROP TABLE IF EXISTS bid_decision_record;
+DROP TABLE IF EXISTS bid_score;
+DROP TABLE IF EXISTS evaluation_criterion;
+-- +goose StatementEnd
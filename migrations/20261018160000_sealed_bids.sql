@@ -0,0 +1,112 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tender ADD COLUMN sealed BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE tender ADD COLUMN opening_at TIMESTAMP;
+
+ALTER TABLE bid ADD COLUMN price NUMERIC(14, 2);
+ALTER TABLE bid_history ADD COLUMN price NUMERIC(14, 2);
+
+-- Название, описание и цена запечатанного предложения хранятся только в зашифрованном виде
+-- (AES-GCM), открытые поля до вскрытия пустые.
+ALTER TABLE bid ADD COLUMN sealed_payload BYTEA;
+
+CREATE TABLE bid_opening (
+    tender_id UUID PRIMARY KEY REFERENCES tender(id) ON DELETE CASCADE,
+    opened_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    opened_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    bids_count INT NOT NULL
+);
+
+CREATE OR REPLACE FUNCTION save_bid_to_history()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO bid_history (bid_id, name, description, feedback, status, tender_id, organization_id, decision, author_id, author_type, version, price, created_at, updated_at)
+    VALUES (NEW.id, NEW.name, NEW.description, NEW.feedback, NEW.status, NEW.tender_id, NEW.organization_id, NEW.decision, NEW.author_id, NEW.author_type, NEW.version, NEW.price, NEW.created_at, NEW.updated_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION rollback_bid_version(bidId UUID, rollback_version INT, username VARCHAR)
+    RETURNS TABLE (id UUID, name VARCHAR(100), description TEXT, feedback TEXT, status bid_status, tender_id UUID, organization_id UUID, decision bid_decision, author_id UUID, author_type author_type_enum, version INT, created_at TIMESTAMP, updated_at TIMESTAMP) AS $$
+DECLARE
+    bid_record bid_history%ROWTYPE;
+BEGIN
+    SELECT * INTO bid_record
+    FROM bid_history
+    WHERE bid_history.bid_id = bidId AND bid_history.version = rollback_version;
+
+    UPDATE bid
+    SET
+        name = bid_record.name,
+        description = bid_record.description,
+        feedback = bid_record.feedback,
+        status = bid_record.status,
+        tender_id = bid_record.tender_id,
+        organization_id = bid_record.organization_id,
+        decision = bid_record.decision,
+        author_id = bid_record.author_id,
+        author_username = username,
+        author_type = bid_record.author_type,
+        price = bid_record.price,
+        updated_at = CURRENT_TIMESTAMP,
+        version = bid_record.version + 1
+    WHERE bid.id = bidId;
+
+    RETURN QUERY
+        SELECT bid.id, bid.name, bid.description, bid.feedback, bid.status, bid.tender_id, bid.organization_id, bid.decision, bid.author_id, bid.author_type, bid.version, bid.created_at, bid.updated_at
+        FROM bid
+        WHERE bid.id = bidId;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION rollback_bid_version(bidId UUID, rollback_version INT, username VARCHAR)
+    RETURNS TABLE (id UUID, name VARCHAR(100), description TEXT, feedback TEXT, status bid_status, tender_id UUID, organization_id UUID, decision bid_decision, author_id UUID, author_type author_type_enum, version INT, created_at TIMESTAMP, updated_at TIMESTAMP) AS $$
+DECLARE
+    bid_record bid_history%ROWTYPE;
+BEGIN
+    SELECT * INTO bid_record
+    FROM bid_history
+    WHERE bid_history.bid_id = bidId AND bid_history.version = rollback_version;
+
+    UPDATE bid
+    SET
+        name = bid_record.name,
+        description = bid_record.description,
+        feedback = bid_record.feedback,
+        status = bid_record.status,
+        tender_id = bid_record.tender_id,
+        organization_id = bid_record.organization_id,
+        decision = bid_record.decision,
+        author_id = bid_record.author_id,
+        author_username = username,
+        author_type = bid_record.author_type,
+        updated_at = CURRENT_TIMESTAMP,
+        version = bid_record.version + 1
+    WHERE bid.id = bidId;
+
+    RETURN QUERY
+        SELECT bid.id, bid.name, bid.description, bid.feedback, bid.status, bid.tender_id, bid.organization_id, bid.decision, bid.author_id, bid.author_type, bid.version, bid.created_at, bid.updated_at
+        FROM bid
+        WHERE bid.id = bidId;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION save_bid_to_history()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO bid_history (bid_id, name, description, feedback, status, tender_id, organization_id, decision, author_id, author_type, version, created_at, updated_at)
+    VALUES (NEW.id, NEW.name, NEW.description, NEW.feedback, NEW.status, NEW.tender_id, NEW.organization_id, NEW.decision, NEW.author_id, NEW.author_type, NEW.version, NEW.created_at, NEW.updated_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS bid_opening;
+ALTER TABLE bid DROP COLUMN IF EXISTS sealed_payload;
+ALTER TABLE bid_history DROP COLUMN IF EXISTS price;
+ALTER TABLE bid DROP COLUMN IF EXISTS price;
+ALTER TABLE tender DROP COLUMN IF EXISTS opening_at;
+ALTER TABLE tender DROP COLUMN IF EXISTS sealed;
+-- +goose StatementEnd
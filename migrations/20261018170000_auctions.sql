@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auction (
+    tender_id UUID PRIMARY KEY REFERENCES tender(id) ON DELETE CASCADE,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    duration_seconds INT NOT NULL CHECK (duration_seconds > 0),
+    extension_seconds INT NOT NULL DEFAULT 0 CHECK (extension_seconds >= 0),
+    min_step NUMERIC(14, 2) NOT NULL CHECK (min_step > 0),
+    start_price NUMERIC(14, 2),
+    winner_bid_id UUID REFERENCES bid(id) ON DELETE SET NULL,
+    closed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX auction_due_idx ON auction (ends_at) WHERE closed_at IS NULL;
+
+CREATE TABLE auction_price (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tender_id UUID REFERENCES auction(tender_id) ON DELETE CASCADE,
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    price NUMERIC(14, 2) NOT NULL CHECK (price > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX auction_price_tender_idx ON auction_price (tender_id, price, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS auction_price;
+DROP TABLE IF EXISTS auction;
+-- +goose StatementEnd
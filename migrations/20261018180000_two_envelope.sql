@@ -0,0 +1,126 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Этапы двухконвертного тендера между Published и Closed: сначала оценивается
+-- техническая часть предложений, затем раскрываются коммерческие части допущенных.
+ALTER TYPE tender_status ADD VALUE IF NOT EXISTS 'TechnicalEvaluation' AFTER 'Published';
+ALTER TYPE tender_status ADD VALUE IF NOT EXISTS 'CommercialEvaluation' AFTER 'TechnicalEvaluation';
+
+ALTER TABLE tender ADD COLUMN two_envelope BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE bid ADD COLUMN technical_proposal TEXT;
+ALTER TABLE bid ADD COLUMN commercial_proposal TEXT;
+ALTER TABLE bid_history ADD COLUMN technical_proposal TEXT;
+ALTER TABLE bid_history ADD COLUMN commercial_proposal TEXT;
+
+CREATE TYPE bid_qualification AS ENUM (
+    'Qualified',
+    'Disqualified'
+);
+
+-- Квалификация хранится отдельно от предложения, чтобы не создавать новую версию предложения.
+CREATE TABLE bid_qualification_record (
+    bid_id UUID PRIMARY KEY REFERENCES bid(id) ON DELETE CASCADE,
+    qualification bid_qualification NOT NULL,
+    reason TEXT,
+    username VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE OR REPLACE FUNCTION save_bid_to_history()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO bid_history (bid_id, name, description, feedback, status, tender_id, organization_id, decision, author_id, author_type, version, price, technical_proposal, commercial_proposal, created_at, updated_at)
+    VALUES (NEW.id, NEW.name, NEW.description, NEW.feedback, NEW.status, NEW.tender_id, NEW.organization_id, NEW.decision, NEW.author_id, NEW.author_type, NEW.version, NEW.price, NEW.technical_proposal, NEW.commercial_proposal, NEW.created_at, NEW.updated_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION rollback_bid_version(bidId UUID, rollback_version INT, username VARCHAR)
+    RETURNS TABLE (id UUID, name VARCHAR(100), description TEXT, feedback TEXT, status bid_status, tender_id UUID, organization_id UUID, decision bid_decision, author_id UUID, author_type author_type_enum, version INT, created_at TIMESTAMP, updated_at TIMESTAMP) AS $$
+DECLARE
+    bid_record bid_history%ROWTYPE;
+BEGIN
+    SELECT * INTO bid_record
+    FROM bid_history
+    WHERE bid_history.bid_id = bidId AND bid_history.version = rollback_version;
+
+    UPDATE bid
+    SET
+        name = bid_record.name,
+        description = bid_record.description,
+        feedback = bid_record.feedback,
+        status = bid_record.status,
+        tender_id = bid_record.tender_id,
+        organization_id = bid_record.organization_id,
+        decision = bid_record.decision,
+        author_id = bid_record.author_id,
+        author_username = username,
+        author_type = bid_record.author_type,
+        price = bid_record.price,
+        technical_proposal = bid_record.technical_proposal,
+        commercial_proposal = bid_record.commercial_proposal,
+        updated_at = CURRENT_TIMESTAMP,
+        version = bid_record.version + 1
+    WHERE bid.id = bidId;
+
+    RETURN QUERY
+        SELECT bid.id, bid.name, bid.description, bid.feedback, bid.status, bid.tender_id, bid.organization_id, bid.decision, bid.author_id, bid.author_type, bid.version, bid.created_at, bid.updated_at
+        FROM bid
+        WHERE bid.id = bidId;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION rollback_bid_version(bidId UUID, rollback_version INT, username VARCHAR)
+    RETURNS TABLE (id UUID, name VARCHAR(100), description TEXT, feedback TEXT, status bid_status, tender_id UUID, organization_id UUID, decision bid_decision, author_id UUID, author_type author_type_enum, version INT, created_at TIMESTAMP, updated_at TIMESTAMP) AS $$
+DECLARE
+    bid_record bid_history%ROWTYPE;
+BEGIN
+    SELECT * INTO bid_record
+    FROM bid_history
+    WHERE bid_history.bid_id = bidId AND bid_history.version = rollback_version;
+
+    UPDATE bid
+    SET
+        name = bid_record.name,
+        description = bid_record.description,
+        feedback = bid_record.feedback,
+        status = bid_record.status,
+        tender_id = bid_record.tender_id,
+        organization_id = bid_record.organization_id,
+        decision = bid_record.decision,
+        author_id = bid_record.author_id,
+        author_username = username,
+        author_type = bid_record.author_type,
+        price = bid_record.price,
+        updated_at = CURRENT_TIMESTAMP,
+        version = bid_record.version + 1
+    WHERE bid.id = bidId;
+
+    RETURN QUERY
+        SELECT bid.id, bid.name, bid.description, bid.feedback, bid.status, bid.tender_id, bid.organization_id, bid.decision, bid.author_id, bid.author_type, bid.version, bid.created_at, bid.updated_at
+        FROM bid
+        WHERE bid.id = bidId;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION save_bid_to_history()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO bid_history (bid_id, name, description, feedback, status, tender_id, organization_id, decision, author_id, author_type, version, price, created_at, updated_at)
+    VALUES (NEW.id, NEW.name, NEW.description, NEW.feedback, NEW.status, NEW.tender_id, NEW.organization_id, NEW.decision, NEW.author_id, NEW.author_type, NEW.version, NEW.price, NEW.created_at, NEW.updated_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS bid_qualification_record;
+DROP TYPE IF EXISTS bid_qualification;
+ALTER TABLE bid_history DROP COLUMN IF EXISTS commercial_proposal;
+ALTER TABLE bid_history DROP COLUMN IF EXISTS technical_proposal;
+ALTER TABLE bid DROP COLUMN IF EXISTS commercial_proposal;
+ALTER TABLE bid DROP COLUMN IF EXISTS technical_proposal;
+ALTER TABLE tender DROP COLUMN IF EXISTS two_envelope;
+-- Значения tender_status не удаляются: PostgreSQL не поддерживает удаление значений enum.
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tender ADD COLUMN private BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TYPE invitation_status AS ENUM (
+    'Pending',
+    'Accepted',
+    'Declined'
+);
+
+CREATE TABLE tender_invitation (
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    status invitation_status NOT NULL DEFAULT 'Pending',
+    invited_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    responded_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    responded_at TIMESTAMP,
+    PRIMARY KEY (tender_id, organization_id)
+);
+
+CREATE INDEX tender_invitation_organization_idx ON tender_invitation (organization_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tender_invitation;
+DROP TYPE IF EXISTS invitation_status;
+ALTER TABLE tender DROP COLUMN IF EXISTS private;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE organization_certificate (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    valid_until DATE,
+    created_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    verified_by VARCHAR(50),
+    verified_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX organization_certificate_organization_idx ON organization_certificate (organization_id, name);
+
+-- Требования к поставщикам. Пустой список типов или NULL в сертификате означают,
+-- что соответствующее требование не задано.
+CREATE TABLE tender_eligibility (
+    tender_id UUID PRIMARY KEY REFERENCES tender(id) ON DELETE CASCADE,
+    organization_types VARCHAR(10)[] NOT NULL DEFAULT '{}',
+    min_completed_contracts INT NOT NULL DEFAULT 0 CHECK (min_completed_contracts >= 0),
+    certificate VARCHAR(100),
+    updated_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tender_eligibility;
+DROP TABLE IF EXISTS organization_certificate;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Отстранение поставщика: organization_id - организация-заказчик, NULL - отстранение на всей площадке.
+-- Отстраняется организация поставщика, конкретный сотрудник или оба сразу.
+CREATE TABLE debarment (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    supplier_organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    supplier_username VARCHAR(50) REFERENCES employee(username) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    approved_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    lifted_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    lifted_at TIMESTAMP,
+    expired_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (supplier_organization_id IS NOT NULL OR supplier_username IS NOT NULL),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX debarment_supplier_organization_idx ON debarment (supplier_organization_id) WHERE lifted_at IS NULL;
+CREATE INDEX debarment_supplier_username_idx ON debarment (supplier_username) WHERE lifted_at IS NULL;
+CREATE INDEX debarment_due_idx ON debarment (ends_at) WHERE lifted_at IS NULL AND expired_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS debarment;
+-- +goose StatementEnd
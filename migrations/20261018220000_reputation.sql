@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Оценка от 1 до 5 и итог исполнения. У отзывов, оставленных до появления оценок, они пустые.
+ALTER TABLE review ADD COLUMN rating SMALLINT CHECK (rating BETWEEN 1 AND 5);
+ALTER TABLE review ADD COLUMN completed BOOLEAN;
+
+CREATE INDEX review_bid_idx ON review (bid_id);
+CREATE INDEX bid_author_idx ON bid (author_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS bid_author_idx;
+DROP INDEX IF EXISTS review_bid_idx;
+ALTER TABLE review DROP COLUMN IF EXISTS completed;
+ALTER TABLE review DROP COLUMN IF EXISTS rating;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Один публичный ответ автора предложения, правки автора отзыва и скрытие модератором площадки.
+ALTER TABLE review ADD COLUMN reply TEXT;
+ALTER TABLE review ADD COLUMN reply_author_username VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL;
+ALTER TABLE review ADD COLUMN replied_at TIMESTAMP;
+ALTER TABLE review ADD COLUMN updated_at TIMESTAMP;
+ALTER TABLE review ADD COLUMN hidden_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL;
+ALTER TABLE review ADD COLUMN hidden_reason TEXT;
+ALTER TABLE review ADD COLUMN hidden_at TIMESTAMP;
+
+-- Предыдущие редакции отзыва, по одной строке на каждую правку.
+CREATE TABLE review_revision (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    review_id UUID NOT NULL REFERENCES review(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    rating SMALLINT,
+    completed BOOLEAN,
+    edited_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX review_revision_review_idx ON review_revision (review_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS review_revision;
+ALTER TABLE review DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE review DROP COLUMN IF EXISTS hidden_reason;
+ALTER TABLE review DROP COLUMN IF EXISTS hidden_by;
+ALTER TABLE review DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE review DROP COLUMN IF EXISTS replied_at;
+ALTER TABLE review DROP COLUMN IF EXISTS reply_author_username;
+ALTER TABLE review DROP COLUMN IF EXISTS reply;
+-- +goose StatementEnd
@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE contract_status AS ENUM (
+    'Active',
+    'Completed',
+    'Terminated'
+);
+
+CREATE TYPE milestone_status AS ENUM (
+    'Pending',
+    'InProgress',
+    'Submitted',
+    'Accepted',
+    'Rejected'
+);
+
+-- Контракт заключается по выигравшему предложению, не больше одного на предложение.
+-- При закрытии заказчик оставляет отзыв, review_id связывает контракт с ним.
+CREATE TABLE contract (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tender_id UUID NOT NULL REFERENCES tender(id) ON DELETE CASCADE,
+    bid_id UUID NOT NULL UNIQUE REFERENCES bid(id) ON DELETE CASCADE,
+    organization_id UUID NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
+    supplier_username VARCHAR(50) NOT NULL REFERENCES employee(username) ON DELETE CASCADE,
+    amount NUMERIC(14, 2),
+    status contract_status DEFAULT 'Active',
+    created_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    closed_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    closed_at TIMESTAMP,
+    review_id UUID REFERENCES review(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX contract_organization_idx ON contract (organization_id);
+CREATE INDEX contract_supplier_idx ON contract (supplier_username);
+
+CREATE TABLE contract_milestone (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    contract_id UUID NOT NULL REFERENCES contract(id) ON DELETE CASCADE,
+    deliverable VARCHAR(100) NOT NULL,
+    description TEXT,
+    due_date DATE NOT NULL,
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount >= 0),
+    status milestone_status DEFAULT 'Pending',
+    supplier_comment TEXT,
+    decision_comment TEXT,
+    decided_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    decided_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX contract_milestone_contract_idx ON contract_milestone (contract_id, due_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS contract_milestone;
+DROP TABLE IF EXISTS contract;
+DROP TYPE IF EXISTS milestone_status;
+DROP TYPE IF EXISTS contract_status;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE negotiation_party AS ENUM (
+    'Customer',
+    'Supplier'
+);
+
+CREATE TYPE negotiation_status AS ENUM (
+    'Open',
+    'Accepted',
+    'Countered',
+    'Rejected'
+);
+
+-- Раунд переговоров по предложению: одна сторона предлагает условия, другая принимает, отклоняет
+-- или выдвигает встречные условия следующим раундом. Принятый раунд ссылается на созданную им версию предложения.
+CREATE TABLE negotiation_round (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bid_id UUID NOT NULL REFERENCES bid(id) ON DELETE CASCADE,
+    round INT NOT NULL CHECK (round > 0),
+    party negotiation_party NOT NULL,
+    proposed_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    price NUMERIC(14, 2) CHECK (price > 0),
+    terms TEXT NOT NULL,
+    status negotiation_status DEFAULT 'Open',
+    bid_version INT NOT NULL,
+    resulting_version INT,
+    responded_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    responded_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (bid_id, round)
+);
+
+CREATE UNIQUE INDEX negotiation_round_open_idx ON negotiation_round (bid_id) WHERE status = 'Open';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS negotiation_round;
+DROP TYPE IF EXISTS negotiation_status;
+DROP TYPE IF EXISTS negotiation_party;
+-- +goose StatementEnd
@@ -0,0 +1,81 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tender ADD COLUMN source_tender_id UUID REFERENCES tender(id) ON DELETE SET NULL;
+
+-- Шаблон тендера организации. Критерии хранятся списком объектов {name, weight, maxScore},
+-- требования к поставщикам - теми же полями, что и в tender_eligibility.
+CREATE TABLE tender_template (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    service_type service_type NOT NULL,
+    criteria JSONB NOT NULL DEFAULT '[]',
+    organization_types VARCHAR(10)[] NOT NULL DEFAULT '{}',
+    min_completed_contracts INT NOT NULL DEFAULT 0 CHECK (min_completed_contracts >= 0),
+    certificate VARCHAR(100),
+    version INT DEFAULT 1,
+    creator_username VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX tender_template_organization_idx ON tender_template (organization_id, name);
+
+CREATE TABLE tender_template_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID REFERENCES tender_template(id) ON DELETE CASCADE,
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+    service_type service_type NOT NULL,
+    criteria JSONB NOT NULL,
+    organization_types VARCHAR(10)[] NOT NULL,
+    min_completed_contracts INT NOT NULL,
+    certificate VARCHAR(100),
+    version INT NOT NULL,
+    creator_username VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    UNIQUE (template_id, version)
+);
+
+CREATE OR REPLACE FUNCTION update_tender_template_metadata()
+    RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at := NOW();
+    NEW.version := NEW.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tender_template_metadata_trigger
+    BEFORE UPDATE ON tender_template
+    FOR EACH ROW
+EXECUTE FUNCTION update_tender_template_metadata();
+
+CREATE OR REPLACE FUNCTION save_tender_template_to_history()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO tender_template_history (template_id, organization_id, name, description, service_type, criteria, organization_types,
+                                         min_completed_contracts, certificate, version, creator_username, created_at, updated_at)
+    VALUES (NEW.id, NEW.organization_id, NEW.name, NEW.description, NEW.service_type, NEW.criteria, NEW.organization_types,
+            NEW.min_completed_contracts, NEW.certificate, NEW.version, NEW.creator_username, NEW.created_at, NEW.updated_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tender_template_insert_update_trigger
+    AFTER INSERT OR UPDATE ON tender_template
+    FOR EACH ROW
+EXECUTE FUNCTION save_tender_template_to_history();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tender_template_history;
+DROP TABLE IF EXISTS tender_template;
+DROP FUNCTION IF EXISTS save_tender_template_to_history();
+DROP FUNCTION IF EXISTS update_tender_template_metadata();
+ALTER TABLE tender DROP COLUMN IF EXISTS source_tender_id;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Черновики правок. Сохраняются без версионирования и применяются к предложению или тендеру
+-- одной правкой. NULL в поле означает, что значение при публикации не меняется.
+CREATE TABLE bid_draft (
+    bid_id UUID PRIMARY KEY REFERENCES bid(id) ON DELETE CASCADE,
+    name VARCHAR(100),
+    description TEXT,
+    price NUMERIC(14, 2),
+    technical_proposal TEXT,
+    commercial_proposal TEXT,
+    base_version INT NOT NULL,
+    saved_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    saved_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE tender_draft (
+    tender_id UUID PRIMARY KEY REFERENCES tender(id) ON DELETE CASCADE,
+    name VARCHAR(100),
+    description TEXT,
+    service_type service_type,
+    base_version INT NOT NULL,
+    saved_by VARCHAR(50) REFERENCES employee(username) ON DELETE SET NULL,
+    saved_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tender_draft;
+DROP TABLE IF EXISTS bid_draft;
+-- +goose StatementEnd
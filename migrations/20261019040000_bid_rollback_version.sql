@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Откат создаёт следующую версию после текущей: версию выставляет bid_metadata_trigger.
+-- Раньше новая версия считалась от целевой и могла оказаться меньше version_from вложений.
+CREATE OR REPLACE FUNCTION rollback_bid_version(bidId UUID, rollback_version INT, username VARCHAR)
+    RETURNS TABLE (id UUID, name VARCHAR(100), description TEXT, feedback TEXT, status bid_status, tender_id UUID, organization_id UUID, decision bid_decision, author_id UUID, author_type author_type_enum, version INT, created_at TIMESTAMP, updated_at TIMESTAMP) AS $$
+DECLARE
+    bid_record bid_history%ROWTYPE;
+BEGIN
+    SELECT * INTO bid_record
+    FROM bid_history
+    WHERE bid_history.bid_id = bidId AND bid_history.version = rollback_version;
+
+    UPDATE bid
+    SET
+        name = bid_record.name,
+        description = bid_record.description,
+        feedback = bid_record.feedback,
+        status = bid_record.status,
+        tender_id = bid_record.tender_id,
+        organization_id = bid_record.organization_id,
+        decision = bid_record.decision,
+        author_id = bid_record.author_id,
+        author_username = username,
+        author_type = bid_record.author_type,
+        price = bid_record.price,
+        technical_proposal = bid_record.technical_proposal,
+        commercial_proposal = bid_record.commercial_proposal,
+        updated_at = CURRENT_TIMESTAMP
+    WHERE bid.id = bidId;
+
+    RETURN QUERY
+        SELECT bid.id, bid.name, bid.description, bid.feedback, bid.status, bid.tender_id, bid.organization_id, bid.decision, bid.author_id, bid.author_type, bid.version, bid.created_at, bid.updated_at
+        FROM bid
+        WHERE bid.id = bidId;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION rollback_bid_version(bidId UUID, rollback_version INT, username VARCHAR)
+    RETURNS TABLE (id UUID, name VARCHAR(100), description TEXT, feedback TEXT, status bid_status, tender_id UUID, organization_id UUID, decision bid_decision, author_id UUID, author_type author_type_enum, version INT, created_at TIMESTAMP, updated_at TIMESTAMP) AS $$
+DECLARE
+    bid_record bid_history%ROWTYPE;
+BEGIN
+    SELECT * INTO bid_record
+    FROM bid_history
+    WHERE bid_history.bid_id = bidId AND bid_history.version = rollback_version;
+
+    UPDATE bid
+    SET
+        name = bid_record.name,
+        description = bid_record.description,
+        feedback = bid_record.feedback,
+        status = bid_record.status,
+        tender_id = bid_record.tender_id,
+        organization_id = bid_record.organization_id,
+        decision = bid_record.decision,
+        author_id = bid_record.author_id,
+        author_username = username,
+        author_type = bid_record.author_type,
+        price = bid_record.price,
+        technical_proposal = bid_record.technical_proposal,
+        commercial_proposal = bid_record.commercial_proposal,
+        updated_at = CURRENT_TIMESTAMP,
+        version = bid_record.version + 1
+    WHERE bid.id = bidId;
+
+    RETURN QUERY
+        SELECT bid.id, bid.name, bid.description, bid.feedback, bid.status, bid.tender_id, bid.organization_id, bid.decision, bid.author_id, bid.author_type, bid.version, bid.created_at, bid.updated_at
+        FROM bid
+        WHERE bid.id = bidId;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- CHAR(64) дополнял пустой prev_hash первой записи пробелами, и её хеш не сходился.
+-- При переводе в VARCHAR хвостовые пробелы отбрасываются.
+ALTER TABLE audit_log ALTER COLUMN prev_hash TYPE VARCHAR(64);
+ALTER TABLE audit_log ALTER COLUMN hash TYPE VARCHAR(64);
+-- Эти поля приходят от клиента без проверки длины, а запись об отказе в доступе
+-- не должна теряться из-за длинного значения.
+ALTER TABLE audit_log ALTER COLUMN actor TYPE TEXT;
+ALTER TABLE audit_log ALTER COLUMN request_id TYPE TEXT;
+ALTER TABLE audit_log ALTER COLUMN client_ip TYPE TEXT;
+ALTER TABLE audit_log ALTER COLUMN action TYPE TEXT;
+ALTER TABLE audit_log ALTER COLUMN target TYPE TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE audit_log ALTER COLUMN target TYPE VARCHAR(100) USING left(target, 100);
+ALTER TABLE audit_log ALTER COLUMN action TYPE VARCHAR(200) USING left(action, 200);
+ALTER TABLE audit_log ALTER COLUMN client_ip TYPE VARCHAR(64) USING left(client_ip, 64);
+ALTER TABLE audit_log ALTER COLUMN request_id TYPE VARCHAR(100) USING left(request_id, 100);
+ALTER TABLE audit_log ALTER COLUMN actor TYPE VARCHAR(50) USING left(actor, 50);
+ALTER TABLE audit_log ALTER COLUMN hash TYPE CHAR(64);
+ALTER TABLE audit_log ALTER COLUMN prev_hash TYPE CHAR(64);
+-- +goose StatementEnd
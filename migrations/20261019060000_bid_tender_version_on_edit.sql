@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Правка содержимого предложения подаёт его заново на текущую версию тендера, и признак
+-- pre_clarification снимается. Вскрытие запечатанного предложения правкой не считается.
+CREATE TRIGGER refresh_bid_tender_version_trigger
+    BEFORE UPDATE OF name, description, price, technical_proposal, commercial_proposal ON bid
+    FOR EACH ROW
+    WHEN (OLD.sealed_payload IS NULL
+        AND (OLD.name, OLD.description, OLD.price, OLD.technical_proposal, OLD.commercial_proposal)
+            IS DISTINCT FROM (NEW.name, NEW.description, NEW.price, NEW.technical_proposal, NEW.commercial_proposal))
+EXECUTE FUNCTION set_bid_tender_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS refresh_bid_tender_version_trigger ON bid;
+-- +goose StatementEnd
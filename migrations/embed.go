@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// FS Миграции goose, встроенные в бинарник.
+//
+//go:embed *.sql
+var FS embed.FS
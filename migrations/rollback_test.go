@@ -0,0 +1,95 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"os"
+	"testing"
+)
+
+// openTestDB Тесты схемы запускаются только на отдельной пустой базе из TEST_DATABASE_URL.
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("pgx", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = provider.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+// TestRollbackBidAfterAttachment v1 -> правка v2 -> правка v3 -> загрузка вложения v4 -> откат к v1.
+// Откат должен дать версию 5, и вложение версии 4 закрывается на ней без нарушения CHECK.
+func TestRollbackBidAfterAttachment(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var tenderID, bidID string
+	err = tx.QueryRowContext(ctx, `INSERT INTO tender (name, description, service_type, organization_id, creator_username)
+		SELECT 'rollback', '', 'Delivery', id, 'johndoe' FROM organization WHERE name = 'Avito'
+		RETURNING id;`).Scan(&tenderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tx.QueryRowContext(ctx, `INSERT INTO bid (name, description, tender_id, author_id, author_type)
+		SELECT 'v1', '', $1, id, 'User' FROM employee WHERE username = 'pepe'
+		RETURNING id;`, tenderID).Scan(&bidID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"v2", "v3"} {
+		if _, err = tx.ExecContext(ctx, `UPDATE bid SET name = $2 WHERE id = $1;`, bidID, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var uploadVersion int
+	err = tx.QueryRowContext(ctx, `UPDATE bid SET updated_at = CURRENT_TIMESTAMP WHERE id = $1 RETURNING version;`, bidID).Scan(&uploadVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO attachment (entity_type, entity_id, file_name, content_type, size, checksum, storage_key, uploaded_by, version_from)
+		VALUES ('Bid', $1, 'a.pdf', 'application/pdf', 1, repeat('0', 64), 'key', 'pepe', $2);`, bidID, uploadVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var version int
+	err = tx.QueryRowContext(ctx, `SELECT version FROM rollback_bid_version($1, 1, 'pepe');`, bidID).Scan(&version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != uploadVersion+1 {
+		t.Fatalf("rollback version = %d, want %d", version, uploadVersion+1)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE attachment SET version_to = $2
+		WHERE entity_type = 'Bid' AND entity_id = $1 AND version_to IS NULL AND version_from > 1;`, bidID, version)
+	if err != nil {
+		t.Fatalf("close attachments: %v", err)
+	}
+}